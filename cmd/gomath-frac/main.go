@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// gomath-frac calculates with fractions given on command line and prints the result in reduced, mixed-number and
+// decimal form:
+//
+//	$ gomath-frac 1/2 + 3/4 x 2/3
+//	exact:   1
+//	mixed:   1
+//	decimal: 1
+//
+// Operators are +, -, x (or quoted *) and : (or quoted /) - each argument is either a fraction or an operator, so
+// "1/2" is one half and "1 : 2" is one divided by two (the same number). Parentheses have to be quoted.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/expr"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run evaluates the arguments and returns exit code: 0 for success, 1 for errors and 2 for invalid usage
+func run(args []string, out io.Writer, errOut io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(errOut, "usage: gomath-frac <fraction> [<operator> <fraction>]...")
+		return 2
+	}
+	terms := make([]string, len(args))
+	for i, a := range args {
+		switch a {
+		case "x", "X":
+			// * needs quoting in shell
+			terms[i] = "*"
+		case ":":
+			terms[i] = "/"
+		default:
+			// each argument is a separate term, so 1/2 : 1/4 is (1/2) / (1/4), not 1 / 2 / 1 / 4
+			terms[i] = "(" + a + ")"
+			if isOperator(a) {
+				terms[i] = a
+			}
+		}
+	}
+	q, e := expr.Eval(strings.Join(terms, " "))
+	if e != nil {
+		fmt.Fprintf(errOut, "error: %v\n", e)
+		return 1
+	}
+	for _, d := range []struct {
+		label   string
+		display expr.Display
+	}{
+		{"exact:  ", expr.Display{Mode: expr.ModeExact}},
+		{"mixed:  ", expr.Display{Mode: expr.ModeMixed}},
+		{"decimal:", expr.Display{Mode: expr.ModeRepeating}},
+	} {
+		fmt.Fprintln(out, d.label, d.display.Format(q))
+	}
+	return 0
+}
+
+// isOperator checks if the argument is an operator or parenthesis
+func isOperator(a string) bool {
+	switch a {
+	case "+", "-", "−", "*", "×", "/", "÷", "(", ")":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// gomath-primes explores prime numbers from command line:
+//
+//	gomath-primes test <n>      checks if n is prime
+//	gomath-primes factor <n>    prints prime factorization of n
+//	gomath-primes next <n>      prints the smallest prime greater than n
+//	gomath-primes list <a> <b>  prints primes between a and b
+//	gomath-primes totient <n>   prints Euler's φ(n)
+//
+// Numbers can be written as Go literals ("1_000_000", "0xFF") or powers ("2⁶⁴").
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+const usage = `usage:
+  gomath-primes test <n>
+  gomath-primes factor <n>
+  gomath-primes next <n>
+  gomath-primes list <a> <b>
+  gomath-primes totient <n>
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns exit code: 0 for success, 1 for errors and 2 for invalid usage
+func run(args []string, out io.Writer, errOut io.Writer) int {
+	arity := map[string]int{"test": 1, "factor": 1, "next": 1, "list": 2, "totient": 1}
+	if len(args) == 0 {
+		fmt.Fprint(errOut, usage)
+		return 2
+	}
+	if n, ok := arity[args[0]]; !ok || n != len(args)-1 {
+		fmt.Fprint(errOut, usage)
+		return 2
+	}
+	ns := make([]*numbers.N, len(args)-1)
+	for i, a := range args[1:] {
+		n, e := numbers.ParseN(a)
+		if e != nil {
+			fmt.Fprintf(errOut, "error: %v\n", e)
+			return 2
+		}
+		ns[i] = n
+	}
+
+	var e error
+	switch args[0] {
+	case "test":
+		e = test(out, ns[0])
+	case "factor":
+		var f []primes.PrimePower
+		if f, e = primes.Factorize(ns[0]); e == nil {
+			fmt.Fprintf(out, "%s = %s\n", ns[0], primes.Factorization(f))
+		}
+	case "next":
+		var p *numbers.N
+		if p, e = primes.Next(ns[0]); e == nil {
+			fmt.Fprintln(out, p)
+		}
+	case "list":
+		var ps []*numbers.N
+		if ps, e = primes.Between(ns[0], ns[1]); e == nil {
+			s := make([]string, len(ps))
+			for i, p := range ps {
+				s[i] = p.String()
+			}
+			fmt.Fprintln(out, strings.Join(s, " "))
+		}
+	case "totient":
+		var phi *numbers.N
+		if phi, e = primes.Totient(ns[0]); e == nil {
+			fmt.Fprintf(out, "φ(%s) = %s\n", ns[0], phi)
+		}
+	}
+	if e != nil {
+		fmt.Fprintf(errOut, "error: %v\n", e)
+		return 1
+	}
+	return 0
+}
+
+// test prints if n is prime, showing the factors of composite numbers
+func test(out io.Writer, n *numbers.N) error {
+	switch {
+	case primes.IsPrime(n):
+		fmt.Fprintf(out, "%s is prime\n", n)
+	case n.Uint64() < 2:
+		fmt.Fprintf(out, "%s is neither prime nor composite\n", n)
+	default:
+		f, e := primes.Factorize(n)
+		if e != nil {
+			return e
+		}
+		fmt.Fprintf(out, "%s is composite (%s)\n", n, primes.Factorization(f))
+	}
+	return nil
+}
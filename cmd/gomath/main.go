@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// gomath is interactive calculator evaluating expressions exactly. Lines starting with ":" change how results are
+// displayed (":mode exact|mixed|repeating|decimal <digits>", ":base <base>"), ":quit" ends the session.
+//
+// "gomath reduce sum|product|mean" instead reads numbers (like "1/3" or "1.5", separated by whitespace or commas) from
+// standard input and prints their exact sum, product or mean, so files can be piped through it.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/expr"
+	"github.com/grgrzybek/gomath/pkg/stats"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reduce" {
+		os.Exit(reduce(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+	run(os.Stdin, os.Stdout)
+}
+
+// reduce folds numbers from in with the reduction given in args and returns exit code: 0 for success, 1 for errors
+// and 2 for invalid usage
+func reduce(args []string, in io.Reader, out io.Writer, errOut io.Writer) int {
+	if len(args) != 1 {
+		fmt.Fprintln(errOut, "usage: gomath reduce sum|product|mean")
+		return 2
+	}
+	op, e := stats.ParseReduction(args[0])
+	if e != nil {
+		fmt.Fprintf(errOut, "error: %v\n", e)
+		return 2
+	}
+	q, e := stats.ReduceReader(in, op)
+	if e != nil {
+		fmt.Fprintf(errOut, "error: %v\n", e)
+		return 1
+	}
+	var display expr.Display
+	fmt.Fprintln(out, display.Format(q))
+	return 0
+}
+
+func run(in io.Reader, out io.Writer) {
+	var display expr.Display
+	scanner := bufio.NewScanner(in)
+	for fmt.Fprint(out, "> "); scanner.Scan(); fmt.Fprint(out, "> ") {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case line == ":quit" || line == ":q":
+			return
+		case strings.HasPrefix(line, ":"):
+			if e := display.Command(line); e != nil {
+				fmt.Fprintf(out, "error: %v\n", e)
+			}
+		default:
+			q, e := expr.Eval(line)
+			if e != nil {
+				fmt.Fprintf(out, "error: %v\n", e)
+				continue
+			}
+			fmt.Fprintln(out, display.Format(q))
+		}
+	}
+	fmt.Fprintln(out)
+}
@@ -0,0 +1,61 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package modarith contains arithmetic on machine integers shared by the packages of the module - modular
+// multiplication and powers, gcd and absolute values which don't overflow
+package modarith
+
+import (
+	"math/bits"
+)
+
+// AbsUint64 returns |v| - also for math.MinInt64, whose absolute value doesn't fit int64
+func AbsUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+// GCD returns gcd(a, b) - Euclid's algorithm
+func GCD(a uint64, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// MulMod returns a * b mod m for a, b < m, using 128-bit product
+func MulMod(a uint64, b uint64, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, r := bits.Div64(hi, lo, m)
+	return r
+}
+
+// PowMod returns a^e mod m for a < m by repeated squaring
+func PowMod(a uint64, e uint64, m uint64) uint64 {
+	res := uint64(1) % m
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			res = MulMod(res, a, m)
+		}
+		a = MulMod(a, a, m)
+	}
+	return res
+}
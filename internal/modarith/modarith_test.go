@@ -0,0 +1,60 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modarith
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAbsUint64(t *testing.T) {
+	for v, expected := range map[int64]uint64{0: 0, 5: 5, -5: 5, math.MaxInt64: math.MaxInt64, math.MinInt64: 1 << 63} {
+		if r := AbsUint64(v); r != expected {
+			t.Errorf("|%d|: expected %d, got %d", v, expected, r)
+		}
+	}
+}
+
+func TestGCD(t *testing.T) {
+	for _, c := range [][3]uint64{{0, 0, 0}, {0, 7, 7}, {7, 0, 7}, {12, 18, 6}, {17, 5, 1},
+		{math.MaxUint64, math.MaxUint64 / 5, math.MaxUint64 / 5}} {
+		if r := GCD(c[0], c[1]); r != c[2] {
+			t.Errorf("gcd(%d, %d): expected %d, got %d", c[0], c[1], c[2], r)
+		}
+	}
+}
+
+func TestMulMod(t *testing.T) {
+	m := uint64(math.MaxUint64 - 58) // the largest prime fitting uint64
+	if r := MulMod(m-1, m-1, m); r != 1 {
+		t.Errorf("(-1)^2: expected 1, got %d", r)
+	}
+	if r := MulMod(1<<40, 1<<40, 1000000007); r != (1<<40%1000000007)*(1<<40%1000000007)%1000000007 {
+		t.Errorf("unexpected %d", r)
+	}
+}
+
+func TestPowMod(t *testing.T) {
+	for _, c := range [][4]uint64{{2, 10, 1000, 24}, {3, 0, 7, 1}, {5, 3, 1, 0}, {2, 1000000006, 1000000007, 1},
+		{math.MaxUint64 - 59, math.MaxUint64 - 59, math.MaxUint64 - 58, 1}} {
+		if r := PowMod(c[0], c[1], c[2]); r != c[3] {
+			t.Errorf("%d^%d mod %d: expected %d, got %d", c[0], c[1], c[2], c[3], r)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package checksum implements check digits of identifiers - Luhn (payment cards), ISBN-10, ISBN-13 and IBAN. All of
+// them are remainders: a weighted sum of digits (or the whole identifier read as a number) is divided by a small
+// modulus and the check digit is chosen so the remainder is fixed. Single-digit typos always change the remainder
+// and so do most swaps of adjacent digits.
+package checksum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// normalize removes spaces and hyphens used to group the characters of identifiers
+func normalize(s string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(s)
+}
+
+// decimalDigits returns values of decimal digits of s
+func decimalDigits(s string) ([]int64, error) {
+	res := make([]int64, 0, len(s))
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("%q is not a decimal digit", c)
+		}
+		res = append(res, int64(c-'0'))
+	}
+	return res, nil
+}
+
+// remainder returns sum mod m for sum >= 0 and m > 0 using division with remainder in ℤ
+func remainder(sum int64, m int64) int64 {
+	_, r, _ := numbers.ZFromInt64(sum).DivideR(numbers.ZFromInt64(m))
+	return r.Int64()
+}
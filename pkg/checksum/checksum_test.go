@@ -0,0 +1,39 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import "testing"
+
+func TestRemainder(t *testing.T) {
+	for _, c := range [][3]int64{{0, 10, 0}, {67, 10, 7}, {130, 11, 9}, {96, 97, 96}} {
+		if r := remainder(c[0], c[1]); r != c[2] {
+			t.Errorf("%d mod %d: expected %d, got %d", c[0], c[1], c[2], r)
+		}
+	}
+}
+
+func TestDecimalDigits(t *testing.T) {
+	ds, e := decimalDigits(normalize("12 3-4"))
+	if e != nil || len(ds) != 4 || ds[3] != 4 {
+		t.Errorf("unexpected %v, %v", ds, e)
+	}
+	if _, e := decimalDigits("12a"); e == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/modular"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxIBANLength is the biggest length of IBAN allowed by ISO 13616
+const MaxIBANLength = 34
+
+// ibanChunk is the number of decimal digits appended to the remainder at once, so the dividend fits int64
+const ibanChunk = 7
+
+// IBANCheckDigits returns two check digits of IBAN for country code (like "GB") and the national account number
+// (BBAN). The country code and "00" are moved behind BBAN, letters are replaced with numbers (A = 10, ..., Z = 35) and
+// the result read as a decimal number n gives the check digits 98 - (n mod 97). The country-specific BBAN formats
+// are not checked.
+func IBANCheckDigits(country string, bban string) (string, error) {
+	country = strings.ToUpper(country)
+	if len(country) != 2 || !isLetter(country[0]) || !isLetter(country[1]) {
+		return "", fmt.Errorf("%q is not a country code", country)
+	}
+	bban = strings.ToUpper(normalize(bban))
+	if bban == "" || len(bban) > MaxIBANLength-4 {
+		return "", fmt.Errorf("BBAN has to have between 1 and %d characters", MaxIBANLength-4)
+	}
+	r, e := mod97(bban + country + "00")
+	if e != nil {
+		return "", e
+	}
+	return fmt.Sprintf("%02d", 98-r), nil
+}
+
+// ValidIBAN checks IBAN like "GB82 WEST 1234 5698 7654 32": moving the first 4 characters to the end and replacing
+// letters with numbers gives a number with remainder 1 modulo 97
+func ValidIBAN(s string) bool {
+	s = strings.ToUpper(normalize(s))
+	if len(s) < 5 || len(s) > MaxIBANLength || !isLetter(s[0]) || !isLetter(s[1]) ||
+		!isDigit(s[2]) || !isDigit(s[3]) {
+		return false
+	}
+	r, e := mod97(s[4:] + s[:4])
+	return e == nil && r == 1
+}
+
+// mod97 returns the remainder modulo 97 of the decimal number made of alphanumeric s. It's too long for int64, so
+// it's reduced piece-wise: (r·10^k + next k digits) mod 97.
+func mod97(s string) (int64, error) {
+	var ds strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isDigit(c):
+			ds.WriteByte(c)
+		case isLetter(c):
+			fmt.Fprintf(&ds, "%d", c-'A'+10)
+		default:
+			return 0, fmt.Errorf("%q is not alphanumeric", c)
+		}
+	}
+	m := numbers.ZFromInt64(97)
+	r := int64(0)
+	digits := ds.String()
+	for len(digits) > 0 {
+		k := min(ibanChunk, len(digits))
+		v := r
+		for _, c := range digits[:k] {
+			v = v*10 + int64(c-'0')
+		}
+		rz, e := modular.Mod(numbers.ZFromInt64(v), m)
+		if e != nil {
+			return 0, e
+		}
+		r = rz.Int64()
+		digits = digits[k:]
+	}
+	return r, nil
+}
+
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import "testing"
+
+func TestIBANCheckDigits(t *testing.T) {
+	for _, c := range []struct {
+		country, bban, check string
+	}{
+		{"GB", "WEST 1234 5698 7654 32", "82"},
+		{"DE", "370400440532013000", "89"},
+		{"pl", "1090 1014 0000 0712 1981 2874", "61"},
+	} {
+		d, e := IBANCheckDigits(c.country, c.bban)
+		if e != nil || d != c.check {
+			t.Errorf("%s %s: expected %s, got %s (%v)", c.country, c.bban, c.check, d, e)
+		}
+	}
+	for _, c := range [][2]string{{"G1", "1234"}, {"GBR", "1234"}, {"GB", ""}, {"GB", "12#4"}} {
+		if _, e := IBANCheckDigits(c[0], c[1]); e == nil {
+			t.Errorf("expected error for %q", c)
+		}
+	}
+}
+
+func TestValidIBAN(t *testing.T) {
+	for _, s := range []string{
+		"GB82 WEST 1234 5698 7654 32",
+		"DE89 3704 0044 0532 0130 00",
+		"PL61 1090 1014 0000 0712 1981 2874",
+		"gb82west12345698765432",
+	} {
+		if !ValidIBAN(s) {
+			t.Errorf("%s should be valid", s)
+		}
+	}
+	for _, s := range []string{
+		"GB82 WEST 1234 5698 7654 33",
+		"GB28 WEST 1234 5698 7654 32",
+		"82GB WEST 1234 5698 7654 32",
+		"GB82",
+		"GB82 WEST 1234 5698 7654 32 1234 5678 9",
+	} {
+		if ValidIBAN(s) {
+			t.Errorf("%s should be invalid", s)
+		}
+	}
+}
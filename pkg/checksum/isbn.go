@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ISBN10CheckDigit returns the check digit of 9-digit payload of ISBN-10: the weighted sum 10·d1 + 9·d2 + ... +
+// 2·d9 + 1·d10 has to be divisible by 11. Because 10 ≡ -1 (mod 11), the check digit is d1 + 2·d2 + ... + 9·d9
+// mod 11, with "X" used for 10.
+func ISBN10CheckDigit(payload string) (byte, error) {
+	ds, e := isbnDigits(payload, 9)
+	if e != nil {
+		return 0, e
+	}
+	sum := int64(0)
+	for i, d := range ds {
+		sum += int64(i+1) * d
+	}
+	return isbn10Digit(remainder(sum, 11)), nil
+}
+
+// ValidISBN10 checks ISBN-10 like "0-306-40615-2" or "0-8044-2957-X"
+func ValidISBN10(s string) bool {
+	s = normalize(s)
+	if len(s) != 10 {
+		return false
+	}
+	check, e := ISBN10CheckDigit(s[:9])
+	return e == nil && strings.ToUpper(s[9:]) == string(check)
+}
+
+// ISBN13CheckDigit returns the check digit of 12-digit payload of ISBN-13 (EAN-13): digits are weighted alternately
+// 1 and 3 and the sum with the check digit has to be divisible by 10
+func ISBN13CheckDigit(payload string) (byte, error) {
+	ds, e := isbnDigits(payload, 12)
+	if e != nil {
+		return 0, e
+	}
+	return byte('0' + remainder(10-remainder(isbn13Sum(ds), 10), 10)), nil
+}
+
+// ValidISBN13 checks ISBN-13 like "978-0-306-40615-7"
+func ValidISBN13(s string) bool {
+	ds, e := isbnDigits(s, 13)
+	return e == nil && remainder(isbn13Sum(ds), 10) == 0
+}
+
+// isbnDigits returns exactly count digits of s
+func isbnDigits(s string, count int) ([]int64, error) {
+	ds, e := decimalDigits(normalize(s))
+	if e != nil {
+		return nil, e
+	}
+	if len(ds) != count {
+		return nil, fmt.Errorf("expected %d digits, got %d", count, len(ds))
+	}
+	return ds, nil
+}
+
+// isbn13Sum returns the sum of ds weighted 1, 3, 1, 3, ...
+func isbn13Sum(ds []int64) int64 {
+	sum := int64(0)
+	for i, d := range ds {
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum
+}
+
+// isbn10Digit returns the character of ISBN-10 check value 0 <= v <= 10
+func isbn10Digit(v int64) byte {
+	if v == 10 {
+		return 'X'
+	}
+	return byte('0' + v)
+}
@@ -0,0 +1,67 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import "testing"
+
+func TestISBN10(t *testing.T) {
+	for _, c := range []struct {
+		payload string
+		check   byte
+	}{
+		{"0-306-40615", '2'},
+		{"0-8044-2957", 'X'},
+		{"0-19-853453", '1'},
+	} {
+		d, e := ISBN10CheckDigit(c.payload)
+		if e != nil || d != c.check {
+			t.Errorf("%s: expected %c, got %c (%v)", c.payload, c.check, d, e)
+		}
+	}
+	for _, s := range []string{"0-306-40615-2", "0-8044-2957-X", "080442957x"} {
+		if !ValidISBN10(s) {
+			t.Errorf("%s should be valid", s)
+		}
+	}
+	for _, s := range []string{"0-306-40615-3", "0-306-04615-2", "0-306-40615", "X-306-40615-2"} {
+		if ValidISBN10(s) {
+			t.Errorf("%s should be invalid", s)
+		}
+	}
+	if _, e := ISBN10CheckDigit("12345678"); e == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestISBN13(t *testing.T) {
+	d, e := ISBN13CheckDigit("978-0-306-40615")
+	if e != nil || d != '7' {
+		t.Errorf("expected 7, got %c (%v)", d, e)
+	}
+	d, _ = ISBN13CheckDigit("978-3-16-148410")
+	if d != '0' {
+		t.Errorf("expected 0, got %c", d)
+	}
+	if !ValidISBN13("978-0-306-40615-7") || !ValidISBN13("9783161484100") {
+		t.Error("should be valid")
+	}
+	if ValidISBN13("978-0-306-40615-8") || ValidISBN13("978-0-306-40615") {
+		t.Error("should be invalid")
+	}
+}
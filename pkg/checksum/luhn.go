@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import "errors"
+
+// LuhnCheckDigit returns the digit which appended to payload makes it valid in Luhn's algorithm: going from the
+// right, every second digit of payload is doubled (with the digits of the product summed: 7 → 14 → 1 + 4 = 5) and the
+// check digit makes the sum of all divisible by 10
+func LuhnCheckDigit(payload string) (byte, error) {
+	ds, e := decimalDigits(normalize(payload))
+	if e != nil {
+		return 0, e
+	}
+	if len(ds) == 0 {
+		return 0, errors.New("no digits")
+	}
+	// the check digit will be at the position 0, so doubling starts at the rightmost payload digit
+	return byte('0' + remainder(10-remainder(luhnSum(ds, true), 10), 10)), nil
+}
+
+// ValidLuhn checks if the last digit of s is its Luhn check digit, like in "4111 1111 1111 1111"
+func ValidLuhn(s string) bool {
+	ds, e := decimalDigits(normalize(s))
+	if e != nil || len(ds) < 2 {
+		return false
+	}
+	return remainder(luhnSum(ds, false), 10) == 0
+}
+
+// luhnSum returns the sum of digits with every second digit from the right doubled, starting with the rightmost one
+// if doubleLast is true
+func luhnSum(ds []int64, doubleLast bool) int64 {
+	sum := int64(0)
+	double := doubleLast
+	for i := len(ds) - 1; i >= 0; i-- {
+		d := ds[i]
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package checksum
+
+import "testing"
+
+func TestLuhn(t *testing.T) {
+	for _, c := range []struct {
+		payload string
+		check   byte
+	}{
+		{"7992739871", '3'},
+		{"411111111111111", '1'},
+		{"0", '0'},
+		{"1", '8'},
+	} {
+		d, e := LuhnCheckDigit(c.payload)
+		if e != nil || d != c.check {
+			t.Errorf("%s: expected %c, got %c (%v)", c.payload, c.check, d, e)
+		}
+		if !ValidLuhn(c.payload + string(d)) {
+			t.Errorf("%s%c should be valid", c.payload, d)
+		}
+	}
+	for _, s := range []string{"79927398710", "79927398713x", "4111 1111 1111 1112", "", "5"} {
+		if ValidLuhn(s) {
+			t.Errorf("%q should be invalid", s)
+		}
+	}
+	if _, e := LuhnCheckDigit(""); e == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestLuhnDetectsSingleDigitErrors(t *testing.T) {
+	valid := []byte("4111111111111111")
+	for i := range valid {
+		for d := byte('0'); d <= '9'; d++ {
+			if d == valid[i] {
+				continue
+			}
+			typo := append([]byte{}, valid...)
+			typo[i] = d
+			if ValidLuhn(string(typo)) {
+				t.Errorf("typo %s not detected", typo)
+			}
+		}
+	}
+}
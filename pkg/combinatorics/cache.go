@@ -0,0 +1,155 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package combinatorics
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+const (
+	// MaxBigFactorial is the biggest n for Cache.BigFactorial - all factorials up to n are kept, so they take
+	// quadratic memory
+	MaxBigFactorial = 1000
+	// MaxBigBinomial is the biggest n for Cache.BigBinomial
+	MaxBigBinomial = 1 << 20
+)
+
+// Cache remembers computed factorials and binomial coefficients, so workloads which need the same values many times
+// (like counting partitions or summing probabilities) compute each of them once. It's opt-in - Factorial and
+// Binomial don't use any cache. The values are kept in math/big, so also the ones which don't fit ℕ are cached.
+// Cache is safe for concurrent use and its zero value is ready to use.
+type Cache struct {
+	mu        sync.RWMutex
+	binomials map[[2]uint64]*big.Int
+	// factorials[i] is i!, filled up to the biggest n asked for
+	factorials []*big.Int
+	// maxBinomials limits the number of cached binomial coefficients, 0 means no limit
+	maxBinomials int
+}
+
+// NewCache creates empty cache
+func NewCache() *Cache {
+	return &Cache{}
+}
+
+// NewBoundedCache creates empty cache keeping at most maxBinomials binomial coefficients - the ones computed after
+// that are not remembered
+func NewBoundedCache(maxBinomials int) *Cache {
+	return &Cache{maxBinomials: max(maxBinomials, 1)}
+}
+
+// Factorial returns n! (see Factorial), computing only the factorials not computed before
+func (c *Cache) Factorial(n *numbers.N) (*numbers.N, error) {
+	if n.Uint64() > MaxFactorial {
+		return nil, numbers.ErrOverflow
+	}
+	f, e := c.BigFactorial(n)
+	if e != nil {
+		return nil, e
+	}
+	return numbers.NFromUint64(f.Uint64()), nil
+}
+
+// BigFactorial returns n! for n <= MaxBigFactorial, computing only the factorials not computed before. The result
+// is a copy, which the caller may change.
+func (c *Cache) BigFactorial(n *numbers.N) (*big.Int, error) {
+	v := n.Uint64()
+	if v > MaxBigFactorial {
+		return nil, fmt.Errorf("%s! is not cached, the limit is %d!", n, MaxBigFactorial)
+	}
+	c.mu.RLock()
+	if v < uint64(len(c.factorials)) {
+		defer c.mu.RUnlock()
+		return new(big.Int).Set(c.factorials[v]), nil
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.factorials) == 0 {
+		c.factorials = append(c.factorials, big.NewInt(1))
+	}
+	for i := uint64(len(c.factorials)); i <= v; i++ {
+		c.factorials = append(c.factorials, new(big.Int).Mul(c.factorials[i-1], new(big.Int).SetUint64(i)))
+	}
+	return new(big.Int).Set(c.factorials[v]), nil
+}
+
+// Binomial returns C(n, k) (see Binomial), computing it only once for each n and k
+func (c *Cache) Binomial(n *numbers.N, k *numbers.N) (*numbers.N, error) {
+	if k.Uint64() > n.Uint64() {
+		return numbers.NFromUint64(0), nil
+	}
+	if n.Uint64() > MaxBigBinomial {
+		v, e := binomial(n.Uint64(), k.Uint64())
+		if e != nil {
+			return nil, e
+		}
+		return numbers.NFromUint64(v), nil
+	}
+	b, e := c.BigBinomial(n, k)
+	if e != nil {
+		return nil, e
+	}
+	if !b.IsUint64() {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.NFromUint64(b.Uint64()), nil
+}
+
+// BigBinomial returns C(n, k) for n <= MaxBigBinomial, computing it only once for each n and k. The result is a
+// copy, which the caller may change.
+func (c *Cache) BigBinomial(n *numbers.N, k *numbers.N) (*big.Int, error) {
+	if n.Uint64() > MaxBigBinomial {
+		return nil, fmt.Errorf("C(%s, %s) is not cached, the limit is n = %d", n, k, MaxBigBinomial)
+	}
+	if k.Uint64() > n.Uint64() {
+		return new(big.Int), nil
+	}
+	// C(n, k) = C(n, n - k), so both share one entry
+	key := [2]uint64{n.Uint64(), min(k.Uint64(), n.Uint64()-k.Uint64())}
+	c.mu.RLock()
+	res, ok := c.binomials[key]
+	c.mu.RUnlock()
+	if ok {
+		return new(big.Int).Set(res), nil
+	}
+
+	res = new(big.Int).Binomial(int64(key[0]), int64(key[1]))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.binomials == nil {
+		c.binomials = make(map[[2]uint64]*big.Int)
+	}
+	if c.maxBinomials == 0 || len(c.binomials) < c.maxBinomials {
+		c.binomials[key] = res
+	}
+	return new(big.Int).Set(res), nil
+}
+
+// Len returns the number of cached values
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.binomials) + len(c.factorials)
+}
@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package combinatorics
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestCacheMatchesUncached(t *testing.T) {
+	c := NewCache()
+	for n := uint64(0); n <= 30; n++ {
+		for k := uint64(0); k <= n+1; k++ {
+			want, _ := Binomial(numbers.NFromUint64(n), numbers.NFromUint64(k))
+			got, e := c.Binomial(numbers.NFromUint64(n), numbers.NFromUint64(k))
+			if e != nil || got.Uint64() != want.Uint64() {
+				t.Errorf("cached C(%d, %d) = %v, %v, want %s", n, k, got, e, want)
+			}
+		}
+	}
+	for n := uint64(MaxFactorial); ; n-- {
+		want, _ := Factorial(numbers.NFromUint64(n))
+		got, e := c.Factorial(numbers.NFromUint64(n))
+		if e != nil || got.Uint64() != want.Uint64() {
+			t.Errorf("cached %d! = %v, %v, want %s", n, got, e, want)
+		}
+		if n == 0 {
+			break
+		}
+	}
+	if _, e := c.Factorial(numbers.NFromUint64(MaxFactorial + 1)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("cached 21! should overflow, got %v", e)
+	}
+	if _, e := c.Binomial(numbers.NFromUint64(100), numbers.NFromUint64(50)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("cached C(100, 50) should overflow, got %v", e)
+	}
+}
+
+func TestCacheSharesSymmetricEntries(t *testing.T) {
+	var c Cache
+	_, _ = c.Binomial(numbers.NFromUint64(10), numbers.NFromUint64(3))
+	_, _ = c.Binomial(numbers.NFromUint64(10), numbers.NFromUint64(7))
+	if c.Len() != 1 {
+		t.Errorf("C(10, 3) and C(10, 7) should share an entry, got %d entries", c.Len())
+	}
+}
+
+func TestCacheConcurrentUse(t *testing.T) {
+	c := NewCache()
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				n := uint64((i + g) % 60)
+				got, e := c.Binomial(numbers.NFromUint64(n), numbers.NFromUint64(n/3))
+				want, _ := binomial(n, n/3)
+				if e != nil || got.Uint64() != want {
+					t.Errorf("C(%d, %d) = %v, %v, want %d", n, n/3, got, e, want)
+				}
+				f, e := c.Factorial(numbers.NFromUint64(uint64(i % (MaxFactorial + 1))))
+				if e != nil || f.Uint64() == 0 {
+					t.Errorf("%d! = %v, %v", i%(MaxFactorial+1), f, e)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCacheBigValues(t *testing.T) {
+	c := NewCache()
+	f, e := c.BigFactorial(numbers.NFromUint64(25))
+	if e != nil || f.String() != "15511210043330985984000000" {
+		t.Errorf("unexpected 25! = %v (%v)", f, e)
+	}
+	b, e := c.BigBinomial(numbers.NFromUint64(100), numbers.NFromUint64(50))
+	if e != nil || b.String() != "100891344545564193334812497256" {
+		t.Errorf("unexpected C(100, 50) = %v (%v)", b, e)
+	}
+	// the results are copies
+	f.SetInt64(0)
+	b.SetInt64(0)
+	if f, _ = c.BigFactorial(numbers.NFromUint64(25)); f.String() != "15511210043330985984000000" {
+		t.Errorf("cached 25! changed to %s", f)
+	}
+	if b, _ = c.BigBinomial(numbers.NFromUint64(100), numbers.NFromUint64(50)); b.String() != "100891344545564193334812497256" {
+		t.Errorf("cached C(100, 50) changed to %s", b)
+	}
+	if b, _ = c.BigBinomial(numbers.NFromUint64(3), numbers.NFromUint64(5)); b.Sign() != 0 {
+		t.Errorf("C(3, 5) should be ZERO, got %s", b)
+	}
+	if _, e = c.BigFactorial(numbers.NFromUint64(MaxBigFactorial + 1)); e == nil {
+		t.Error("expected error above MaxBigFactorial")
+	}
+	if _, e = c.BigBinomial(numbers.NFromUint64(MaxBigBinomial+1), numbers.NFromUint64(1)); e == nil {
+		t.Error("expected error above MaxBigBinomial")
+	}
+	if v, e := c.Binomial(numbers.NFromUint64(MaxBigBinomial+1), numbers.NFromUint64(1)); e != nil ||
+		v.Uint64() != MaxBigBinomial+1 {
+		t.Errorf("unexpected %v (%v)", v, e)
+	}
+}
+
+func TestBoundedCache(t *testing.T) {
+	c := NewBoundedCache(3)
+	for k := uint64(0); k <= 10; k++ {
+		want, _ := Binomial(numbers.NFromUint64(20), numbers.NFromUint64(k))
+		if got, e := c.Binomial(numbers.NFromUint64(20), numbers.NFromUint64(k)); e != nil || got.Uint64() != want.Uint64() {
+			t.Errorf("C(20, %d) = %v, %v, want %s", k, got, e, want)
+		}
+	}
+	if c.Len() != 3 {
+		t.Errorf("expected 3 entries, got %d", c.Len())
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package combinatorics counts arrangements and selections of objects exactly in ℕ
+package combinatorics
+
+import (
+	"math/bits"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxFactorial is the biggest n with n! fitting uint64
+const MaxFactorial = 20
+
+// Factorial returns n! = 1 * 2 * ... * n (0! = 1) or numbers.ErrOverflow for n > MaxFactorial
+func Factorial(n *numbers.N) (*numbers.N, error) {
+	if n.Uint64() > MaxFactorial {
+		return nil, numbers.ErrOverflow
+	}
+	res := uint64(1)
+	for i := uint64(2); i <= n.Uint64(); i++ {
+		res *= i
+	}
+	return numbers.NFromUint64(res), nil
+}
+
+// Binomial returns binomial coefficient C(n, k) = n! / (k! (n - k)!) - the number of k-element subsets of n-element
+// set (ZERO for k > n), or numbers.ErrOverflow if it doesn't fit uint64.
+//
+// It's C(n, i + 1) = C(n, i) * (n - i) / (i + 1) for i < min(k, n - k). The product is always divisible, and it's
+// kept in 128 bits, so only the result has to fit uint64.
+func Binomial(n *numbers.N, k *numbers.N) (*numbers.N, error) {
+	v, e := binomial(n.Uint64(), k.Uint64())
+	if e != nil {
+		return nil, e
+	}
+	return numbers.NFromUint64(v), nil
+}
+
+// binomial returns C(n, k)
+func binomial(n uint64, k uint64) (uint64, error) {
+	if k > n {
+		return 0, nil
+	}
+	k = min(k, n-k)
+	res := uint64(1)
+	for i := uint64(0); i < k; i++ {
+		hi, lo := bits.Mul64(res, n-i)
+		if hi >= i+1 {
+			// the quotient doesn't fit 64 bits
+			return 0, numbers.ErrOverflow
+		}
+		res, _ = bits.Div64(hi, lo, i+1)
+	}
+	return res, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package combinatorics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestFactorial(t *testing.T) {
+	for n, want := range map[uint64]uint64{0: 1, 1: 1, 5: 120, 10: 3628800, 20: 2432902008176640000} {
+		f, e := Factorial(numbers.NFromUint64(n))
+		if e != nil || f.Uint64() != want {
+			t.Errorf("%d! = %v, %v, want %d", n, f, e, want)
+		}
+	}
+	if _, e := Factorial(numbers.NFromUint64(21)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("21! should overflow, got %v", e)
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	tests := []struct{ n, k, want uint64 }{
+		{0, 0, 1},
+		{5, 0, 1},
+		{5, 2, 10},
+		{5, 3, 10},
+		{5, 6, 0},
+		{52, 5, 2598960},
+		{67, 33, 14226520737620288370},
+		{1 << 40, 1, 1 << 40},
+	}
+	for _, tt := range tests {
+		b, e := Binomial(numbers.NFromUint64(tt.n), numbers.NFromUint64(tt.k))
+		if e != nil || b.Uint64() != tt.want {
+			t.Errorf("C(%d, %d) = %v, %v, want %d", tt.n, tt.k, b, e, tt.want)
+		}
+	}
+	if _, e := Binomial(numbers.NFromUint64(68), numbers.NFromUint64(34)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("C(68, 34) should overflow, got %v", e)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package cryptoedu implements textbook public-key cryptography with toy parameters, built on packages primes and
+// modular. Every intermediate value can be shown, so it's for teaching how the number theory works - never for
+// protecting anything: the keys are tiny and there's no padding.
+package cryptoedu
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Step is an intermediate value of a calculation - a name, the formula it's calculated with and the value
+type Step struct {
+	Name    string
+	Formula string
+	Value   *numbers.Z
+}
+
+func (s Step) String() string {
+	if s.Formula == "" {
+		return fmt.Sprintf("%s = %s", s.Name, s.Value)
+	}
+	return fmt.Sprintf("%s = %s = %s", s.Name, s.Formula, s.Value)
+}
+
+// PrintSteps writes steps to w, one per line
+func PrintSteps(w io.Writer, steps []Step) error {
+	for _, s := range steps {
+		if _, e := fmt.Fprintln(w, s); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// powerSteps returns base^exp mod m by repeated squaring with the steps: the squares base^(2^i) mod m and the
+// product of those for 1 bits of exp
+func powerSteps(name string, base uint64, exp uint64, m uint64) (uint64, []Step) {
+	var steps []Step
+	res, square := 1%m, base%m
+	for i := 0; exp > 0; i, exp = i+1, exp>>1 {
+		if i > 0 {
+			square = modarith.MulMod(square, square, m)
+			steps = append(steps, Step{Name: fmt.Sprintf("%s^%d", name, uint64(1)<<i),
+				Formula: fmt.Sprintf("(%s^%d)^2 mod %d", name, uint64(1)<<(i-1), m), Value: z(square)})
+		}
+		if exp&1 == 1 {
+			res = modarith.MulMod(res, square, m)
+			steps = append(steps, Step{Name: "result", Formula: fmt.Sprintf("result·%s^%d mod %d", name,
+				uint64(1)<<i, m), Value: z(res)})
+		}
+	}
+	return res, steps
+}
+
+// z returns v as ℤ
+func z(v uint64) *numbers.Z {
+	return numbers.ZFromInt64(int64(v))
+}
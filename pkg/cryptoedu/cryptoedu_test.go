@@ -0,0 +1,47 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cryptoedu
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestStep(t *testing.T) {
+	if s := (Step{Name: "p", Value: zi(61)}).String(); s != "p = 61" {
+		t.Errorf("expected p = 61, got %s", s)
+	}
+	if s := (Step{Name: "n", Formula: "p·q", Value: zi(3233)}).String(); s != "n = p·q = 3233" {
+		t.Errorf("expected n = p·q = 3233, got %s", s)
+	}
+}
+
+func TestPowerSteps(t *testing.T) {
+	for _, c := range [][3]uint64{{2, 10, 1000}, {3, 0, 7}, {5, 1, 1}, {1<<62 - 1, 1<<62 + 3, 1<<62 - 57}} {
+		v, steps := powerSteps("x", c[0], c[1], c[2])
+		exp := new(big.Int).Exp(new(big.Int).SetUint64(c[0]), new(big.Int).SetUint64(c[1]),
+			new(big.Int).SetUint64(c[2]))
+		if v != exp.Uint64() {
+			t.Errorf("%d^%d mod %d: expected %s, got %d", c[0], c[1], c[2], exp, v)
+		}
+		if len(steps) > 0 && uint64(steps[len(steps)-1].Value.Int64()) != v {
+			t.Errorf("%d^%d mod %d: the last step is %s", c[0], c[1], c[2], steps[len(steps)-1])
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cryptoedu
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/grgrzybek/gomath/pkg/modular"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// MaxDHBits is the biggest size of toy Diffie-Hellman prime
+const MaxDHBits = 62
+
+// DHGroup is the public part of Diffie-Hellman key exchange - prime p and generator g of the multiplicative group
+// modulo p.
+//
+// Alice and Bob choose secret a and b and publish A = g^a and B = g^b (mod p). Both can calculate the shared secret
+// B^a = A^b = g^(ab) (mod p), but an eavesdropper knowing only p, g, A and B has to find a or b - the discrete
+// logarithm, which is hard for big p (and easy for the toy groups here - see modular.DiscreteLog).
+type DHGroup struct {
+	p, g uint64
+
+	fmt.Stringer
+}
+
+// NewDHGroup creates the group for chosen prime p and primitive root g modulo p - like the textbook p = 23, g = 5
+func NewDHGroup(p *numbers.Z, g *numbers.Z) (*DHGroup, error) {
+	if p.Int64() < 3 || !primes.IsPrime(numbers.NFromUint64(uint64(p.Int64()))) {
+		return nil, fmt.Errorf("%s is not an odd prime", p)
+	}
+	if p.Int64() >= 1<<MaxDHBits {
+		return nil, fmt.Errorf("prime has to fit %d bits", MaxDHBits)
+	}
+	order, e := modular.OrderMod(g, p)
+	if e != nil || order.Uint64() != uint64(p.Int64()-1) {
+		return nil, fmt.Errorf("%s is not a primitive root modulo %s", g, p)
+	}
+	r, _ := modular.Mod(g, p)
+	return &DHGroup{p: uint64(p.Int64()), g: uint64(r.Int64())}, nil
+}
+
+// GenerateDHGroup creates a group for random prime p of given bits (3 <= bits <= MaxDHBits) and the smallest
+// primitive root modulo p
+func GenerateDHGroup(r *rand.Rand, bits int) (*DHGroup, error) {
+	if bits < 3 || bits > MaxDHBits {
+		return nil, fmt.Errorf("prime has to have between 3 and %d bits", MaxDHBits)
+	}
+	p, e := randomPrime(r, bits)
+	if e != nil {
+		return nil, e
+	}
+	g, e := modular.PrimitiveRoot(z(p))
+	if e != nil {
+		return nil, e
+	}
+	return NewDHGroup(z(p), g)
+}
+
+// P returns the prime modulus
+func (dh *DHGroup) P() *numbers.Z {
+	return z(dh.p)
+}
+
+// G returns the generator
+func (dh *DHGroup) G() *numbers.Z {
+	return z(dh.g)
+}
+
+// PrivateKey returns random secret 2 <= a <= p-2
+func (dh *DHGroup) PrivateKey(r *rand.Rand) *numbers.Z {
+	if dh.p < 5 {
+		// there's no choice for p = 3
+		return z(1)
+	}
+	return z(2 + r.Uint64N(dh.p-3))
+}
+
+// PublicKey returns A = g^a mod p for secret a
+func (dh *DHGroup) PublicKey(a *numbers.Z) (*numbers.Z, error) {
+	if e := dh.checkSecret(a); e != nil {
+		return nil, e
+	}
+	return modular.PowerMod(z(dh.g), a, z(dh.p))
+}
+
+// SharedSecret returns B^a mod p for own secret a and the other party's public key B
+func (dh *DHGroup) SharedSecret(a *numbers.Z, b *numbers.Z) (*numbers.Z, error) {
+	if e := dh.checkSecret(a); e != nil {
+		return nil, e
+	}
+	if b.Int64() <= 0 || uint64(b.Int64()) >= dh.p {
+		return nil, fmt.Errorf("public key has to be between 1 and %d", dh.p-1)
+	}
+	return modular.PowerMod(b, a, z(dh.p))
+}
+
+// ExchangeSteps returns the shared secret of Alice and Bob with secrets a and b, with all values of the exchange
+func (dh *DHGroup) ExchangeSteps(a *numbers.Z, b *numbers.Z) (*numbers.Z, []Step, error) {
+	pubA, e := dh.PublicKey(a)
+	if e != nil {
+		return nil, nil, e
+	}
+	pubB, e := dh.PublicKey(b)
+	if e != nil {
+		return nil, nil, e
+	}
+	sa, _ := dh.SharedSecret(a, pubB)
+	sb, _ := dh.SharedSecret(b, pubA)
+	steps := []Step{
+		{Name: "p", Value: z(dh.p)},
+		{Name: "g", Value: z(dh.g)},
+		{Name: "a (Alice's secret)", Value: a},
+		{Name: "b (Bob's secret)", Value: b},
+		{Name: "A (Alice sends)", Formula: fmt.Sprintf("g^a mod p = %d^%s mod %d", dh.g, a, dh.p), Value: pubA},
+		{Name: "B (Bob sends)", Formula: fmt.Sprintf("g^b mod p = %d^%s mod %d", dh.g, b, dh.p), Value: pubB},
+		{Name: "s (Alice)", Formula: fmt.Sprintf("B^a mod p = %s^%s mod %d", pubB, a, dh.p), Value: sa},
+		{Name: "s (Bob)", Formula: fmt.Sprintf("A^b mod p = %s^%s mod %d", pubA, b, dh.p), Value: sb},
+	}
+	return sa, steps, nil
+}
+
+// PrintExchange writes the exchange of Alice and Bob with secrets a and b step by step to w
+func (dh *DHGroup) PrintExchange(w io.Writer, a *numbers.Z, b *numbers.Z) (*numbers.Z, error) {
+	s, steps, e := dh.ExchangeSteps(a, b)
+	if e != nil {
+		return nil, e
+	}
+	return s, PrintSteps(w, steps)
+}
+
+// String formats dh as "DH(p=23, g=5)"
+func (dh *DHGroup) String() string {
+	return fmt.Sprintf("DH(p=%d, g=%d)", dh.p, dh.g)
+}
+
+// checkSecret checks if 1 <= a <= p-2
+func (dh *DHGroup) checkSecret(a *numbers.Z) error {
+	if a.Int64() < 1 || uint64(a.Int64()) > dh.p-2 {
+		return fmt.Errorf("secret has to be between 1 and %d", dh.p-2)
+	}
+	return nil
+}
+
+var _ = fmt.Stringer(&DHGroup{})
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cryptoedu
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestTextbookDH(t *testing.T) {
+	dh, e := NewDHGroup(zi(23), zi(5))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if dh.String() != "DH(p=23, g=5)" {
+		t.Errorf("String: %s", dh)
+	}
+	a, _ := dh.PublicKey(zi(6))
+	b, _ := dh.PublicKey(zi(15))
+	if a.Int64() != 8 || b.Int64() != 19 {
+		t.Errorf("public keys: %s, %s", a, b)
+	}
+	var out strings.Builder
+	s, e := dh.PrintExchange(&out, zi(6), zi(15))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if s.Int64() != 2 {
+		t.Errorf("shared secret: %s", s)
+	}
+	for _, line := range []string{
+		"p = 23\n",
+		"A (Alice sends) = g^a mod p = 5^6 mod 23 = 8\n",
+		"s (Alice) = B^a mod p = 19^6 mod 23 = 2\n",
+		"s (Bob) = A^b mod p = 8^15 mod 23 = 2\n",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("missing %q in:\n%s", line, out.String())
+		}
+	}
+}
+
+func TestInvalidDHGroups(t *testing.T) {
+	for _, c := range [][2]int64{{21, 2}, {2, 1}, {23, 2}, {23, 0}, {23, 23}} {
+		if _, e := NewDHGroup(zi(c[0]), zi(c[1])); e == nil {
+			t.Errorf("expected error for p=%d, g=%d", c[0], c[1])
+		}
+	}
+	dh, _ := NewDHGroup(zi(23), zi(5))
+	if _, e := dh.PublicKey(zi(22)); e == nil {
+		t.Error("expected error for secret p-1")
+	}
+	if _, e := dh.SharedSecret(zi(6), zi(0)); e == nil {
+		t.Error("expected error for public key 0")
+	}
+	if _, e := GenerateDHGroup(rand.New(rand.NewPCG(1, 2)), 2); e == nil {
+		t.Error("expected error for 2 bits")
+	}
+}
+
+func TestRandomDHExchange(t *testing.T) {
+	r := rand.New(rand.NewPCG(4, 7))
+	for _, bits := range []int{8, 16, 24} {
+		dh, e := GenerateDHGroup(r, bits)
+		if e != nil {
+			t.Fatal(e)
+		}
+		a, b := dh.PrivateKey(r), dh.PrivateKey(r)
+		pubA, _ := dh.PublicKey(a)
+		pubB, _ := dh.PublicKey(b)
+		sa, _ := dh.SharedSecret(a, pubB)
+		sb, _ := dh.SharedSecret(b, pubA)
+		if sa.Compare(sb) != 0 {
+			t.Errorf("%s: %s != %s", dh, sa, sb)
+		}
+	}
+}
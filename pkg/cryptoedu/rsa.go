@@ -0,0 +1,226 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cryptoedu
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/modular"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// MaxRSABits is the biggest size of toy RSA modulus, so it fits int64
+const MaxRSABits = 62
+
+// DefaultRSAExponent is the usual public exponent 2^16 + 1 - a prime with only two 1 bits
+const DefaultRSAExponent = 65537
+
+// RSAKey is a toy RSA key pair - public (n, e) and private d, with the primes p and q it's made of.
+//
+// For n = p·q, φ(n) = (p-1)(q-1) and e·d = 1 (mod φ(n)), (m^e)^d = m (mod n) by Euler's theorem (and for m not
+// coprime with n too, by the Chinese remainder theorem), so m^e mod n is undone by raising to d.
+type RSAKey struct {
+	p, q, n, phi, e, d uint64
+
+	fmt.Stringer
+}
+
+// GenerateRSAKey creates a key with modulus of about given bits (8 <= bits <= MaxRSABits) from two random primes of
+// half that size. The public exponent is DefaultRSAExponent or, if it's not coprime with φ(n) or too big, the least
+// suitable odd number.
+func GenerateRSAKey(r *rand.Rand, bits int) (*RSAKey, error) {
+	if bits < 8 || bits > MaxRSABits {
+		return nil, fmt.Errorf("modulus has to have between 8 and %d bits", MaxRSABits)
+	}
+	for {
+		p, e := randomPrime(r, bits/2)
+		if e != nil {
+			return nil, e
+		}
+		q, e := randomPrime(r, bits-bits/2)
+		if e != nil {
+			return nil, e
+		}
+		if p == q {
+			continue
+		}
+		phi := (p - 1) * (q - 1)
+		exp := uint64(DefaultRSAExponent)
+		for exp >= phi || modarith.GCD(exp, phi) != 1 {
+			if exp >= phi {
+				exp = 1
+			}
+			exp += 2
+		}
+		return NewRSAKey(z(p), z(q), z(exp))
+	}
+}
+
+// NewRSAKey creates a key from chosen different primes p and q and public exponent 1 < e < φ(n) coprime with φ(n) -
+// like the textbook p = 61, q = 53, e = 17
+func NewRSAKey(p *numbers.Z, q *numbers.Z, e *numbers.Z) (*RSAKey, error) {
+	for _, v := range []*numbers.Z{p, q} {
+		if v.Int64() < 2 || !primes.IsPrime(numbers.NFromUint64(uint64(v.Int64()))) {
+			return nil, fmt.Errorf("%s is not a prime", v)
+		}
+	}
+	if p.Int64() == q.Int64() {
+		return nil, errors.New("p and q have to be different")
+	}
+	pv, qv := uint64(p.Int64()), uint64(q.Int64())
+	if pv > (1<<MaxRSABits)/qv {
+		return nil, fmt.Errorf("modulus has to fit %d bits", MaxRSABits)
+	}
+	phi := (pv - 1) * (qv - 1)
+	if e.Int64() <= 1 || uint64(e.Int64()) >= phi {
+		return nil, fmt.Errorf("public exponent has to be between 1 and φ(n) = %d", phi)
+	}
+	d, err := modular.Inverse(e, z(phi))
+	if err != nil {
+		return nil, fmt.Errorf("public exponent %s is not coprime with φ(n) = %d", e, phi)
+	}
+	return &RSAKey{p: pv, q: qv, n: pv * qv, phi: phi, e: uint64(e.Int64()), d: uint64(d.Int64())}, nil
+}
+
+// N returns the modulus n = p·q - the public key together with E
+func (k *RSAKey) N() *numbers.Z {
+	return z(k.n)
+}
+
+// E returns the public exponent
+func (k *RSAKey) E() *numbers.Z {
+	return z(k.e)
+}
+
+// D returns the private exponent d = e^-1 mod φ(n)
+func (k *RSAKey) D() *numbers.Z {
+	return z(k.d)
+}
+
+// P returns the first prime factor of n
+func (k *RSAKey) P() *numbers.Z {
+	return z(k.p)
+}
+
+// Q returns the second prime factor of n
+func (k *RSAKey) Q() *numbers.Z {
+	return z(k.q)
+}
+
+// Totient returns φ(n) = (p-1)(q-1)
+func (k *RSAKey) Totient() *numbers.Z {
+	return z(k.phi)
+}
+
+// Steps returns the values calculated when creating k
+func (k *RSAKey) Steps() []Step {
+	return []Step{
+		{Name: "p", Value: z(k.p)},
+		{Name: "q", Value: z(k.q)},
+		{Name: "n", Formula: "p·q", Value: z(k.n)},
+		{Name: "φ(n)", Formula: "(p-1)(q-1)", Value: z(k.phi)},
+		{Name: "e", Value: z(k.e)},
+		{Name: "d", Formula: fmt.Sprintf("e^-1 mod %d", k.phi), Value: z(k.d)},
+		{Name: "e·d mod φ(n)", Value: z(modarith.MulMod(k.e, k.d, k.phi))},
+	}
+}
+
+// Encrypt returns c = m^e mod n for 0 <= m < n
+func (k *RSAKey) Encrypt(m *numbers.Z) (*numbers.Z, error) {
+	c, _, e := k.EncryptSteps(m)
+	return c, e
+}
+
+// EncryptSteps is Encrypt with the steps of repeated squaring
+func (k *RSAKey) EncryptSteps(m *numbers.Z) (*numbers.Z, []Step, error) {
+	if m.Int64() < 0 || uint64(m.Int64()) >= k.n {
+		return nil, nil, fmt.Errorf("message has to be between 0 and %d", k.n-1)
+	}
+	c, steps := powerSteps("m", uint64(m.Int64()), k.e, k.n)
+	return z(c), steps, nil
+}
+
+// Decrypt returns m = c^d mod n for 0 <= c < n
+func (k *RSAKey) Decrypt(c *numbers.Z) (*numbers.Z, error) {
+	m, _, e := k.DecryptSteps(c)
+	return m, e
+}
+
+// DecryptSteps is Decrypt with the steps of repeated squaring
+func (k *RSAKey) DecryptSteps(c *numbers.Z) (*numbers.Z, []Step, error) {
+	if c.Int64() < 0 || uint64(c.Int64()) >= k.n {
+		return nil, nil, fmt.Errorf("ciphertext has to be between 0 and %d", k.n-1)
+	}
+	m, steps := powerSteps("c", uint64(c.Int64()), k.d, k.n)
+	return z(m), steps, nil
+}
+
+// EncryptBytes encrypts every byte of a small message separately, so the bytes have to be less than n. It's
+// a textbook substitution cipher, which leaks the repeated letters - a lesson on why real RSA needs padding.
+func (k *RSAKey) EncryptBytes(message []byte) ([]*numbers.Z, error) {
+	res := make([]*numbers.Z, len(message))
+	for i, b := range message {
+		var e error
+		if res[i], e = k.Encrypt(z(uint64(b))); e != nil {
+			return nil, e
+		}
+	}
+	return res, nil
+}
+
+// DecryptBytes decrypts the result of EncryptBytes
+func (k *RSAKey) DecryptBytes(ciphertext []*numbers.Z) ([]byte, error) {
+	res := make([]byte, len(ciphertext))
+	for i, c := range ciphertext {
+		m, e := k.Decrypt(c)
+		if e != nil {
+			return nil, e
+		}
+		if m.Int64() > 255 {
+			return nil, fmt.Errorf("%s doesn't decrypt to a byte", c)
+		}
+		res[i] = byte(m.Int64())
+	}
+	return res, nil
+}
+
+// String formats the public key as "RSA(n=3233, e=17)"
+func (k *RSAKey) String() string {
+	return fmt.Sprintf("RSA(n=%d, e=%d)", k.n, k.e)
+}
+
+// randomPrime returns a random prime of given bits - the next prime after a random number with the highest bit set
+func randomPrime(r *rand.Rand, bits int) (uint64, error) {
+	for {
+		lo := uint64(1) << (bits - 1)
+		p, e := primes.Next(numbers.NFromUint64(lo + r.Uint64N(lo)))
+		if e != nil {
+			return 0, e
+		}
+		if p.Uint64() < lo<<1 {
+			return p.Uint64(), nil
+		}
+	}
+}
+
+var _ = fmt.Stringer(&RSAKey{})
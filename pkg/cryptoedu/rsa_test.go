@@ -0,0 +1,149 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cryptoedu
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func zi(v int64) *numbers.Z {
+	return numbers.ZFromInt64(v)
+}
+
+func TestTextbookRSA(t *testing.T) {
+	k, e := NewRSAKey(zi(61), zi(53), zi(17))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if k.N().Int64() != 3233 || k.Totient().Int64() != 3120 || k.D().Int64() != 2753 || k.E().Int64() != 17 ||
+		k.P().Int64() != 61 || k.Q().Int64() != 53 {
+		t.Errorf("unexpected key %v", k.Steps())
+	}
+	if s := k.String(); s != "RSA(n=3233, e=17)" {
+		t.Errorf("expected RSA(n=3233, e=17), got %s", s)
+	}
+	steps := fmt.Sprint(k.Steps())
+	if exp := "[p = 61 q = 53 n = p·q = 3233 φ(n) = (p-1)(q-1) = 3120 e = 17 d = e^-1 mod 3120 = 2753 " +
+		"e·d mod φ(n) = 1]"; steps != exp {
+		t.Errorf("expected %s, got %s", exp, steps)
+	}
+	c, e := k.Encrypt(zi(65))
+	if e != nil || c.Int64() != 2790 {
+		t.Errorf("expected 2790, got %v, %v", c, e)
+	}
+	if m, e := k.Decrypt(c); e != nil || m.Int64() != 65 {
+		t.Errorf("expected 65, got %v, %v", m, e)
+	}
+}
+
+func TestEncryptSteps(t *testing.T) {
+	k, _ := NewRSAKey(zi(61), zi(53), zi(17))
+	c, steps, e := k.EncryptSteps(zi(65))
+	if e != nil || c.Int64() != 2790 {
+		t.Fatalf("expected 2790, got %v, %v", c, e)
+	}
+	// 17 = 10001b: result = m, then four squarings and the last multiplication
+	if len(steps) != 6 {
+		t.Fatalf("expected 6 steps, got %v", steps)
+	}
+	for i, exp := range []string{"result = result·m^1 mod 3233 = 65", "m^2 = (m^1)^2 mod 3233 = 992",
+		"m^16 = (m^8)^2 mod 3233 = 789", "result = result·m^16 mod 3233 = 2790"} {
+		if s := steps[[]int{0, 1, 4, 5}[i]].String(); s != exp {
+			t.Errorf("expected %s, got %s", exp, s)
+		}
+	}
+	m, steps, e := k.DecryptSteps(c)
+	if e != nil || m.Int64() != 65 || steps[len(steps)-1].Value.Int64() != 65 {
+		t.Errorf("expected 65, got %v, %v, %v", m, steps, e)
+	}
+}
+
+func TestGenerateRSAKey(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, b := range []int{8, 9, 16, 31, 32, 48, MaxRSABits} {
+		k, e := GenerateRSAKey(r, b)
+		if e != nil {
+			t.Fatalf("%d bits: %v", b, e)
+		}
+		if n := bits.Len64(uint64(k.N().Int64())); n < b-1 || n > b {
+			t.Errorf("%d bits: got %s", b, k.N())
+		}
+		for range 20 {
+			m := zi(r.Int64N(k.N().Int64()))
+			c, e := k.Encrypt(m)
+			if e != nil {
+				t.Fatal(e)
+			}
+			if d, e := k.Decrypt(c); e != nil || d.Int64() != m.Int64() {
+				t.Errorf("%s: %s decrypted to %v, %v", k, m, d, e)
+			}
+		}
+	}
+	for _, b := range []int{7, MaxRSABits + 1} {
+		if _, e := GenerateRSAKey(r, b); e == nil {
+			t.Errorf("%d bits: expected error", b)
+		}
+	}
+}
+
+func TestRSAErrors(t *testing.T) {
+	for _, c := range [][3]int64{{61, 51, 17}, {61, 61, 17}, {61, 53, 3}, {61, 53, 1}, {61, 53, 3120},
+		{3037000493, 3037000453, 65537}} {
+		if _, e := NewRSAKey(zi(c[0]), zi(c[1]), zi(c[2])); e == nil {
+			t.Errorf("%v: expected error", c)
+		}
+	}
+	k, _ := NewRSAKey(zi(61), zi(53), zi(17))
+	for _, m := range []int64{-1, 3233} {
+		if _, e := k.Encrypt(zi(m)); e == nil {
+			t.Errorf("%d: expected error", m)
+		}
+		if _, e := k.Decrypt(zi(m)); e == nil {
+			t.Errorf("%d: expected error", m)
+		}
+	}
+}
+
+func TestEncryptBytes(t *testing.T) {
+	k, _ := GenerateRSAKey(rand.New(rand.NewPCG(3, 4)), 32)
+	c, e := k.EncryptBytes([]byte("HELLO"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	// the weakness of textbook RSA
+	if c[2].Int64() != c[3].Int64() {
+		t.Errorf("expected equal ciphertexts of L, got %v", c)
+	}
+	if m, e := k.DecryptBytes(c); e != nil || string(m) != "HELLO" {
+		t.Errorf("expected HELLO, got %q, %v", m, e)
+	}
+	small, _ := NewRSAKey(zi(11), zi(13), zi(7))
+	if _, e := small.EncryptBytes([]byte{200}); e == nil {
+		t.Errorf("expected error for byte not less than n")
+	}
+	big, _ := k.Encrypt(zi(1000))
+	if _, e := k.DecryptBytes([]*numbers.Z{big}); e == nil {
+		t.Errorf("expected error for ciphertext which isn't a byte")
+	}
+}
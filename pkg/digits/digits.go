@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package digits explores recreational properties of natural numbers which depend on their decimal digits
+package digits
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// KaprekarConstant is where Kaprekar's routine ends for every 4-digit number
+const KaprekarConstant = 6174
+
+// Digits returns decimal digits of n, the most significant first (single 0 for ZERO)
+func Digits(n *numbers.N) []int {
+	return digits(n.Uint64())
+}
+
+// digits returns decimal digits of v, the most significant first
+func digits(v uint64) []int {
+	return digitsIn(v, 10)
+}
+
+// digitsIn returns digits of v in given base >= 2, the most significant first
+func digitsIn(v uint64, base uint64) []int {
+	res := []int{int(v % base)}
+	for v /= base; v > 0; v /= base {
+		res = append(res, int(v%base))
+	}
+	slices.Reverse(res)
+	return res
+}
+
+// IsHappy checks if n is a happy number - replacing a number with the sum of squares of its digits eventually leads
+// to 1: 7 → 49 → 97 → 130 → 10 → 1. Other numbers end in the cycle 4 → 16 → 37 → 58 → 89 → 145 → 42 → 20 → 4.
+func IsHappy(n *numbers.N) bool {
+	v := n.Uint64()
+	for v != 1 && v != 4 && v != 0 {
+		sum := uint64(0)
+		for _, d := range digits(v) {
+			sum += uint64(d * d)
+		}
+		v = sum
+	}
+	return v == 1
+}
+
+// IsArmstrong checks if n is an Armstrong (narcissistic) number - the sum of its k digits raised to k-th power is n
+// itself: 153 = 1^3 + 5^3 + 3^3
+func IsArmstrong(n *numbers.N) bool {
+	v := n.Uint64()
+	ds := digits(v)
+	sum := uint64(0)
+	for _, d := range ds {
+		p := uint64(1)
+		for range ds {
+			p *= uint64(d) // 9^20 fits uint64
+		}
+		var carry uint64
+		if sum, carry = bits.Add64(sum, p, 0); carry != 0 {
+			return false
+		}
+	}
+	return sum == v
+}
+
+// IsHarshad checks if n > 0 is a Harshad (Niven) number - divisible by the sum of its digits: 18 = 2 * (1 + 8)
+func IsHarshad(n *numbers.N) bool {
+	v := n.Uint64()
+	if v == 0 {
+		return false
+	}
+	sum := uint64(0)
+	for _, d := range digits(v) {
+		sum += uint64(d)
+	}
+	return v%sum == 0
+}
+
+// IsKaprekar checks if n > 0 is a Kaprekar number - digits of n^2 can be split into two parts (the right one not
+// ZERO) which sum to n: 45^2 = 2025 and 20 + 25 = 45, 4879^2 = 23804641 and 238 + 04641 = 4879.
+func IsKaprekar(n *numbers.N) bool {
+	v := n.Uint64()
+	if v == 0 {
+		return false
+	}
+	// n^2 may not fit uint64
+	sq := new(big.Int).SetUint64(v)
+	sq.Mul(sq, sq)
+	target := new(big.Int).SetUint64(v)
+	ten := big.NewInt(10)
+	left, right, sum := new(big.Int), new(big.Int), new(big.Int)
+	for split := big.NewInt(10); ; split.Mul(split, ten) {
+		left.QuoRem(sq, split, right)
+		if right.Sign() != 0 && sum.Add(left, right).Cmp(target) == 0 {
+			return true
+		}
+		if left.Sign() == 0 {
+			return false
+		}
+	}
+}
+
+// Kaprekar returns the numbers reached by Kaprekar's routine from 4-digit n (with leading zeros, so 999 is 0999), which
+// has at least two different digits: the number made from its digits in descending order minus the number made from
+// them in ascending order. After at most 7 steps it always reaches KaprekarConstant 6174 = 7641 - 1467, which is the
+// last number returned: 3524 → 5432 - 2345 = 3087 → 8730 - 0378 = 8352 → 8532 - 2358 = 6174.
+func Kaprekar(n *numbers.N) ([]*numbers.N, error) {
+	v := n.Uint64()
+	if v > 9999 {
+		return nil, fmt.Errorf("%d has more than 4 digits", v)
+	}
+	if v%1111 == 0 {
+		return nil, errors.New("Kaprekar's routine needs at least two different digits")
+	}
+	res := make([]*numbers.N, 0)
+	for {
+		ds := digits(v + 10000)[1:]
+		slices.Sort(ds)
+		asc, desc := 0, 0
+		for i := range ds {
+			asc = asc*10 + ds[i]
+			desc = desc*10 + ds[len(ds)-1-i]
+		}
+		v = uint64(desc - asc)
+		res = append(res, numbers.NFromUint64(v))
+		if v == KaprekarConstant {
+			return res, nil
+		}
+	}
+}
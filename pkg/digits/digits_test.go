@@ -0,0 +1,124 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// find returns numbers 0 <= v < limit matching the predicate
+func find(limit uint64, pred func(*numbers.N) bool) []uint64 {
+	var res []uint64
+	for v := range limit {
+		if pred(numbers.NFromUint64(v)) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func TestDigits(t *testing.T) {
+	for v, exp := range map[uint64][]int{0: {0}, 7: {7}, 120: {1, 2, 0}, 18446744073709551615: {1, 8, 4, 4, 6, 7, 4,
+		4, 0, 7, 3, 7, 0, 9, 5, 5, 1, 6, 1, 5}} {
+		if d := Digits(numbers.NFromUint64(v)); !slices.Equal(d, exp) {
+			t.Errorf("%d: expected %v, got %v", v, exp, d)
+		}
+	}
+}
+
+func TestIsHappy(t *testing.T) {
+	exp := []uint64{1, 7, 10, 13, 19, 23, 28, 31, 32, 44, 49, 68, 70, 79, 82, 86, 91, 94, 97, 100}
+	if found := find(101, IsHappy); !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	// 1+64+16+16+36+49+16+16+0+49+9+49+0+81+25+25+1+36+1+25 = 515 → 51 → 26 → 40 → 16 → ... → 4
+	if IsHappy(numbers.NFromUint64(18446744073709551615)) {
+		t.Errorf("18446744073709551615 is not happy number")
+	}
+}
+
+func TestIsArmstrong(t *testing.T) {
+	exp := []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 153, 370, 371, 407, 1634, 8208, 9474, 54748, 92727, 93084}
+	if found := find(100000, IsArmstrong); !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	for _, v := range []uint64{4679307774, 1517841543307505039, 4929273885928088826} {
+		if !IsArmstrong(numbers.NFromUint64(v)) {
+			t.Errorf("%d is Armstrong number", v)
+		}
+	}
+	// the sum of powers doesn't fit uint64
+	if IsArmstrong(numbers.NFromUint64(18446744073709551615)) {
+		t.Errorf("18446744073709551615 is not Armstrong number")
+	}
+}
+
+func TestIsHarshad(t *testing.T) {
+	exp := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 18, 20, 21, 24, 27, 30, 36, 40, 42, 45, 48, 50}
+	if found := find(51, IsHarshad); !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+}
+
+func TestIsKaprekar(t *testing.T) {
+	exp := []uint64{1, 9, 45, 55, 99, 297, 703, 999, 2223, 2728, 4879, 4950, 5050, 5292, 7272, 7777, 9999}
+	if found := find(10000, IsKaprekar); !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	// n^2 doesn't fit uint64
+	if !IsKaprekar(numbers.NFromUint64(9999999999999999999)) {
+		t.Errorf("9999999999999999999 is Kaprekar number")
+	}
+}
+
+func TestKaprekar(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		3524: "3087 8352 6174",
+		6174: "6174",
+		999:  "8991 8082 8532 6174",
+		1:    "999 8991 8082 8532 6174",
+	} {
+		steps, e := Kaprekar(numbers.NFromUint64(v))
+		if e != nil {
+			t.Errorf("%d: unexpected error %v", v, e)
+			continue
+		}
+		var s []string
+		for _, n := range steps {
+			s = append(s, n.String())
+		}
+		if strings.Join(s, " ") != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, strings.Join(s, " "))
+		}
+	}
+	for v := range uint64(10000) {
+		if steps, e := Kaprekar(numbers.NFromUint64(v)); e == nil && len(steps) > 7 {
+			t.Errorf("%d: %d steps", v, len(steps))
+		}
+	}
+	for _, v := range []uint64{0, 1111, 7777, 10000} {
+		if _, e := Kaprekar(numbers.NFromUint64(v)); e == nil {
+			t.Errorf("%d: expected error", v)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// DivisibilityExplanation shows how a divisibility rule decides if n is divisible by d using only the decimal digits
+// of n: the rule, the digit manipulations performed and the verdict
+type DivisibilityExplanation struct {
+	n         uint64
+	d         int
+	rule      string
+	steps     []string
+	divisible bool
+
+	fmt.Stringer
+}
+
+// ExplainDivisibility applies the divisibility rule for d ∈ {2, 3, 4, 5, 6, 8, 9, 11} to n:
+//   - 2, 5: the last digit is even, or 0 or 5
+//   - 4, 8: the number made of the last 2 or 3 digits is divisible (100 and 1000 are divisible by 4 and 8)
+//   - 3, 9: the sum of digits is divisible (10 ≡ 1 mod 3 and 9), repeated until it's a single digit
+//   - 6: both the rules for 2 and 3
+//   - 11: the alternating sum of digits from the right is divisible (10 ≡ -1 mod 11), repeated until it's below 11
+func ExplainDivisibility(n *numbers.N, d int) (*DivisibilityExplanation, error) {
+	x := &DivisibilityExplanation{n: n.Uint64(), d: d}
+	switch d {
+	case 2:
+		x.rule = "a number is divisible by 2 if its last digit is even"
+		x.divisible = x.lastDigits(1, func(v uint64) bool { return v%2 == 0 })
+	case 5:
+		x.rule = "a number is divisible by 5 if its last digit is 0 or 5"
+		x.divisible = x.lastDigits(1, func(v uint64) bool { return v == 0 || v == 5 })
+	case 4:
+		x.rule = "a number is divisible by 4 if the number made of its last 2 digits is divisible by 4"
+		x.divisible = x.lastDigits(2, x.smallMultiple(4))
+	case 8:
+		x.rule = "a number is divisible by 8 if the number made of its last 3 digits is divisible by 8"
+		x.divisible = x.lastDigits(3, x.smallMultiple(8))
+	case 3, 9:
+		x.rule = fmt.Sprintf("a number is divisible by %d if the sum of its digits is divisible by %d", d, d)
+		x.divisible = x.digitSum(uint64(d))
+	case 6:
+		x.rule = "a number is divisible by 6 if it's divisible by both 2 and 3: its last digit is even and the sum " +
+			"of its digits is divisible by 3"
+		even := x.lastDigits(1, func(v uint64) bool { return v%2 == 0 })
+		x.divisible = x.digitSum(3) && even
+	case 11:
+		x.rule = "a number is divisible by 11 if the alternating sum of its digits (from the right) is divisible by 11"
+		x.divisible = x.alternatingSum()
+	default:
+		return nil, fmt.Errorf("no rule implemented for %d, expected one of 2, 3, 4, 5, 6, 8, 9, 11", d)
+	}
+	return x, nil
+}
+
+// N returns the checked number
+func (x *DivisibilityExplanation) N() *numbers.N {
+	return numbers.NFromUint64(x.n)
+}
+
+// Divisor returns the divisor of the rule
+func (x *DivisibilityExplanation) Divisor() int {
+	return x.d
+}
+
+// Rule returns the description of the rule
+func (x *DivisibilityExplanation) Rule() string {
+	return x.rule
+}
+
+// Steps returns the digit manipulations in the order they were performed
+func (x *DivisibilityExplanation) Steps() []string {
+	return append([]string(nil), x.steps...)
+}
+
+// Divisible returns the verdict
+func (x *DivisibilityExplanation) Divisible() bool {
+	return x.divisible
+}
+
+// Verdict returns the verdict as a sentence: "1234 is not divisible by 4"
+func (x *DivisibilityExplanation) Verdict() string {
+	if x.divisible {
+		return fmt.Sprintf("%d is divisible by %d", x.n, x.d)
+	}
+	return fmt.Sprintf("%d is not divisible by %d", x.n, x.d)
+}
+
+// String returns the rule, the steps and the verdict, one per line
+func (x *DivisibilityExplanation) String() string {
+	lines := append(append([]string{"rule: " + x.rule}, x.steps...), "verdict: "+x.Verdict())
+	return strings.Join(lines, "\n")
+}
+
+// step records a digit manipulation
+func (x *DivisibilityExplanation) step(format string, args ...any) {
+	x.steps = append(x.steps, fmt.Sprintf(format, args...))
+}
+
+// lastDigits checks the number made of the last k digits of n with the test
+func (x *DivisibilityExplanation) lastDigits(k int, test func(uint64) bool) bool {
+	ds := digits(x.n)
+	if len(ds) > k {
+		ds = ds[len(ds)-k:]
+	}
+	v := uint64(0)
+	for _, d := range ds {
+		v = v*10 + uint64(d)
+	}
+	if k == 1 {
+		x.step("the last digit of %d is %d", x.n, v)
+	} else {
+		x.step("the last %d digits of %d make %d", k, x.n, v)
+	}
+	ok := test(v)
+	if ok {
+		x.step("%d passes the test", v)
+	} else {
+		x.step("%d fails the test", v)
+	}
+	return ok
+}
+
+// smallMultiple returns the test if a small number is a multiple of m, which records the multiplication
+func (x *DivisibilityExplanation) smallMultiple(m uint64) func(uint64) bool {
+	return func(v uint64) bool {
+		if v%m == 0 {
+			x.step("%d = %d·%d", v, m, v/m)
+			return true
+		}
+		x.step("%d = %d·%d + %d", v, m, v/m, v%m)
+		return false
+	}
+}
+
+// digitSum sums the digits of n until it's a single digit, which is then checked for divisibility by m (3 or 9)
+func (x *DivisibilityExplanation) digitSum(m uint64) bool {
+	v := x.n
+	for v >= 10 {
+		ds := digits(v)
+		terms := make([]string, len(ds))
+		v = 0
+		for i, d := range ds {
+			terms[i] = fmt.Sprint(d)
+			v += uint64(d)
+		}
+		x.step("%s = %d", strings.Join(terms, " + "), v)
+	}
+	ok := v%m == 0
+	if ok {
+		x.step("%d is divisible by %d", v, m)
+	} else {
+		x.step("%d is not divisible by %d", v, m)
+	}
+	return ok
+}
+
+// alternatingSum adds and subtracts the digits of n starting with the last one until the absolute value of the sum
+// is below 11, which is then checked for being 0
+func (x *DivisibilityExplanation) alternatingSum() bool {
+	v := x.n
+	for v >= 11 {
+		ds := digits(v)
+		var sb strings.Builder
+		sum := int64(0)
+		for i := len(ds) - 1; i >= 0; i-- {
+			sign := int64(1)
+			if (len(ds)-1-i)%2 == 1 {
+				sign = -1
+			}
+			sum += sign * int64(ds[i])
+			switch {
+			case i == len(ds)-1:
+				fmt.Fprintf(&sb, "%d", ds[i])
+			case sign < 0:
+				fmt.Fprintf(&sb, " - %d", ds[i])
+			default:
+				fmt.Fprintf(&sb, " + %d", ds[i])
+			}
+		}
+		x.step("%s = %d", sb.String(), sum)
+		if sum < 0 {
+			sum = -sum
+		}
+		v = uint64(sum)
+	}
+	ok := v == 0
+	if ok {
+		x.step("the sum is 0")
+	} else {
+		x.step("%d is not divisible by 11", v)
+	}
+	return ok
+}
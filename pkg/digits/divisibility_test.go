@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestExplainDivisibilityVerdicts(t *testing.T) {
+	for _, d := range []int{2, 3, 4, 5, 6, 8, 9, 11} {
+		for _, n := range []uint64{0, 1, 7, 10, 11, 12, 99, 121, 918082, 1234, 1000, 123456789, 18446744073709551615} {
+			x, e := ExplainDivisibility(numbers.NFromUint64(n), d)
+			if e != nil {
+				t.Fatal(e)
+			}
+			if x.Divisible() != (n%uint64(d) == 0) {
+				t.Errorf("%d by %d: wrong verdict\n%s", n, d, x)
+			}
+		}
+	}
+}
+
+func TestExplainDivisibilitySteps(t *testing.T) {
+	for _, c := range []struct {
+		n     uint64
+		d     int
+		steps []string
+	}{
+		{1234, 4, []string{"the last 2 digits of 1234 make 34", "34 = 4·8 + 2", "34 fails the test"}},
+		{98765, 9, []string{"9 + 8 + 7 + 6 + 5 = 35", "3 + 5 = 8", "8 is not divisible by 9"}},
+		{918082, 11, []string{"2 - 8 + 0 - 8 + 1 - 9 = -22", "2 - 2 = 0", "the sum is 0"}},
+		{5, 5, []string{"the last digit of 5 is 5", "5 passes the test"}},
+	} {
+		x, _ := ExplainDivisibility(numbers.NFromUint64(c.n), c.d)
+		if !slices.Equal(x.Steps(), c.steps) {
+			t.Errorf("%d by %d: expected %q, got %q", c.n, c.d, c.steps, x.Steps())
+		}
+	}
+}
+
+func TestExplainDivisibilityString(t *testing.T) {
+	x, _ := ExplainDivisibility(numbers.NFromUint64(114), 6)
+	s := x.String()
+	if !strings.HasPrefix(s, "rule: a number is divisible by 6") || !strings.HasSuffix(s, "verdict: 114 is divisible by 6") {
+		t.Errorf("unexpected:\n%s", s)
+	}
+	if x.N().Uint64() != 114 || x.Divisor() != 6 || len(x.Steps()) != 4 {
+		t.Errorf("unexpected %s, %d, %q", x.N(), x.Divisor(), x.Steps())
+	}
+}
+
+func TestExplainDivisibilityUnknownRule(t *testing.T) {
+	// 7 has divisibility rules, but none of them is implemented
+	expected := "no rule implemented for 7, expected one of 2, 3, 4, 5, 6, 8, 9, 11"
+	if _, e := ExplainDivisibility(numbers.NFromUint64(14), 7); e == nil || e.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, e)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// DigitHistogram returns how many times each decimal digit occurs in n: for 1223 it's 1 for 1 and 3, 2 for 2 and 0
+// for the other digits
+func DigitHistogram(n *numbers.N) [10]int {
+	var res [10]int
+	for _, d := range digits(n.Uint64()) {
+		res[d]++
+	}
+	return res
+}
+
+// IsPandigital checks if every digit of given base >= 2 occurs in n at least once: 1023456789 is pandigital in base
+// 10 and 0b10 in base 2. Puzzles using each digit exactly once can also check the number of digits.
+func IsPandigital(n *numbers.N, base int) bool {
+	if base < 2 {
+		return false
+	}
+	seen := make([]bool, base)
+	missing := base
+	for _, d := range digitsIn(n.Uint64(), uint64(base)) {
+		if !seen[d] {
+			seen[d] = true
+			missing--
+		}
+	}
+	return missing == 0
+}
+
+// ContainsDigit checks if decimal digit d occurs in n
+func ContainsDigit(n *numbers.N, d int) bool {
+	for _, nd := range digits(n.Uint64()) {
+		if nd == d {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDigitHistogram(t *testing.T) {
+	for v, exp := range map[uint64][10]int{
+		0:                    {1},
+		1223:                 {0, 1, 2, 1},
+		1000000:              {6, 1},
+		18446744073709551615: {2, 3, 0, 1, 4, 3, 2, 3, 1, 1},
+	} {
+		if h := DigitHistogram(numbers.NFromUint64(v)); h != exp {
+			t.Errorf("%d: expected %v, got %v", v, exp, h)
+		}
+	}
+}
+
+func TestIsPandigital(t *testing.T) {
+	for _, c := range []struct {
+		v    uint64
+		base int
+		exp  bool
+	}{
+		{1023456789, 10, true},
+		{123456789, 10, false},
+		{9876543210123, 10, true},
+		{2, 2, true},
+		{1, 2, false},
+		{11, 3, true}, // 102
+		{0x1023456789abcdef, 16, true},
+		{0x123456789abcdef, 16, false},
+		{10, 1, false},
+	} {
+		if IsPandigital(numbers.NFromUint64(c.v), c.base) != c.exp {
+			t.Errorf("%d (base %d): expected %v", c.v, c.base, c.exp)
+		}
+	}
+}
+
+func TestContainsDigit(t *testing.T) {
+	n := numbers.NFromUint64(1203)
+	for d, exp := range []bool{true, true, true, true, false, false, false, false, false, false} {
+		if ContainsDigit(n, d) != exp {
+			t.Errorf("%d: expected %v", d, exp)
+		}
+	}
+	if !ContainsDigit(numbers.NFromUint64(0), 0) || ContainsDigit(numbers.NFromUint64(0), 10) {
+		t.Errorf("wrong digits of ZERO")
+	}
+}
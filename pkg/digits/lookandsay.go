@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"errors"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// LookAndSay returns lazy iterator over given number of terms of look-and-say sequence starting with seed: each term
+// reads the digits of the previous one aloud - 1 is "one 1", so the next term is 11, then "two 1s" is 21, then 1211,
+// 111221, 312211, ... Terms are decimal digit strings, because their length grows by about 30% in each step - short
+// ones can be turned back into N with numbers.ParseN.
+func LookAndSay(seed *numbers.N, steps int) (iter.Seq[string], error) {
+	if steps < 0 {
+		return nil, errors.New("number of steps can't be negative")
+	}
+	return func(yield func(string) bool) {
+		term := seed.String()
+		for range steps {
+			if !yield(term) {
+				return
+			}
+			term = lookAndSay(term)
+		}
+	}, nil
+}
+
+// lookAndSay returns the next term of look-and-say sequence - the count of each run of equal digits followed by the
+// digit
+func lookAndSay(term string) string {
+	var sb strings.Builder
+	for i := 0; i < len(term); {
+		j := i + 1
+		for j < len(term) && term[j] == term[i] {
+			j++
+		}
+		sb.WriteString(strconv.Itoa(j - i))
+		sb.WriteByte(term[i])
+		i = j
+	}
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestLookAndSay(t *testing.T) {
+	for _, c := range []struct {
+		seed  uint64
+		steps int
+		exp   string
+	}{
+		{1, 8, "1 11 21 1211 111221 312211 13112221 1113213211"},
+		{22, 3, "22 22 22"},
+		{0, 4, "0 10 1110 3110"},
+		{3, 0, ""},
+	} {
+		terms, e := LookAndSay(numbers.NFromUint64(c.seed), c.steps)
+		if e != nil {
+			t.Errorf("%d: unexpected error %v", c.seed, e)
+			continue
+		}
+		if s := strings.Join(slices.Collect(terms), " "); s != c.exp {
+			t.Errorf("%d: expected %q, got %q", c.seed, c.exp, s)
+		}
+	}
+
+	// Conway's constant - the length grows about 1.303577 times per step
+	terms, _ := LookAndSay(numbers.OneN(), 51)
+	var lengths []int
+	for term := range terms {
+		lengths = append(lengths, len(term))
+	}
+	if lengths[50] != 1166642 {
+		t.Errorf("expected 1166642 digits of 51st term, got %d", lengths[50])
+	}
+	if _, e := LookAndSay(numbers.OneN(), -1); e == nil {
+		t.Errorf("expected error for negative steps")
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// IsPalindrome checks if decimal digits of n read the same backwards: 0, 7, 121, 1221
+func IsPalindrome(n *numbers.N) bool {
+	return isPalindrome(digits(n.Uint64()))
+}
+
+// NextPalindrome returns the smallest palindrome greater than n, or numbers.ErrOverflow if it doesn't fit uint64.
+//
+// The left half of n is mirrored to the right. If that's not bigger than n, the left half (with the middle digit) is
+// increased by 1 first - 99...9 + 1 gets one more digit and the next palindrome is 10...01.
+func NextPalindrome(n *numbers.N) (*numbers.N, error) {
+	v := n.Uint64()
+	if v == math.MaxUint64 {
+		return nil, numbers.ErrOverflow
+	}
+	ds := digits(v)
+	k := len(ds)
+	left := ds[:(k+1)/2]
+	p := mirror(left, k)
+	if p <= v {
+		half := uint64(0)
+		for _, d := range left {
+			half = half*10 + uint64(d)
+		}
+		grown := digits(half + 1)
+		if len(grown) > len(left) {
+			// 99...9 -> 10...01
+			k++
+			grown = grown[:(k+1)/2]
+		}
+		p = mirror(grown, k)
+	}
+	if p <= v {
+		// 0 from mirror
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.NFromUint64(p), nil
+}
+
+// mirror returns the k-digit palindrome starting with given left half (with the middle digit for odd k) or 0 if it
+// doesn't fit uint64
+func mirror(left []int, k int) uint64 {
+	p := uint64(0)
+	for _, d := range left {
+		p = p*10 + uint64(d)
+	}
+	for i := k/2 - 1; i >= 0; i-- {
+		hi, lo := bits.Mul64(p, 10)
+		var carry uint64
+		if p, carry = bits.Add64(lo, uint64(left[i]), 0); hi != 0 || carry != 0 {
+			return 0
+		}
+	}
+	return p
+}
+
+// ReverseAndAdd repeatedly adds n and the number made from its digits in reverse order until the sum is a palindrome:
+// 89 + 98 = 187, 187 + 781 = 968, ... and after 24 steps it's 8813200023188. It returns this palindrome (as decimal
+// digits, because it quickly doesn't fit uint64) and the number of steps (at least one). Numbers which never reach a
+// palindrome are called Lychrel numbers - 196 is the smallest candidate - so an error is returned after maxSteps.
+func ReverseAndAdd(n *numbers.N, maxSteps int) (string, int, error) {
+	// least significant digit first
+	ds := digits(n.Uint64())
+	slices.Reverse(ds)
+	for step := 1; step <= maxSteps; step++ {
+		sum := make([]int, 0, len(ds)+1)
+		carry := 0
+		for i := range ds {
+			s := ds[i] + ds[len(ds)-1-i] + carry
+			sum, carry = append(sum, s%10), s/10
+		}
+		if carry > 0 {
+			sum = append(sum, carry)
+		}
+		ds = sum
+		if isPalindrome(ds) {
+			var sb strings.Builder
+			for i := len(ds) - 1; i >= 0; i-- {
+				sb.WriteByte(byte('0' + ds[i]))
+			}
+			return sb.String(), step, nil
+		}
+	}
+	return "", maxSteps, fmt.Errorf("%s doesn't reach a palindrome in %d steps", n, maxSteps)
+}
+
+// isPalindrome checks if digits read the same backwards
+func isPalindrome(ds []int) bool {
+	for i := range len(ds) / 2 {
+		if ds[i] != ds[len(ds)-1-i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package digits
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestIsPalindrome(t *testing.T) {
+	for v, exp := range map[uint64]bool{0: true, 7: true, 10: false, 121: true, 1221: true, 1231: false,
+		18446744066044764481: true} {
+		if IsPalindrome(numbers.NFromUint64(v)) != exp {
+			t.Errorf("%d: expected %v", v, exp)
+		}
+	}
+}
+
+func TestNextPalindrome(t *testing.T) {
+	for v, exp := range map[uint64]uint64{
+		0: 1, 8: 9, 9: 11, 10: 11, 11: 22, 99: 101, 120: 121, 121: 131, 129: 131, 191: 202, 999: 1001,
+		1234: 1331, 12921: 13031, 9999999999: 10000000001,
+		18446744066044764480: 18446744066044764481,
+	} {
+		p, e := NextPalindrome(numbers.NFromUint64(v))
+		if e != nil || p.Uint64() != exp {
+			t.Errorf("%d: expected %d, got %v, %v", v, exp, p, e)
+		}
+	}
+	for _, v := range []uint64{18446744066044764481, 18446744073709551615} {
+		if _, e := NextPalindrome(numbers.NFromUint64(v)); e != numbers.ErrOverflow {
+			t.Errorf("%d: expected overflow, got %v", v, e)
+		}
+	}
+	// compared with checking every number
+	for v := uint64(0); v < 20000; v++ {
+		p, _ := NextPalindrome(numbers.NFromUint64(v))
+		exp := v + 1
+		for !IsPalindrome(numbers.NFromUint64(exp)) {
+			exp++
+		}
+		if p.Uint64() != exp {
+			t.Fatalf("%d: expected %d, got %s", v, exp, p)
+		}
+	}
+}
+
+func TestReverseAndAdd(t *testing.T) {
+	for _, c := range []struct {
+		v     uint64
+		exp   string
+		steps int
+	}{
+		{56, "121", 1},
+		{57, "363", 2},
+		{11, "22", 1},
+		{89, "8813200023188", 24},
+		{10911, "4668731596684224866951378664", 55},
+		{1186060307891929990, "44562665878976437622437848976653870388884783662598425855963436955852489526638748888307835667984873422673467987856626544", 261},
+	} {
+		p, steps, e := ReverseAndAdd(numbers.NFromUint64(c.v), 1000)
+		if e != nil || p != c.exp || steps != c.steps {
+			t.Errorf("%d: expected %s after %d steps, got %s, %d, %v", c.v, c.exp, c.steps, p, steps, e)
+		}
+	}
+	if _, _, e := ReverseAndAdd(numbers.NFromUint64(196), 1000); e == nil {
+		t.Errorf("196: expected error")
+	}
+}
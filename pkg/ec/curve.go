@@ -0,0 +1,229 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package ec implements elliptic curves y^2 = x^3 + ax + b (short Weierstrass form) over any field with exact
+// arithmetic - GF(p) for the toy cryptography and ℚ for small examples - with the group law of chords and tangents
+package ec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/laws"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Curve is an elliptic curve y^2 = x^3 + ax + b over field T. Curves and their points are immutable.
+type Curve[T laws.Field[T]] struct {
+	a T
+	b T
+
+	fmt.Stringer
+}
+
+// Point is a point of a curve - (x, y) or the point at infinity O, which is the identity of the group
+type Point[T laws.Field[T]] struct {
+	x        T
+	y        T
+	infinity bool
+
+	fmt.Stringer
+}
+
+// NewCurve creates y^2 = x^3 + ax + b. The curve has to be smooth (4a^3 + 27b^2 != 0) and the characteristic of the
+// field can't be 2 or 3, where short Weierstrass form doesn't describe all curves (and the tangent needs division
+// by 2).
+func NewCurve[T laws.Field[T]](a T, b T) (*Curve[T], error) {
+	u := a
+	if isZero(a) {
+		u = b
+	}
+	one, e := u.Divide(u)
+	if e != nil {
+		return nil, errors.New("the curve is singular: 4a^3 + 27b^2 = 0")
+	}
+	if isZero(times(2, one)) || isZero(times(3, one)) {
+		return nil, errors.New("short Weierstrass form needs the characteristic other than 2 and 3")
+	}
+	if isZero(times(4, a.Multiply(a).Multiply(a)).Add(times(27, b.Multiply(b)))) {
+		return nil, errors.New("the curve is singular: 4a^3 + 27b^2 = 0")
+	}
+	return &Curve[T]{a: a, b: b}, nil
+}
+
+// A returns a of y^2 = x^3 + ax + b
+func (c *Curve[T]) A() T {
+	return c.a
+}
+
+// B returns b of y^2 = x^3 + ax + b
+func (c *Curve[T]) B() T {
+	return c.b
+}
+
+// Point returns (x, y) or an error, if it's not on c
+func (c *Curve[T]) Point(x T, y T) (*Point[T], error) {
+	p := &Point[T]{x: x, y: y}
+	if !c.Contains(p) {
+		return nil, fmt.Errorf("%s is not on %s", p, c)
+	}
+	return p, nil
+}
+
+// Infinity returns the point at infinity O
+func (c *Curve[T]) Infinity() *Point[T] {
+	return &Point[T]{infinity: true}
+}
+
+// Contains checks if p is on c
+func (c *Curve[T]) Contains(p *Point[T]) bool {
+	if p.infinity {
+		return true
+	}
+	return p.y.Multiply(p.y).Compare(c.rhs(p.x)) == 0
+}
+
+// Negate returns -p = (x, -y) - the other point of c on the vertical line through p
+func (c *Curve[T]) Negate(p *Point[T]) *Point[T] {
+	if p.infinity {
+		return p
+	}
+	return &Point[T]{x: p.x, y: p.y.Subtract(p.y).Subtract(p.y)}
+}
+
+// Add returns p + q. The line through p and q (the tangent for p = q) meets c in the third point -(p + q), so for
+// its slope λ, x = λ^2 - x1 - x2 and y = λ(x1 - x) - y1. Vertical lines meet c at O, so p + (-p) = O. Returns
+// numbers.ErrOverflow when the coordinates don't fit the representation (heights of rational points grow fast).
+func (c *Curve[T]) Add(p *Point[T], q *Point[T]) (res *Point[T], e error) {
+	defer recoverOverflow(&e)
+	switch {
+	case p.infinity:
+		return q, nil
+	case q.infinity:
+		return p, nil
+	}
+	var slope T
+	if p.x.Compare(q.x) == 0 {
+		if p.y.Compare(q.y) != 0 || isZero(p.y) {
+			return c.Infinity(), nil
+		}
+		// the tangent: λ = (3x^2 + a) / 2y
+		slope, e = times(3, p.x.Multiply(p.x)).Add(c.a).Divide(times(2, p.y))
+	} else {
+		slope, e = q.y.Subtract(p.y).Divide(q.x.Subtract(p.x))
+	}
+	if e != nil {
+		return nil, e
+	}
+	x := slope.Multiply(slope).Subtract(p.x).Subtract(q.x)
+	return &Point[T]{x: x, y: slope.Multiply(p.x.Subtract(x)).Subtract(p.y)}, nil
+}
+
+// ScalarMultiply returns k·p = p + ... + p (k times) by doubling and adding - in log(k) steps. Negative k means
+// the multiple of -p.
+func (c *Curve[T]) ScalarMultiply(k *numbers.Z, p *Point[T]) (*Point[T], error) {
+	n := k.Int64()
+	if n < 0 {
+		p = c.Negate(p)
+	}
+	res := c.Infinity()
+	for u := modarith.AbsUint64(n); u > 0; u >>= 1 {
+		var e error
+		if u&1 == 1 {
+			if res, e = c.Add(res, p); e != nil {
+				return nil, e
+			}
+		}
+		if u > 1 {
+			if p, e = c.Add(p, p); e != nil {
+				return nil, e
+			}
+		}
+	}
+	return res, nil
+}
+
+// String formats c as "y^2 = x^3 + ax + b"
+func (c *Curve[T]) String() string {
+	return fmt.Sprintf("y^2 = x^3 + %sx + %s", fmt.Sprint(c.a), fmt.Sprint(c.b))
+}
+
+// X returns x of p (undefined for O)
+func (p *Point[T]) X() T {
+	return p.x
+}
+
+// Y returns y of p (undefined for O)
+func (p *Point[T]) Y() T {
+	return p.y
+}
+
+// IsInfinity checks if p is the point at infinity O
+func (p *Point[T]) IsInfinity() bool {
+	return p.infinity
+}
+
+// Equal checks if p and q are the same point
+func (p *Point[T]) Equal(q *Point[T]) bool {
+	if p.infinity || q.infinity {
+		return p.infinity == q.infinity
+	}
+	return p.x.Compare(q.x) == 0 && p.y.Compare(q.y) == 0
+}
+
+// String formats p as "(x, y)" or "O"
+func (p *Point[T]) String() string {
+	if p.infinity {
+		return "O"
+	}
+	return fmt.Sprintf("(%s, %s)", fmt.Sprint(p.x), fmt.Sprint(p.y))
+}
+
+// rhs returns x^3 + ax + b
+func (c *Curve[T]) rhs(x T) T {
+	return x.Multiply(x).Multiply(x).Add(c.a.Multiply(x)).Add(c.b)
+}
+
+// isZero checks if v is the additive identity
+func isZero[T laws.Field[T]](v T) bool {
+	return v.Compare(v.Subtract(v)) == 0
+}
+
+// times returns v + ... + v (n > 0 times)
+func times[T laws.Field[T]](n int, v T) T {
+	res := v
+	for range n - 1 {
+		res = res.Add(v)
+	}
+	return res
+}
+
+// recoverOverflow turns numbers.ErrOverflow panic of unchecked operations into an error
+func recoverOverflow(e *error) {
+	if p := recover(); p != nil {
+		if err, ok := p.(error); ok && errors.Is(err, numbers.ErrOverflow) {
+			*e = err
+			return
+		}
+		panic(p)
+	}
+}
+
+var _ = fmt.Stringer(&Curve[*numbers.Q]{})
+var _ = fmt.Stringer(&Point[*numbers.Q]{})
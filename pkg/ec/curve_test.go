@@ -0,0 +1,139 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/gf"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func z(v int64) *numbers.Z {
+	return numbers.ZFromInt64(v)
+}
+
+// curve returns y^2 = x^3 + ax + b over GF(p)
+func curve(t *testing.T, p uint64, a int64, b int64) *Curve[*gf.Element] {
+	t.Helper()
+	f, e := gf.NewPrimeField(numbers.NFromUint64(p))
+	if e != nil {
+		t.Fatal(e)
+	}
+	c, e := NewCurve(f.Element(z(a)), f.Element(z(b)))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return c
+}
+
+func point(t *testing.T, c *Curve[*gf.Element], x int64, y int64) *Point[*gf.Element] {
+	t.Helper()
+	f := c.A().Field()
+	p, e := c.Point(f.Element(z(x)), f.Element(z(y)))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return p
+}
+
+func TestNewCurve(t *testing.T) {
+	c := curve(t, 17, 2, 2)
+	if s := c.String(); s != "y^2 = x^3 + 2x + 2" {
+		t.Errorf("expected y^2 = x^3 + 2x + 2, got %s", s)
+	}
+	for _, ab := range [][2]string{{"0/1", "0/1"}, {"-3/1", "2/1"}} {
+		if _, e := NewCurve(numbers.NewQ(ab[0]), numbers.NewQ(ab[1])); e == nil {
+			t.Errorf("%v: expected singular curve", ab)
+		}
+	}
+	for _, p := range []uint64{2, 3} {
+		f, _ := gf.NewPrimeField(numbers.NFromUint64(p))
+		if _, e := NewCurve(f.One(), f.One()); e == nil {
+			t.Errorf("GF(%d): expected error", p)
+		}
+	}
+	if _, e := c.Point(c.A().Field().One(), c.A().Field().One()); e == nil {
+		t.Errorf("expected error for (1, 1)")
+	}
+}
+
+func TestGroupLawOverGF(t *testing.T) {
+	// the textbook curve with 19 points, generated by (5, 1)
+	c := curve(t, 17, 2, 2)
+	g := point(t, c, 5, 1)
+	for k, exp := range map[int64]string{0: "O", 1: "(5, 1)", 2: "(6, 3)", 3: "(10, 6)", 9: "(7, 6)", 18: "(5, 16)",
+		19: "O", -1: "(5, 16)", 20: "(5, 1)"} {
+		if p, e := c.ScalarMultiply(z(k), g); e != nil || p.String() != exp {
+			t.Errorf("%d·G: expected %s, got %v, %v", k, exp, p, e)
+		}
+	}
+	if p, _ := c.Add(g, c.Negate(g)); !p.IsInfinity() {
+		t.Errorf("G + (-G): expected O, got %s", p)
+	}
+	if p, _ := c.Add(c.Infinity(), g); !p.Equal(g) {
+		t.Errorf("O + G: expected G, got %s", p)
+	}
+	if !c.Negate(c.Infinity()).IsInfinity() || !c.Contains(c.Infinity()) {
+		t.Errorf("-O: expected O")
+	}
+}
+
+func TestGroupLawsHold(t *testing.T) {
+	c := curve(t, 23, 1, 1)
+	points, e := Points(c)
+	if e != nil {
+		t.Fatal(e)
+	}
+	for i, p := range points {
+		for j, q := range points {
+			pq, _ := c.Add(p, q)
+			qp, _ := c.Add(q, p)
+			if !c.Contains(pq) || !pq.Equal(qp) {
+				t.Errorf("%s + %s: got %s and %s", p, q, pq, qp)
+			}
+			r := points[(i*7+j*3)%len(points)]
+			a, _ := c.Add(pq, r)
+			qr, _ := c.Add(q, r)
+			b, _ := c.Add(p, qr)
+			if !a.Equal(b) {
+				t.Errorf("(%s + %s) + %s != %s + (%s + %s)", p, q, r, p, q, r)
+			}
+		}
+	}
+}
+
+func TestGroupLawOverQ(t *testing.T) {
+	c, e := NewCurve(numbers.NewQ("0/1"), numbers.NewQ("-2/1"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	p, e := c.Point(numbers.NewQ("3/1"), numbers.NewQ("5/1"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	// Bachet's duplication of (3, 5) on y^2 = x^3 - 2
+	if d, e := c.ScalarMultiply(z(2), p); e != nil || d.String() != "(129/100, -383/1000)" || !c.Contains(d) {
+		t.Errorf("2·(3, 5): expected (129/100, -383/1000), got %v, %v", d, e)
+	}
+	if _, e := c.ScalarMultiply(z(100), p); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
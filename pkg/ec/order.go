@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ec
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/grgrzybek/gomath/pkg/gf"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxBruteForcePrime is the biggest p of GF(p), for which the points of a curve are counted one by one
+const MaxBruteForcePrime = 1 << 20
+
+// Points returns all points of c over GF(p) - O first, then (x, y) by x and y - checking every x and y. It's only
+// for tiny curves, p <= MaxBruteForcePrime.
+func Points(c *Curve[*gf.Element]) ([]*Point[*gf.Element], error) {
+	f, e := field(c)
+	if e != nil {
+		return nil, e
+	}
+	roots := squareRoots(f)
+	res := []*Point[*gf.Element]{c.Infinity()}
+	for x := range f.P().Uint64() {
+		px := f.Element(numbers.ZFromInt64(int64(x)))
+		for _, y := range roots[c.rhs(px).Value().Uint64()] {
+			res = append(res, &Point[*gf.Element]{x: px, y: f.Element(numbers.ZFromInt64(int64(y)))})
+		}
+	}
+	return res, nil
+}
+
+// Order returns the number of points of c over GF(p), including O, counted one by one (p <= MaxBruteForcePrime).
+// By Hasse's theorem, it's between p + 1 - 2√p and p + 1 + 2√p.
+func Order(c *Curve[*gf.Element]) (*numbers.N, error) {
+	f, e := field(c)
+	if e != nil {
+		return nil, e
+	}
+	roots := squareRoots(f)
+	n := uint64(1)
+	for x := range f.P().Uint64() {
+		n += uint64(len(roots[c.rhs(f.Element(numbers.ZFromInt64(int64(x)))).Value().Uint64()]))
+	}
+	return numbers.NFromUint64(n), nil
+}
+
+// PointOrder returns the order of p in the group of c over GF(p) - the least n > 0 with n·p = O, found by adding
+// p again and again (p <= MaxBruteForcePrime). By Lagrange's theorem it divides the order of the curve.
+func PointOrder(c *Curve[*gf.Element], p *Point[*gf.Element]) (*numbers.N, error) {
+	f, e := field(c)
+	if e != nil {
+		return nil, e
+	}
+	if !c.Contains(p) {
+		return nil, fmt.Errorf("%s is not on %s", p, c)
+	}
+	// Hasse's bound
+	limit := f.P().Uint64() + 1 + 2*uint64(math.Sqrt(float64(f.P().Uint64()))+1)
+	q := p
+	for n := uint64(1); n <= limit; n++ {
+		if q.IsInfinity() {
+			return numbers.NFromUint64(n), nil
+		}
+		if q, e = c.Add(q, p); e != nil {
+			return nil, e
+		}
+	}
+	return nil, fmt.Errorf("order of %s exceeds Hasse's bound", p)
+}
+
+// field returns GF(p) of c, if it's small enough for brute force
+func field(c *Curve[*gf.Element]) (*gf.PrimeField, error) {
+	f := c.a.Field()
+	if f.P().Uint64() > MaxBruteForcePrime {
+		return nil, fmt.Errorf("%s is too big for counting the points one by one", f)
+	}
+	return f, nil
+}
+
+// squareRoots returns square roots of all elements of f - roots[v] are all y with y^2 = v
+func squareRoots(f *gf.PrimeField) [][]uint64 {
+	p := f.P().Uint64()
+	roots := make([][]uint64, p)
+	for y := range p {
+		v := y * y % p
+		roots[v] = append(roots[v], y)
+	}
+	return roots
+}
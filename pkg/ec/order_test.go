@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ec
+
+import (
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/gf"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestOrder(t *testing.T) {
+	for _, c := range []struct {
+		p    uint64
+		a, b int64
+		exp  uint64
+	}{
+		{5, 1, 1, 9}, {17, 2, 2, 19}, {23, 1, 1, 28}, {7, 0, 1, 12},
+	} {
+		curve := curve(t, c.p, c.a, c.b)
+		n, e := Order(curve)
+		if e != nil || n.Uint64() != c.exp {
+			t.Errorf("%s over GF(%d): expected %d points, got %v, %v", curve, c.p, c.exp, n, e)
+		}
+		points, _ := Points(curve)
+		if uint64(len(points)) != c.exp || !points[0].IsInfinity() {
+			t.Errorf("%s over GF(%d): expected %d points, got %v", curve, c.p, c.exp, points)
+		}
+		for _, p := range points {
+			if !curve.Contains(p) {
+				t.Errorf("%s is not on %s", p, curve)
+			}
+			if k, e := PointOrder(curve, p); e != nil || c.exp%k.Uint64() != 0 {
+				t.Errorf("order of %s: expected divisor of %d, got %v, %v", p, c.exp, k, e)
+			}
+		}
+	}
+}
+
+func TestHasseBound(t *testing.T) {
+	for a := int64(0); a < 10; a++ {
+		for b := int64(1); b < 10; b++ {
+			f, _ := gf.NewPrimeField(numbers.NFromUint64(101))
+			c, e := NewCurve(f.Element(z(a)), f.Element(z(b)))
+			if e != nil {
+				// singular
+				continue
+			}
+			n, e := Order(c)
+			if e != nil || math.Abs(float64(n.Uint64())-102) > 2*math.Sqrt(101) {
+				t.Errorf("%s: %v points is outside Hasse's bound, %v", c, n, e)
+			}
+		}
+	}
+}
+
+func TestPointOrder(t *testing.T) {
+	c := curve(t, 17, 2, 2)
+	if n, e := PointOrder(c, point(t, c, 5, 1)); e != nil || n.Uint64() != 19 {
+		t.Errorf("expected 19, got %v, %v", n, e)
+	}
+	if n, e := PointOrder(c, c.Infinity()); e != nil || n.Uint64() != 1 {
+		t.Errorf("expected 1, got %v, %v", n, e)
+	}
+	f := c.A().Field()
+	if _, e := PointOrder(c, &Point[*gf.Element]{x: f.One(), y: f.One()}); e == nil {
+		t.Errorf("expected error for point not on the curve")
+	}
+	f, _ = gf.NewPrimeField(numbers.NFromUint64(MaxBruteForcePrime + 7))
+	huge, _ := NewCurve(f.One(), f.One())
+	if _, e := Order(huge); e == nil {
+		t.Errorf("expected error for big field")
+	}
+}
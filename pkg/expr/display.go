@@ -0,0 +1,205 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxRepeatingDigits is the maximal number of digits shown by ModeRepeating - the period of 1/B may have B-1 digits
+const MaxRepeatingDigits = 1000
+
+// Mode decides how results are displayed
+type Mode int
+
+const (
+	// ModeExact displays fractions in lowest terms: "7/2"
+	ModeExact Mode = iota
+	// ModeMixed displays mixed numbers: "3 1/2"
+	ModeMixed
+	// ModeRepeating displays decimal expansion with repeating part in parentheses: "0.1(6)"
+	ModeRepeating
+	// ModeDecimal displays decimal expansion rounded to fixed number of digits: "0.167"
+	ModeDecimal
+)
+
+var modeNames = []string{"exact", "mixed", "repeating", "decimal"}
+
+func (m Mode) String() string {
+	if m < 0 || int(m) >= len(modeNames) {
+		return "Mode(?)"
+	}
+	return modeNames[m]
+}
+
+// Display keeps settings of displaying results, which can be switched at runtime with Command. The zero value
+// displays exact fractions in base 10. Unlike the numbers it formats, Display is mutable - Format may be called
+// concurrently, but not together with Command or changes of the fields.
+type Display struct {
+	Mode Mode
+	// Precision is the number of fractional digits for ModeDecimal
+	Precision int
+	// Base is used for ModeExact and ModeMixed, 0 means 10
+	Base int
+	// Rounding of the last digit for ModeDecimal, nil means numbers.RoundHalfUp
+	Rounding *numbers.RoundingMode
+}
+
+// Format displays q according to the settings
+func (d *Display) Format(q *numbers.Q) string {
+	base := d.Base
+	if base == 0 {
+		base = 10
+	}
+	switch d.Mode {
+	case ModeMixed:
+		return mixed(q, base)
+	case ModeRepeating:
+		return repeating(q)
+	case ModeDecimal:
+		mode := numbers.RoundHalfUp
+		if d.Rounding != nil {
+			mode = *d.Rounding
+		}
+		s, _ := numbers.Locale{Decimal: "."}.FormatQWith(q, d.Precision, mode)
+		return s
+	}
+	s, _ := FormatBase(q, base)
+	return s
+}
+
+// Command changes the settings with commands like ":mode exact", ":mode mixed", ":mode repeating",
+// ":mode decimal 20", ":base 16" or ":round halfeven" (see numbers.ParseRoundingMode)
+func (d *Display) Command(cmd string) error {
+	args := strings.Fields(cmd)
+	if len(args) == 0 {
+		return errors.New("missing command")
+	}
+	switch {
+	case args[0] == ":mode" && len(args) == 2:
+		for m, name := range modeNames {
+			if name == args[1] && Mode(m) != ModeDecimal {
+				d.Mode = Mode(m)
+				return nil
+			}
+		}
+	case args[0] == ":mode" && len(args) == 3 && args[1] == ModeDecimal.String():
+		p, e := strconv.Atoi(args[2])
+		if e != nil || p < 0 {
+			return fmt.Errorf("invalid precision %q", args[2])
+		}
+		d.Mode, d.Precision = ModeDecimal, p
+		return nil
+	case args[0] == ":base" && len(args) == 2:
+		b, e := strconv.Atoi(args[1])
+		if e != nil || b < 2 || b > 36 {
+			return fmt.Errorf("invalid base %q", args[1])
+		}
+		d.Base = b
+		return nil
+	case args[0] == ":round" && len(args) == 2:
+		m, e := numbers.ParseRoundingMode(args[1])
+		if e != nil {
+			return e
+		}
+		d.Rounding = &m
+		return nil
+	}
+	return fmt.Errorf("unknown command %q, expected \":mode exact|mixed|repeating|decimal <digits>\", "+
+		"\":base <base>\" or \":round <mode>\"", cmd)
+}
+
+// mixed formats q as integer part and proper fraction with the same sign: -7/2 is "-3 1/2"
+func mixed(q *numbers.Q, base int) string {
+	a, b := q.Ratio()
+	whole, rest := a/b, a%b
+	if rest == 0 {
+		return formatInt(numbers.ZFromInt64(whole), base)
+	}
+	if rest < 0 {
+		rest = -rest
+	}
+	frac := formatInt(numbers.ZFromInt64(rest), base) + "/" + formatInt(numbers.ZFromInt64(b), base)
+	switch {
+	case whole != 0:
+		return formatInt(numbers.ZFromInt64(whole), base) + " " + frac
+	case a < 0:
+		return "-" + frac
+	}
+	return frac
+}
+
+// repeating formats decimal expansion of q, putting the period in parentheses: 1/6 is "0.1(6)". Long division
+// repeats when the remainder repeats, so remainders are remembered together with the position of the digit.
+func repeating(q *numbers.Q) string {
+	a, b := q.Ratio()
+	neg := a < 0
+	integer := numbers.ZFromInt64(a / b).Text(10)
+	if neg {
+		integer = strings.TrimPrefix(integer, "-")
+	}
+	var digits []byte
+	seen := map[uint64]int{}
+	rem := uint64(a % b)
+	if neg {
+		rem = uint64(-(a % b))
+	}
+	period := -1
+	for rem != 0 && len(digits) < MaxRepeatingDigits {
+		if i, ok := seen[rem]; ok {
+			period = i
+			break
+		}
+		seen[rem] = len(digits)
+		// rem < B < 2^63, so 10*rem fits 128 bits and the quotient is a single digit
+		hi, lo := bits.Mul64(rem, 10)
+		var d uint64
+		d, rem = bits.Div64(hi, lo, uint64(b))
+		digits = append(digits, byte('0'+d))
+	}
+
+	var sb strings.Builder
+	if neg {
+		sb.WriteString("-")
+	}
+	sb.WriteString(integer)
+	if len(digits) > 0 {
+		sb.WriteString(".")
+		switch {
+		case period >= 0:
+			sb.Write(digits[:period])
+			sb.WriteString("(")
+			sb.Write(digits[period:])
+			sb.WriteString(")")
+		case rem != 0:
+			// too long period
+			sb.Write(digits)
+			sb.WriteString("…")
+		default:
+			sb.Write(digits)
+		}
+	}
+	return sb.String()
+}
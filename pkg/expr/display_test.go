@@ -0,0 +1,114 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package expr
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDisplay(t *testing.T) {
+	for _, c := range []struct {
+		d   Display
+		q   string
+		exp string
+	}{
+		{Display{}, "7/2", "7/2"},
+		{Display{}, "7/1", "7"},
+		{Display{Base: 16}, "255/2", "0xff/0x2"},
+		{Display{Mode: ModeMixed}, "7/2", "3 1/2"},
+		{Display{Mode: ModeMixed}, "-7/2", "-3 1/2"},
+		{Display{Mode: ModeMixed}, "-1/2", "-1/2"},
+		{Display{Mode: ModeMixed}, "1/2", "1/2"},
+		{Display{Mode: ModeMixed}, "-4/1", "-4"},
+		{Display{Mode: ModeMixed, Base: 2}, "7/2", "0b11 0b1/0b10"},
+		{Display{Mode: ModeRepeating}, "1/3", "0.(3)"},
+		{Display{Mode: ModeRepeating}, "1/6", "0.1(6)"},
+		{Display{Mode: ModeRepeating}, "-22/7", "-3.(142857)"},
+		{Display{Mode: ModeRepeating}, "-1/8", "-0.125"},
+		{Display{Mode: ModeRepeating}, "5/1", "5"},
+		{Display{Mode: ModeRepeating}, "1/12", "0.08(3)"},
+		{Display{Mode: ModeDecimal, Precision: 3}, "1/6", "0.167"},
+		{Display{Mode: ModeDecimal, Precision: 20}, "1/7", "0.14285714285714285714"},
+		{Display{Mode: ModeDecimal}, "5/2", "3"},
+	} {
+		if s := c.d.Format(numbers.NewQ(c.q)); s != c.exp {
+			t.Errorf("%s in %s mode: expected %q, got %q", c.q, c.d.Mode, c.exp, s)
+		}
+	}
+
+	// period of 1/1019 has 1018 digits
+	d := Display{Mode: ModeRepeating}
+	if s := d.Format(numbers.NewQ("1/1019")); !strings.HasSuffix(s, "…") || len(s) != len("0.…")+MaxRepeatingDigits {
+		t.Errorf("expected truncated expansion, got %s", s)
+	}
+}
+
+// TestDisplayConcurrentFormat shares one Display between goroutines - run with -race
+func TestDisplayConcurrentFormat(t *testing.T) {
+	d := Display{Mode: ModeRepeating}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if s := d.Format(numbers.NewQ("-22/7")); s != "-3.(142857)" {
+					t.Errorf("expected -3.(142857), got %s", s)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDisplayCommand(t *testing.T) {
+	var d Display
+	q := numbers.NewQ("1/6")
+	for _, c := range []struct {
+		cmd string
+		exp string
+	}{
+		{":mode decimal 20", "0.16666666666666666667"},
+		{":mode repeating", "0.1(6)"},
+		{":mode mixed", "1/6"},
+		{":base 16", "0x1/0x6"},
+		{":mode exact", "0x1/0x6"},
+		{":base 10", "1/6"},
+		{":mode decimal 2", "0.17"},
+		{":round down", "0.16"},
+		{":round HalfEven", "0.17"},
+	} {
+		if e := d.Command(c.cmd); e != nil {
+			t.Errorf("%s: unexpected error: %v", c.cmd, e)
+		}
+		if s := d.Format(q); s != c.exp {
+			t.Errorf("%s: expected %q, got %q", c.cmd, c.exp, s)
+		}
+	}
+	for _, cmd := range []string{"", ":mode", ":mode decimal", ":mode decimal -1", ":mode decimal x", ":mode fancy",
+		":mode exact 2", ":base 1", ":base 37", ":base", ":round", ":round nearest", ":help"} {
+		if e := d.Command(cmd); e == nil {
+			t.Errorf("%q: expected error", cmd)
+		}
+	}
+}
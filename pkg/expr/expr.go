@@ -0,0 +1,253 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package expr evaluates arithmetic expressions exactly, using ℚ from package numbers
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Eval evaluates expression with +, -, *, / (also Unicode −, × and ÷), parentheses and unary minus. Numbers are
+// Go-style literals in any base supported by numbers.ParseN ("0xFF + 0b1010 / 3", "1_000", "2⁵") or decimal numbers
+// supported by numbers.ParseScientific ("1.5e-3"). The result is exact.
+func Eval(s string) (q *numbers.Q, err error) {
+	defer func() {
+		// ℚ operations panic with overflow
+		if r := recover(); r != nil {
+			if r != numbers.ErrOverflow {
+				panic(r)
+			}
+			q, err = nil, numbers.ErrOverflow
+		}
+	}()
+	p := &parser{s: s}
+	q, err = p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpaces(); p.pos < len(p.s) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek(), p.pos)
+	}
+	return q, nil
+}
+
+// FormatBase formats q in given base (2 <= base <= 36). Bases 2, 8 and 16 use Go prefixes, so the result can be
+// evaluated again ("0xff", "-0b1/0b11"). ℤ are formatted without denominator.
+func FormatBase(q *numbers.Q, base int) (string, error) {
+	if base < 2 || base > 36 {
+		return "", fmt.Errorf("base has to be between 2 and 36, got %d", base)
+	}
+	a, b := q.Ratio()
+	res := formatInt(numbers.ZFromInt64(a), base)
+	if b != 1 {
+		res += "/" + formatInt(numbers.ZFromInt64(b), base)
+	}
+	return res, nil
+}
+
+// formatInt formats z in given base with Go prefix, if it exists
+func formatInt(z *numbers.Z, base int) string {
+	prefix := map[int]string{2: "0b", 8: "0o", 16: "0x"}[base]
+	s := z.Text(base)
+	if digits, neg := strings.CutPrefix(s, "-"); neg {
+		return "-" + prefix + digits
+	}
+	return prefix + s
+}
+
+// parser is recursive descent parser of
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("-" | "+") unary | primary
+//	primary = number | "(" expr ")"
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) expr() (*numbers.Q, error) {
+	res, e := p.term()
+	if e != nil {
+		return nil, e
+	}
+	for {
+		switch p.skipSpaces(); p.peek() {
+		case '+':
+			p.next()
+			arg, e := p.term()
+			if e != nil {
+				return nil, e
+			}
+			res = res.Add(arg)
+		case '-', '−':
+			p.next()
+			arg, e := p.term()
+			if e != nil {
+				return nil, e
+			}
+			res = res.Subtract(arg)
+		default:
+			return res, nil
+		}
+	}
+}
+
+func (p *parser) term() (*numbers.Q, error) {
+	res, e := p.unary()
+	if e != nil {
+		return nil, e
+	}
+	for {
+		switch p.skipSpaces(); p.peek() {
+		case '*', '×':
+			p.next()
+			arg, e := p.unary()
+			if e != nil {
+				return nil, e
+			}
+			res = res.Multiply(arg)
+		case '/', '÷':
+			p.next()
+			arg, e := p.unary()
+			if e != nil {
+				return nil, e
+			}
+			if res, e = res.Divide(arg); e != nil {
+				return nil, e
+			}
+		default:
+			return res, nil
+		}
+	}
+}
+
+func (p *parser) unary() (*numbers.Q, error) {
+	switch p.skipSpaces(); p.peek() {
+	case '-', '−':
+		p.next()
+		arg, e := p.unary()
+		if e != nil {
+			return nil, e
+		}
+		return numbers.ZeroQ().Subtract(arg), nil
+	case '+':
+		p.next()
+		return p.unary()
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (*numbers.Q, error) {
+	switch r := p.peek(); {
+	case r == '(':
+		p.next()
+		res, e := p.expr()
+		if e != nil {
+			return nil, e
+		}
+		if p.skipSpaces(); p.peek() != ')' {
+			return nil, fmt.Errorf("missing ')' at position %d", p.pos)
+		}
+		p.next()
+		return res, nil
+	case r >= '0' && r <= '9' || r == '.':
+		return p.number()
+	case r == utf8.RuneError && p.pos >= len(p.s):
+		return nil, errors.New("unexpected end of expression")
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", r, p.pos)
+	}
+}
+
+// number reads literal - everything up to the next operator, parenthesis or space
+func (p *parser) number() (*numbers.Q, error) {
+	start := p.pos
+	for p.pos < len(p.s) {
+		r := p.peek()
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' && !isSuperscript(r) &&
+			!(r == '-' || r == '+') {
+			break
+		}
+		if (r == '-' || r == '+') && !p.exponentSign(start) {
+			break
+		}
+		p.next()
+	}
+	literal := p.s[start:p.pos]
+	if isDecimal(literal) {
+		q, e := numbers.ParseScientific(literal)
+		if e != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", literal, e)
+		}
+		return q, nil
+	}
+	n, e := numbers.ParseN(literal)
+	if e != nil {
+		return nil, e
+	}
+	a, ok := n.Int64()
+	if !ok {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.QFromInts(a, 1)
+}
+
+// exponentSign checks if "+" or "-" at current position is the sign of exponent in decimal number like "1.5e-3"
+func (p *parser) exponentSign(start int) bool {
+	prev := p.s[start:p.pos]
+	return (strings.HasSuffix(prev, "e") || strings.HasSuffix(prev, "E")) && isDecimal(prev+"0")
+}
+
+// isDecimal checks if the literal is a decimal number for ParseScientific - it has decimal point or exponent and no
+// base prefix
+func isDecimal(literal string) bool {
+	if len(literal) >= 2 && literal[0] == '0' && strings.ContainsRune("bBoOxX", rune(literal[1])) {
+		return false
+	}
+	return strings.ContainsAny(literal, ".eE")
+}
+
+func isSuperscript(r rune) bool {
+	return strings.ContainsRune("⁰¹²³⁴⁵⁶⁷⁸⁹", r)
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && unicode.IsSpace(p.peek()) {
+		p.next()
+	}
+}
+
+// peek returns current rune or utf8.RuneError at the end
+func (p *parser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.s[p.pos:])
+	return r
+}
+
+func (p *parser) next() {
+	_, size := utf8.DecodeRuneInString(p.s[p.pos:])
+	p.pos += size
+}
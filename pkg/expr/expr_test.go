@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package expr
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestEval(t *testing.T) {
+	for s, exp := range map[string]string{
+		"1 + 2":             "3/1",
+		"1/3 + 1/6":         "1/2",
+		"0xFF + 0b1010 / 3": "775/3",
+		"0o17 * 0x10":       "240/1",
+		"1_000 − 1":         "999/1",
+		"2⁵ ÷ 3 × 6":        "64/1",
+		"-(1 + 2) * -3":     "9/1",
+		"--1":               "1/1",
+		"+1":                "1/1",
+		"((1))":             "1/1",
+		"1.5e-3":            "3/2000",
+		"1.5e-3 + 1":        "2003/2000",
+		"2e3-1":             "1999/1",
+		"0x1e-3":            "27/1",
+		"0.5 * 0x10":        "8/1",
+		"  7  ":             "7/1",
+		"1 - 2 - 3":         "-4/1",
+		"12 / 4 / 3":        "1/1",
+	} {
+		q, e := Eval(s)
+		if e != nil || q.String() != exp {
+			t.Errorf("%q: expected %s, got %v, %v", s, exp, q, e)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	for _, s := range []string{"", "1 +", "(1", "1)", "1 / 0", "1 / (1 - 1)", "0xZZ", "1 2", "a", "1.2.3", "*2",
+		"0b102"} {
+		if q, e := Eval(s); e == nil {
+			t.Errorf("%q: expected error, got %s", s, q)
+		}
+	}
+	if _, e := Eval("9223372036854775807 + 1"); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := Eval("0xFFFFFFFFFFFFFFFF"); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestFormatBase(t *testing.T) {
+	for _, c := range []struct {
+		q    string
+		base int
+		exp  string
+	}{
+		{"255/1", 16, "0xff"},
+		{"255/1", 10, "255"},
+		{"-1/3", 2, "-0b1/0b11"},
+		{"8/9", 8, "0o10/0o11"},
+		{"35/1", 36, "z"},
+	} {
+		s, e := FormatBase(numbers.NewQ(c.q), c.base)
+		if e != nil || s != c.exp {
+			t.Errorf("%s in base %d: expected %s, got %s, %v", c.q, c.base, c.exp, s, e)
+		}
+		// the result can be evaluated again
+		if c.base == 2 || c.base == 8 || c.base == 10 || c.base == 16 {
+			if q, e := Eval(s); e != nil || q.String() != numbers.NewQ(c.q).String() {
+				t.Errorf("%s: expected %s, got %v, %v", s, c.q, q, e)
+			}
+		}
+	}
+	if _, e := FormatBase(numbers.OneQ(), 1); e == nil {
+		t.Errorf("expected error for base 1")
+	}
+}
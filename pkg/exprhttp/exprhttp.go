@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package exprhttp exposes exact evaluation of package expr over HTTP:
+//
+//	POST /eval {"expr": "1/3 + 1/6", "precision": 5}
+//	200 {"exact": "1/2", "decimal": "0.50000", "repeating": "0.5"}
+//
+// Invalid expressions are reported with status 400 and {"error": "..."}.
+package exprhttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/grgrzybek/gomath/pkg/expr"
+)
+
+const (
+	// DefaultPrecision is the number of fractional digits of decimal representation, when the request doesn't say
+	DefaultPrecision = 20
+	// MaxPrecision is the maximal number of fractional digits of decimal representation
+	MaxPrecision = 1000
+	// maxRequestSize limits the body of request
+	maxRequestSize = 1 << 16
+)
+
+// Request is JSON body of POST /eval
+type Request struct {
+	Expr      string `json:"expr"`
+	Precision *int   `json:"precision,omitempty"`
+}
+
+// Response is JSON body returned by POST /eval - with representations of the result or with the error
+type Response struct {
+	Exact     string `json:"exact,omitempty"`
+	Decimal   string `json:"decimal,omitempty"`
+	Repeating string `json:"repeating,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Handler returns http.Handler serving POST /eval
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eval", eval)
+	return mux
+}
+
+func eval(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		reply(w, http.StatusMethodNotAllowed, Response{Error: "only POST is allowed"})
+		return
+	}
+	var req Request
+	if e := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req); e != nil {
+		reply(w, http.StatusBadRequest, Response{Error: "invalid request: " + e.Error()})
+		return
+	}
+	precision := DefaultPrecision
+	if req.Precision != nil {
+		precision = *req.Precision
+	}
+	if precision < 0 || precision > MaxPrecision {
+		reply(w, http.StatusBadRequest, Response{Error: "precision has to be between 0 and 1000"})
+		return
+	}
+	q, e := expr.Eval(req.Expr)
+	if e != nil {
+		reply(w, http.StatusBadRequest, Response{Error: e.Error()})
+		return
+	}
+	reply(w, http.StatusOK, Response{
+		Exact:     (&expr.Display{Mode: expr.ModeExact}).Format(q),
+		Decimal:   (&expr.Display{Mode: expr.ModeDecimal, Precision: precision}).Format(q),
+		Repeating: (&expr.Display{Mode: expr.ModeRepeating}).Format(q),
+	})
+}
+
+func reply(w http.ResponseWriter, status int, res Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
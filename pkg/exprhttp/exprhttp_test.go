@@ -0,0 +1,77 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package exprhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func post(t *testing.T, body string) (int, Response) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/eval", strings.NewReader(body)))
+	var res Response
+	if e := json.NewDecoder(rec.Body).Decode(&res); e != nil {
+		t.Fatalf("%s: invalid response: %v", body, e)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("%s: expected JSON, got %s", body, ct)
+	}
+	return rec.Code, res
+}
+
+func TestEval(t *testing.T) {
+	code, res := post(t, `{"expr": "1/3 + 1/6", "precision": 5}`)
+	if code != http.StatusOK || res != (Response{Exact: "1/2", Decimal: "0.50000", Repeating: "0.5"}) {
+		t.Errorf("unexpected response %d %+v", code, res)
+	}
+	code, res = post(t, `{"expr": "0xFF / 6"}`)
+	if code != http.StatusOK || res != (Response{Exact: "85/2", Decimal: "42.50000000000000000000", Repeating: "42.5"}) {
+		t.Errorf("unexpected response %d %+v", code, res)
+	}
+	code, res = post(t, `{"expr": "-2/3", "precision": 0}`)
+	if code != http.StatusOK || res != (Response{Exact: "-2/3", Decimal: "-1", Repeating: "-0.(6)"}) {
+		t.Errorf("unexpected response %d %+v", code, res)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	for _, body := range []string{
+		`{"expr": "1/0"}`,
+		`{"expr": "1 +"}`,
+		`{"expr": ""}`,
+		`{"expr": "1", "precision": -1}`,
+		`{"expr": "1", "precision": 1001}`,
+		`not json`,
+	} {
+		code, res := post(t, body)
+		if code != http.StatusBadRequest || res.Error == "" || res.Exact != "" {
+			t.Errorf("%s: expected error, got %d %+v", body, code, res)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eval", nil))
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("GET: expected %d, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+}
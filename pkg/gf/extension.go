@@ -0,0 +1,439 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package gf
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// ExtensionField is GF(p^n) in polynomial basis - polynomials over GF(p) modulo a monic irreducible polynomial of
+// degree n. Its elements are the polynomials of degree below n.
+type ExtensionField struct {
+	base    *PrimeField
+	modulus polynomial
+
+	fmt.Stringer
+}
+
+// ExtensionElement is an element of GF(p^n). Like Element, it's immutable and it satisfies laws.Field. Addition,
+// subtraction and multiplication of elements of different fields panic with ErrDifferentFields.
+type ExtensionElement struct {
+	value polynomial
+	field *ExtensionField
+
+	fmt.Stringer
+}
+
+// polynomial is a polynomial over GF(p) - coefficients, the lowest power first, without trailing ZEROs
+type polynomial []uint64
+
+// NewExtensionField creates GF(p^n) for the polynomial of degree n >= 1 with given coefficients from GF(p), the lowest
+// power first. The polynomial is made monic and it has to be irreducible.
+func NewExtensionField(base *PrimeField, modulus ...*Element) (*ExtensionField, error) {
+	m, e := coefficients(base, modulus)
+	if e != nil {
+		return nil, e
+	}
+	if !m.irreducible(base.p) {
+		return nil, fmt.Errorf("%s is not irreducible over %s", m.format(), base)
+	}
+	return &ExtensionField{base: base, modulus: m.monic(base.p)}, nil
+}
+
+// IsIrreducible checks if the polynomial with given coefficients from GF(p), the lowest power first, can't be
+// written as a product of polynomials of lower degrees. Constants are not irreducible.
+//
+// It's Rabin's test: monic f of degree n is irreducible if and only if it divides x^(p^n) - x (the product of all
+// monic irreducible polynomials of degrees dividing n) and gcd(f, x^(p^(n/q)) - x) = 1 for every prime q dividing n
+// (so f has no factor of degree dividing n/q).
+func IsIrreducible(base *PrimeField, f ...*Element) (bool, error) {
+	m, e := coefficients(base, f)
+	if e != nil {
+		return false, e
+	}
+	return m.irreducible(base.p), nil
+}
+
+// Base returns GF(p) of f
+func (f *ExtensionField) Base() *PrimeField {
+	return f.base
+}
+
+// Degree returns n of GF(p^n)
+func (f *ExtensionField) Degree() int {
+	return len(f.modulus) - 1
+}
+
+// Order returns the number of elements p^n or numbers.ErrOverflow
+func (f *ExtensionField) Order() (*numbers.N, error) {
+	order := uint64(1)
+	for range f.Degree() {
+		hi, lo := bits.Mul64(order, f.base.p)
+		if hi != 0 {
+			return nil, numbers.ErrOverflow
+		}
+		order = lo
+	}
+	return numbers.NFromUint64(order), nil
+}
+
+// Modulus returns the coefficients of the monic irreducible polynomial of f, the lowest power first
+func (f *ExtensionField) Modulus() []*Element {
+	return f.base.elements(f.modulus)
+}
+
+// Element returns the polynomial with given coefficients from GF(p), the lowest power first, reduced modulo the
+// modulus of f. Coefficients from other fields panic with ErrDifferentFields.
+func (f *ExtensionField) Element(coefficients ...*Element) *ExtensionElement {
+	v := make(polynomial, len(coefficients))
+	for i, c := range coefficients {
+		if c.field.p != f.base.p {
+			panic(ErrDifferentFields)
+		}
+		v[i] = c.value
+	}
+	_, r := v.trim().divide(f.modulus, f.base.p)
+	return &ExtensionElement{value: r, field: f}
+}
+
+// Zero returns the additive identity of f
+func (f *ExtensionField) Zero() *ExtensionElement {
+	return &ExtensionElement{value: nil, field: f}
+}
+
+// One returns the multiplicative identity of f
+func (f *ExtensionField) One() *ExtensionElement {
+	return &ExtensionElement{value: polynomial{1}, field: f}
+}
+
+// String formats f as "GF(p^n)"
+func (f *ExtensionField) String() string {
+	return fmt.Sprintf("GF(%d^%d)", f.base.p, f.Degree())
+}
+
+// Field returns the field of x
+func (x *ExtensionElement) Field() *ExtensionField {
+	return x.field
+}
+
+// Coefficients returns the coefficients of x, the lowest power first, without trailing ZEROs
+func (x *ExtensionElement) Coefficients() []*Element {
+	return x.field.base.elements(x.value)
+}
+
+// Add returns x + y - coefficients are added in GF(p)
+func (x *ExtensionElement) Add(y *ExtensionElement) *ExtensionElement {
+	x.check(y)
+	return &ExtensionElement{value: x.value.add(y.value, x.field.base.p), field: x.field}
+}
+
+// Subtract returns x - y
+func (x *ExtensionElement) Subtract(y *ExtensionElement) *ExtensionElement {
+	x.check(y)
+	return &ExtensionElement{value: x.value.subtract(y.value, x.field.base.p), field: x.field}
+}
+
+// Negate returns -x
+func (x *ExtensionElement) Negate() *ExtensionElement {
+	return x.field.Zero().Subtract(x)
+}
+
+// Multiply returns x * y modulo the modulus of the field
+func (x *ExtensionElement) Multiply(y *ExtensionElement) *ExtensionElement {
+	x.check(y)
+	_, r := x.value.multiply(y.value, x.field.base.p).divide(x.field.modulus, x.field.base.p)
+	return &ExtensionElement{value: r, field: x.field}
+}
+
+// Inverse returns 1/x. Extended Euclid's algorithm finds a*x + b*m = gcd(x, m) = 1 for irreducible modulus m, so a is
+// the inverse. ZERO has no inverse.
+func (x *ExtensionElement) Inverse() (*ExtensionElement, error) {
+	if len(x.value) == 0 {
+		return nil, errors.New("ZERO has no inverse")
+	}
+	p := x.field.base.p
+	r0, r1 := x.field.modulus, x.value
+	var a0, a1 polynomial = nil, polynomial{1}
+	for len(r1) > 0 {
+		q, r := r0.divide(r1, p)
+		r0, r1 = r1, r
+		a0, a1 = a1, a0.subtract(q.multiply(a1, p), p)
+	}
+	// r0 is a non-ZERO constant c, so a0/c is the inverse
+	return &ExtensionElement{value: a0.scale(modarith.PowMod(r0[0], p-2, p), p), field: x.field}, nil
+}
+
+// Divide returns x / y = x * y^-1 or an error for y = 0 and elements of different fields
+func (x *ExtensionElement) Divide(y *ExtensionElement) (*ExtensionElement, error) {
+	if !x.field.equal(y.field) {
+		return nil, ErrDifferentFields
+	}
+	inv, e := y.Inverse()
+	if e != nil {
+		return nil, e
+	}
+	return x.Multiply(inv), nil
+}
+
+// Power returns x^n by repeated squaring. Negative n means the power of the inverse, which doesn't exist for ZERO.
+func (x *ExtensionElement) Power(n *numbers.Z) (*ExtensionElement, error) {
+	base, exp := x, n.Int64()
+	if exp < 0 {
+		var e error
+		if base, e = x.Inverse(); e != nil {
+			return nil, e
+		}
+	}
+	v := base.value.power(modarith.AbsUint64(exp), x.field.modulus, x.field.base.p)
+	return &ExtensionElement{value: v, field: x.field}, nil
+}
+
+// Compare compares x and y as polynomials - by degree, then by coefficients from the highest power. Like for
+// Element, it's not an order compatible with the field operations, but it tells equal elements.
+func (x *ExtensionElement) Compare(y *ExtensionElement) int {
+	x.check(y)
+	if c := len(x.value) - len(y.value); c != 0 {
+		return c / max(c, -c)
+	}
+	for i := len(x.value) - 1; i >= 0; i-- {
+		switch {
+		case x.value[i] < y.value[i]:
+			return -1
+		case x.value[i] > y.value[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// IsZero checks if x is the additive identity
+func (x *ExtensionElement) IsZero() bool {
+	return len(x.value) == 0
+}
+
+// String formats x as a polynomial, like "3x^2 + x + 1"
+func (x *ExtensionElement) String() string {
+	return x.value.format()
+}
+
+// check panics if x and y are from different fields
+func (x *ExtensionElement) check(y *ExtensionElement) {
+	if !x.field.equal(y.field) {
+		panic(ErrDifferentFields)
+	}
+}
+
+// equal checks if f and g have the same base and modulus
+func (f *ExtensionField) equal(g *ExtensionField) bool {
+	return f.base.p == g.base.p && slices.Equal(f.modulus, g.modulus)
+}
+
+// elements returns coefficients of v as elements of f
+func (f *PrimeField) elements(v polynomial) []*Element {
+	res := make([]*Element, len(v))
+	for i, c := range v {
+		res[i] = &Element{value: c, field: f}
+	}
+	return res
+}
+
+// coefficients returns the polynomial with given coefficients of degree at least 1
+func coefficients(base *PrimeField, cs []*Element) (polynomial, error) {
+	v := make(polynomial, len(cs))
+	for i, c := range cs {
+		if c.field.p != base.p {
+			return nil, ErrDifferentFields
+		}
+		v[i] = c.value
+	}
+	if v = v.trim(); len(v) < 2 {
+		return nil, errors.New("polynomial of degree at least 1 is needed")
+	}
+	return v, nil
+}
+
+// irreducible is Rabin's test of v of degree at least 1 (see IsIrreducible)
+func (v polynomial) irreducible(p uint64) bool {
+	n := len(v) - 1
+	if n == 1 {
+		return true
+	}
+	m := v.monic(p)
+	x := polynomial{0, 1}
+	// h[k] = x^(p^k) mod m
+	h := make([]polynomial, n+1)
+	h[0] = x
+	for k := 1; k <= n; k++ {
+		h[k] = h[k-1].power(p, m, p)
+	}
+	if len(h[n].subtract(x, p)) != 0 {
+		return false
+	}
+	factors, _ := primes.Factorize(numbers.NFromUint64(uint64(n)))
+	for _, q := range factors {
+		if len(m.gcd(h[n/int(q.Prime.Uint64())].subtract(x, p), p)) > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// trim removes trailing ZEROs
+func (v polynomial) trim() polynomial {
+	n := len(v)
+	for n > 0 && v[n-1] == 0 {
+		n--
+	}
+	return v[:n]
+}
+
+// add returns v + w
+func (v polynomial) add(w polynomial, p uint64) polynomial {
+	res := make(polynomial, max(len(v), len(w)))
+	for i := range res {
+		res[i] = (v.coefficient(i) + w.coefficient(i)) % p
+	}
+	return res.trim()
+}
+
+// subtract returns v - w
+func (v polynomial) subtract(w polynomial, p uint64) polynomial {
+	res := make(polynomial, max(len(v), len(w)))
+	for i := range res {
+		res[i] = (v.coefficient(i) + p - w.coefficient(i)) % p
+	}
+	return res.trim()
+}
+
+// scale returns c * v for c != 0
+func (v polynomial) scale(c uint64, p uint64) polynomial {
+	res := make(polynomial, len(v))
+	for i, a := range v {
+		res[i] = modarith.MulMod(a, c, p)
+	}
+	return res
+}
+
+// multiply returns v * w
+func (v polynomial) multiply(w polynomial, p uint64) polynomial {
+	if len(v) == 0 || len(w) == 0 {
+		return nil
+	}
+	res := make(polynomial, len(v)+len(w)-1)
+	for i, a := range v {
+		for j, b := range w {
+			res[i+j] = (res[i+j] + modarith.MulMod(a, b, p)) % p
+		}
+	}
+	return res.trim()
+}
+
+// divide returns quotient and remainder of v / w for non-ZERO w - long division
+func (v polynomial) divide(w polynomial, p uint64) (polynomial, polynomial) {
+	if len(v) < len(w) {
+		return nil, v
+	}
+	rem := slices.Clone(v)
+	quotient := make(polynomial, len(v)-len(w)+1)
+	inv := modarith.PowMod(w[len(w)-1], p-2, p)
+	for i := len(quotient) - 1; i >= 0; i-- {
+		c := modarith.MulMod(rem[i+len(w)-1], inv, p)
+		quotient[i] = c
+		for j, b := range w {
+			rem[i+j] = (rem[i+j] + p - modarith.MulMod(c, b, p)) % p
+		}
+	}
+	return quotient.trim(), rem[:len(w)-1].trim()
+}
+
+// power returns v^e mod m by repeated squaring
+func (v polynomial) power(e uint64, m polynomial, p uint64) polynomial {
+	_, res := polynomial{1}.divide(m, p)
+	_, b := v.divide(m, p)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			_, res = res.multiply(b, p).divide(m, p)
+		}
+		_, b = b.multiply(b, p).divide(m, p)
+	}
+	return res
+}
+
+// gcd returns monic gcd(v, w) - Euclid's algorithm
+func (v polynomial) gcd(w polynomial, p uint64) polynomial {
+	for len(w) > 0 {
+		_, r := v.divide(w, p)
+		v, w = w, r
+	}
+	return v.monic(p)
+}
+
+// monic returns v divided by its leading coefficient
+func (v polynomial) monic(p uint64) polynomial {
+	if len(v) == 0 {
+		return v
+	}
+	return v.scale(modarith.PowMod(v[len(v)-1], p-2, p), p)
+}
+
+// coefficient returns the coefficient of x^i (ZERO above the degree)
+func (v polynomial) coefficient(i int) uint64 {
+	if i < len(v) {
+		return v[i]
+	}
+	return 0
+}
+
+// format formats v with the highest power first, like "3x^2 + x + 1"
+func (v polynomial) format() string {
+	if len(v) == 0 {
+		return "0"
+	}
+	var terms []string
+	for i := len(v) - 1; i >= 0; i-- {
+		c := v[i]
+		switch {
+		case c == 0:
+			continue
+		case i == 0:
+			terms = append(terms, fmt.Sprintf("%d", c))
+			continue
+		}
+		t := "x"
+		if i > 1 {
+			t = fmt.Sprintf("x^%d", i)
+		}
+		if c != 1 {
+			t = fmt.Sprintf("%d%s", c, t)
+		}
+		terms = append(terms, t)
+	}
+	return strings.Join(terms, " + ")
+}
+
+var _ = fmt.Stringer(&ExtensionField{})
+var _ = fmt.Stringer(&ExtensionElement{})
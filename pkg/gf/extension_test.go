@@ -0,0 +1,234 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package gf
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/laws"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// elements returns elements of f with given values
+func elements(f *PrimeField, vs ...int64) []*Element {
+	res := make([]*Element, len(vs))
+	for i, v := range vs {
+		res[i] = f.Element(z(v))
+	}
+	return res
+}
+
+// aes returns GF(2^8) of AES, with modulus x^8 + x^4 + x^3 + x + 1
+func aes(t *testing.T) *ExtensionField {
+	f, e := NewExtensionField(field(t, 2), elements(field(t, 2), 1, 1, 0, 1, 1, 0, 0, 0, 1)...)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return f
+}
+
+// byteElement returns b as polynomial over GF(2) - bit i is the coefficient of x^i
+func byteElement(f *ExtensionField, b byte) *ExtensionElement {
+	cs := make([]int64, 8)
+	for i := range cs {
+		cs[i] = int64(b>>i) & 1
+	}
+	return f.Element(elements(f.Base(), cs...)...)
+}
+
+func TestIsIrreducible(t *testing.T) {
+	gf2 := field(t, 2)
+	for _, c := range []struct {
+		cs  []int64
+		exp bool
+	}{
+		{[]int64{1, 1}, true},
+		{[]int64{1, 1, 1}, true},
+		{[]int64{1, 0, 1}, false},
+		{[]int64{1, 1, 0, 1}, true},
+		{[]int64{1, 1, 0, 0, 1}, true},
+		{[]int64{1, 0, 1, 0, 1}, false},
+		{[]int64{1, 1, 0, 1, 1, 0, 0, 0, 1}, true},
+		{[]int64{1, 1, 0, 0, 0, 0, 1}, true},
+		{[]int64{1, 1, 1, 0, 0, 0, 1}, false},
+	} {
+		if ok, e := IsIrreducible(gf2, elements(gf2, c.cs...)...); e != nil || ok != c.exp {
+			t.Errorf("%v: expected %v, got %v, %v", c.cs, c.exp, ok, e)
+		}
+	}
+	if _, e := IsIrreducible(gf2, elements(gf2, 1)...); e == nil {
+		t.Errorf("expected error for constant")
+	}
+	if _, e := IsIrreducible(gf2, elements(field(t, 3), 1, 1)...); !errors.Is(e, ErrDifferentFields) {
+		t.Errorf("expected ErrDifferentFields, got %v", e)
+	}
+}
+
+func TestIrreducibleCount(t *testing.T) {
+	// Gauss: there are (1/n) Σ μ(d) p^(n/d) monic irreducible polynomials of degree n over GF(p)
+	for _, c := range []struct {
+		p     uint64
+		n     int
+		count int
+	}{
+		{2, 1, 2}, {2, 2, 1}, {2, 3, 2}, {2, 4, 3}, {2, 5, 6}, {2, 6, 9}, {2, 8, 30},
+		{3, 2, 3}, {3, 3, 8}, {3, 4, 18}, {5, 2, 10}, {5, 3, 40},
+	} {
+		f := field(t, c.p)
+		count := 0
+		total := 1
+		for range c.n {
+			total *= int(c.p)
+		}
+		for k := range total {
+			cs := make([]*Element, c.n+1)
+			for i := range c.n {
+				cs[i] = f.Element(z(int64(k % int(c.p))))
+				k /= int(c.p)
+			}
+			cs[c.n] = f.One()
+			if ok, _ := IsIrreducible(f, cs...); ok {
+				count++
+			}
+		}
+		if count != c.count {
+			t.Errorf("GF(%d), degree %d: expected %d, got %d", c.p, c.n, c.count, count)
+		}
+	}
+}
+
+func TestNewExtensionField(t *testing.T) {
+	f := aes(t)
+	if f.String() != "GF(2^8)" || f.Degree() != 8 {
+		t.Errorf("expected GF(2^8), got %s", f)
+	}
+	if o, e := f.Order(); e != nil || o.Uint64() != 256 {
+		t.Errorf("expected 256 elements, got %v, %v", o, e)
+	}
+	gf7 := field(t, 7)
+	// 2x^2 + 2 is made monic
+	g, e := NewExtensionField(gf7, elements(gf7, 2, 0, 2)...)
+	if e != nil || len(g.Modulus()) != 3 || g.Modulus()[0].String() != "1" || g.Modulus()[2].String() != "1" {
+		t.Errorf("expected x^2 + 1, got %v, %v", g, e)
+	}
+	if _, e := NewExtensionField(gf7, elements(gf7, 1, 0, 0, 1)...); e == nil {
+		t.Errorf("x^3 + 1 = (x + 1)(x^2 - x + 1) is not irreducible")
+	}
+	gfm := field(t, mersenne61)
+	h, _ := NewExtensionField(gfm, elements(gfm, 1, 0, 1)...)
+	if _, e := h.Order(); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
+
+func TestExtensionArithmetic(t *testing.T) {
+	f := aes(t)
+	// FIPS-197, 4.2: {57} • {83} = {c1}
+	if v := byteElement(f, 0x57).Multiply(byteElement(f, 0x83)); v.Compare(byteElement(f, 0xc1)) != 0 {
+		t.Errorf("{57} • {83}: expected x^7 + x^6 + 1, got %s", v)
+	}
+	// {57} + {83} = {d4}
+	if v := byteElement(f, 0x57).Add(byteElement(f, 0x83)); v.String() != "x^7 + x^6 + x^4 + x^2" {
+		t.Errorf("{57} + {83}: expected x^7 + x^6 + x^4 + x^2, got %s", v)
+	}
+	// the S-box of AES starts with the inverse: {53}^-1 = {ca}
+	if v, e := byteElement(f, 0x53).Inverse(); e != nil || v.Compare(byteElement(f, 0xca)) != 0 {
+		t.Errorf("{53}^-1: expected {ca}, got %v, %v", v, e)
+	}
+	if _, e := f.Zero().Inverse(); e == nil {
+		t.Errorf("expected error inverting ZERO")
+	}
+
+	gf3 := field(t, 3)
+	g, _ := NewExtensionField(gf3, elements(gf3, 1, 0, 1)...)
+	x := g.Element(elements(gf3, 0, 1)...)
+	if v := x.Multiply(x); v.String() != "2" {
+		t.Errorf("x^2 in GF(3)[x]/(x^2 + 1): expected 2, got %s", v)
+	}
+	if v := g.Element(elements(gf3, 2, 0, 0, 1)...); v.String() != "2x + 2" {
+		t.Errorf("x^3 + 2: expected 2x + 2, got %s", v)
+	}
+	if v := x.Negate(); v.String() != "2x" || x.Subtract(x).String() != "0" {
+		t.Errorf("-x: expected 2x, got %s", v)
+	}
+	if v, e := g.One().Divide(x); e != nil || v.String() != "2x" {
+		t.Errorf("1/x: expected 2x, got %v, %v", v, e)
+	}
+	if _, e := x.Divide(f.One()); !errors.Is(e, ErrDifferentFields) {
+		t.Errorf("expected ErrDifferentFields, got %v", e)
+	}
+	if c := x.Coefficients(); len(c) != 2 || c[1].String() != "1" {
+		t.Errorf("expected [0 1], got %v", c)
+	}
+}
+
+func TestExtensionPower(t *testing.T) {
+	f := aes(t)
+	// the multiplicative group has 255 elements, {03} generates it
+	g := byteElement(f, 0x03)
+	seen := map[string]bool{}
+	for k := range int64(255) {
+		v, _ := g.Power(z(k))
+		seen[v.String()] = true
+	}
+	if len(seen) != 255 {
+		t.Errorf("expected 255 powers of {03}, got %d", len(seen))
+	}
+	if v, _ := g.Power(z(255)); v.Compare(f.One()) != 0 {
+		t.Errorf("{03}^255: expected 1, got %s", v)
+	}
+	inv, _ := g.Inverse()
+	if v, e := g.Power(z(-1)); e != nil || v.Compare(inv) != 0 {
+		t.Errorf("{03}^-1: expected %s, got %v, %v", inv, v, e)
+	}
+	if _, e := f.Zero().Power(z(-2)); e == nil {
+		t.Errorf("expected error for ZERO^-2")
+	}
+}
+
+func TestExtensionDifferentFieldsPanic(t *testing.T) {
+	gf3 := field(t, 3)
+	a, _ := NewExtensionField(gf3, elements(gf3, 1, 0, 1)...)
+	b, _ := NewExtensionField(gf3, elements(gf3, 2, 2, 1)...)
+	defer func() {
+		if p := recover(); p != ErrDifferentFields {
+			t.Errorf("expected ErrDifferentFields panic, got %v", p)
+		}
+	}()
+	a.One().Multiply(b.One())
+}
+
+func TestExtensionLaws(t *testing.T) {
+	gf3, gfm := field(t, 3), field(t, mersenne61)
+	g, _ := NewExtensionField(gf3, elements(gf3, 1, 0, 1)...)
+	h, _ := NewExtensionField(gfm, elements(gfm, 1, 0, 1)...)
+	for _, f := range []*ExtensionField{aes(t), g, h} {
+		if e := laws.Check(laws.FieldLaws[*ExtensionElement](), func(r *rand.Rand) *ExtensionElement {
+			cs := make([]*Element, f.Degree())
+			for i := range cs {
+				cs[i] = f.Base().Element(z(r.Int64N(int64(f.Base().P().Uint64()))))
+			}
+			return f.Element(cs...)
+		}); e != nil {
+			t.Errorf("%s: %v", f, e)
+		}
+	}
+}
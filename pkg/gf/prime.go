@@ -0,0 +1,214 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package gf implements finite (Galois) fields - GF(p) of residues modulo prime p, where every non-zero element has
+// a multiplicative inverse, and its extensions GF(p^n) of polynomials over GF(p) modulo an irreducible polynomial
+package gf
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// ErrDifferentFields is the panic of arithmetic operations on elements of different fields
+var ErrDifferentFields = errors.New("elements of different fields")
+
+// PrimeField is GF(p) - {0, 1, ..., p-1} with addition and multiplication modulo prime p
+type PrimeField struct {
+	p uint64
+
+	fmt.Stringer
+}
+
+// Element is an element of GF(p). Elements are immutable and, like numbers, they satisfy laws.Field. Addition,
+// subtraction and multiplication of elements of different fields panic with ErrDifferentFields.
+type Element struct {
+	value uint64
+	field *PrimeField
+
+	fmt.Stringer
+}
+
+// NewPrimeField creates GF(p) for prime p < 2^63
+func NewPrimeField(p *numbers.N) (*PrimeField, error) {
+	if p.Uint64() > math.MaxInt64 || !primes.IsPrime(p) {
+		return nil, fmt.Errorf("%s is not a prime below 2^63", p)
+	}
+	return &PrimeField{p: p.Uint64()}, nil
+}
+
+// P returns the characteristic (and the number of elements) of f
+func (f *PrimeField) P() *numbers.N {
+	return numbers.NFromUint64(f.p)
+}
+
+// Element returns v mod p as an element of f
+func (f *PrimeField) Element(v *numbers.Z) *Element {
+	r := int64(f.p)
+	return &Element{value: uint64(((v.Int64() % r) + r) % r), field: f}
+}
+
+// Zero returns the additive identity of f
+func (f *PrimeField) Zero() *Element {
+	return &Element{value: 0, field: f}
+}
+
+// One returns the multiplicative identity of f
+func (f *PrimeField) One() *Element {
+	return &Element{value: 1, field: f}
+}
+
+// String formats f as "GF(p)"
+func (f *PrimeField) String() string {
+	return fmt.Sprintf("GF(%d)", f.p)
+}
+
+// Field returns the field of x
+func (x *Element) Field() *PrimeField {
+	return x.field
+}
+
+// Value returns x as residue 0 <= v < p
+func (x *Element) Value() *numbers.N {
+	return numbers.NFromUint64(x.value)
+}
+
+// Add returns x + y (mod p)
+func (x *Element) Add(y *Element) *Element {
+	x.check(y)
+	// both are below 2^63, so the sum fits uint64
+	return &Element{value: (x.value + y.value) % x.field.p, field: x.field}
+}
+
+// Subtract returns x - y (mod p)
+func (x *Element) Subtract(y *Element) *Element {
+	x.check(y)
+	return &Element{value: (x.value + x.field.p - y.value) % x.field.p, field: x.field}
+}
+
+// Negate returns -x (mod p)
+func (x *Element) Negate() *Element {
+	return x.field.Zero().Subtract(x)
+}
+
+// Multiply returns x * y (mod p)
+func (x *Element) Multiply(y *Element) *Element {
+	x.check(y)
+	return &Element{value: modarith.MulMod(x.value, y.value, x.field.p), field: x.field}
+}
+
+// Inverse returns 1/x - by Fermat's little theorem x^(p-1) = 1, so x^(p-2) is the inverse. ZERO has no inverse.
+func (x *Element) Inverse() (*Element, error) {
+	if x.value == 0 {
+		return nil, errors.New("ZERO has no inverse")
+	}
+	return &Element{value: modarith.PowMod(x.value, x.field.p-2, x.field.p), field: x.field}, nil
+}
+
+// Divide returns x / y = x * y^-1 or an error for y = 0 and elements of different fields
+func (x *Element) Divide(y *Element) (*Element, error) {
+	if x.field.p != y.field.p {
+		return nil, ErrDifferentFields
+	}
+	inv, e := y.Inverse()
+	if e != nil {
+		return nil, e
+	}
+	return x.Multiply(inv), nil
+}
+
+// Power returns x^n by repeated squaring. Negative n means the power of the inverse, which doesn't exist for ZERO.
+func (x *Element) Power(n *numbers.Z) (*Element, error) {
+	base, exp := x, n.Int64()
+	if exp < 0 {
+		var e error
+		if base, e = x.Inverse(); e != nil {
+			return nil, e
+		}
+		// x^(p-1) = 1, so the exponent can be reduced modulo p-1 (and -MinInt64 doesn't overflow)
+		exp = -(exp % int64(x.field.p-1))
+	}
+	return &Element{value: modarith.PowMod(base.value, uint64(exp), x.field.p), field: x.field}, nil
+}
+
+// Compare compares the residues of x and y - there's no order compatible with the field operations, but it tells
+// equal elements
+func (x *Element) Compare(y *Element) int {
+	x.check(y)
+	switch {
+	case x.value < y.value:
+		return -1
+	case x.value > y.value:
+		return 1
+	}
+	return 0
+}
+
+// IsZero checks if x is the additive identity
+func (x *Element) IsZero() bool {
+	return x.value == 0
+}
+
+// String formats x as its residue
+func (x *Element) String() string {
+	return fmt.Sprintf("%d", x.value)
+}
+
+// BatchInverse returns the inverses of all xs with single Inverse - Montgomery's trick. For prefix products
+// c(i) = x(0) * ... * x(i), 1/x(i) = c(i-1) / c(i) and 1/c(i-1) = x(i) / c(i), so going backwards from 1/c(n-1), each
+// inverse costs three multiplications. Returns an error if any of xs is ZERO or they're from different fields.
+func BatchInverse(xs []*Element) ([]*Element, error) {
+	if len(xs) == 0 {
+		return nil, nil
+	}
+	prefix := make([]*Element, len(xs))
+	acc := xs[0].field.One()
+	for i, x := range xs {
+		if x.field.p != acc.field.p {
+			return nil, ErrDifferentFields
+		}
+		if x.IsZero() {
+			return nil, fmt.Errorf("element %d is ZERO, which has no inverse", i)
+		}
+		acc = acc.Multiply(x)
+		prefix[i] = acc
+	}
+	inv, _ := acc.Inverse()
+	res := make([]*Element, len(xs))
+	for i := len(xs) - 1; i > 0; i-- {
+		res[i] = inv.Multiply(prefix[i-1])
+		inv = inv.Multiply(xs[i])
+	}
+	res[0] = inv
+	return res, nil
+}
+
+// check panics if x and y are from different fields
+func (x *Element) check(y *Element) {
+	if x.field.p != y.field.p {
+		panic(ErrDifferentFields)
+	}
+}
+
+var _ = fmt.Stringer(&PrimeField{})
+var _ = fmt.Stringer(&Element{})
@@ -0,0 +1,186 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package gf
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/laws"
+	"github.com/grgrzybek/gomath/pkg/modular"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// 2^61 - 1 is a Mersenne prime
+const mersenne61 = 1<<61 - 1
+
+func field(t *testing.T, p uint64) *PrimeField {
+	t.Helper()
+	f, e := NewPrimeField(numbers.NFromUint64(p))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return f
+}
+
+func z(v int64) *numbers.Z {
+	return numbers.ZFromInt64(v)
+}
+
+func TestNewPrimeField(t *testing.T) {
+	for _, p := range []uint64{2, 3, 7, mersenne61} {
+		if f, e := NewPrimeField(numbers.NFromUint64(p)); e != nil || f.P().Uint64() != p {
+			t.Errorf("%d: expected field, got %v, %v", p, f, e)
+		}
+	}
+	for _, p := range []uint64{0, 1, 4, 91, 1<<63 + 29} {
+		if _, e := NewPrimeField(numbers.NFromUint64(p)); e == nil {
+			t.Errorf("%d: expected error", p)
+		}
+	}
+	if s := field(t, 7).String(); s != "GF(7)" {
+		t.Errorf("expected GF(7), got %s", s)
+	}
+}
+
+func TestElementArithmetic(t *testing.T) {
+	f := field(t, 7)
+	a, b := f.Element(z(3)), f.Element(z(-2))
+	if b.String() != "5" {
+		t.Errorf("-2 mod 7: expected 5, got %s", b)
+	}
+	for _, c := range []struct {
+		v   *Element
+		exp string
+	}{
+		{a.Add(b), "1"},
+		{a.Subtract(b), "5"},
+		{a.Multiply(b), "1"},
+		{a.Negate(), "4"},
+		{f.Zero().Negate(), "0"},
+		{f.One(), "1"},
+	} {
+		if c.v.String() != c.exp {
+			t.Errorf("expected %s, got %s", c.exp, c.v)
+		}
+	}
+	if q, e := a.Divide(b); e != nil || q.String() != "2" {
+		t.Errorf("3/5: expected 2, got %v, %v", q, e)
+	}
+	if _, e := a.Divide(f.Zero()); e == nil {
+		t.Errorf("expected error dividing by ZERO")
+	}
+	if _, e := f.Zero().Inverse(); e == nil {
+		t.Errorf("expected error inverting ZERO")
+	}
+	if _, e := a.Divide(field(t, 5).One()); !errors.Is(e, ErrDifferentFields) {
+		t.Errorf("expected ErrDifferentFields, got %v", e)
+	}
+}
+
+func TestDifferentFieldsPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p != ErrDifferentFields {
+			t.Errorf("expected ErrDifferentFields panic, got %v", p)
+		}
+	}()
+	field(t, 7).One().Add(field(t, 5).One())
+}
+
+func TestAgainstModular(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, p := range []uint64{2, 3, 7, 1000000007, mersenne61} {
+		f, m := field(t, p), z(int64(p))
+		for range 100 {
+			v := r.Int64N(int64(p))
+			x := f.Element(z(v))
+			if v == 0 {
+				continue
+			}
+			inv, e := x.Inverse()
+			exp, _ := modular.Inverse(z(v), m)
+			if e != nil || inv.Value().Uint64() != uint64(exp.Int64()) {
+				t.Errorf("1/%d mod %d: expected %s, got %v, %v", v, p, exp, inv, e)
+			}
+			n := r.Int64N(2001) - 1000
+			pow, e := x.Power(z(n))
+			exp, _ = modular.PowerMod(z(v), z(n), m)
+			if e != nil || pow.Value().Uint64() != uint64(exp.Int64()) {
+				t.Errorf("%d^%d mod %d: expected %s, got %v, %v", v, n, p, exp, pow, e)
+			}
+		}
+	}
+}
+
+func TestPower(t *testing.T) {
+	f := field(t, 7)
+	for _, c := range []struct {
+		x, n int64
+		exp  string
+	}{
+		{3, 0, "1"}, {3, 6, "1"}, {3, 2, "2"}, {3, -1, "5"}, {0, 0, "1"}, {0, 5, "0"}, {2, math.MinInt64, "2"},
+	} {
+		if v, e := f.Element(z(c.x)).Power(z(c.n)); e != nil || v.String() != c.exp {
+			t.Errorf("%d^%d: expected %s, got %v, %v", c.x, c.n, c.exp, v, e)
+		}
+	}
+	if _, e := f.Zero().Power(z(-1)); e == nil {
+		t.Errorf("expected error for ZERO^-1")
+	}
+}
+
+func TestBatchInverse(t *testing.T) {
+	f := field(t, mersenne61)
+	r := rand.New(rand.NewPCG(3, 4))
+	xs := make([]*Element, 50)
+	for i := range xs {
+		xs[i] = f.Element(z(r.Int64N(mersenne61-1) + 1))
+	}
+	invs, e := BatchInverse(xs)
+	if e != nil {
+		t.Fatal(e)
+	}
+	for i, x := range xs {
+		if exp, _ := x.Inverse(); invs[i].Compare(exp) != 0 {
+			t.Errorf("1/%s: expected %s, got %s", x, exp, invs[i])
+		}
+	}
+	if invs, e := BatchInverse(nil); e != nil || invs != nil {
+		t.Errorf("expected no inverses, got %v, %v", invs, e)
+	}
+	if _, e := BatchInverse([]*Element{f.One(), f.Zero()}); e == nil {
+		t.Errorf("expected error for ZERO")
+	}
+	if _, e := BatchInverse([]*Element{f.One(), field(t, 7).One()}); !errors.Is(e, ErrDifferentFields) {
+		t.Errorf("expected ErrDifferentFields, got %v", e)
+	}
+}
+
+func TestLaws(t *testing.T) {
+	for _, p := range []uint64{2, 7, mersenne61} {
+		f := field(t, p)
+		if e := laws.Check(laws.FieldLaws[*Element](), func(r *rand.Rand) *Element {
+			return f.Element(z(r.Int64N(int64(p))))
+		}); e != nil {
+			t.Errorf("%s: %v", f, e)
+		}
+	}
+}
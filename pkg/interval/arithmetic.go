@@ -0,0 +1,134 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package interval
+
+import (
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Add returns [a, b] + [c, d] = [a + c, b + d] or ErrOverflow
+func (x *Interval) Add(arg *Interval) (*Interval, error) {
+	lo, e := x.lo.AddChecked(arg.lo)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := x.hi.AddChecked(arg.hi)
+	if e != nil {
+		return nil, e
+	}
+	return &Interval{lo: lo, hi: hi}, nil
+}
+
+// Subtract returns [a, b] - [c, d] = [a - d, b - c] or ErrOverflow
+func (x *Interval) Subtract(arg *Interval) (*Interval, error) {
+	lo, e := x.lo.SubtractChecked(arg.hi)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := x.hi.SubtractChecked(arg.lo)
+	if e != nil {
+		return nil, e
+	}
+	return &Interval{lo: lo, hi: hi}, nil
+}
+
+// Multiply returns [a, b] * [c, d] = [min(ac, ad, bc, bd), max(ac, ad, bc, bd)] or ErrOverflow
+func (x *Interval) Multiply(arg *Interval) (*Interval, error) {
+	products := make([]*numbers.Q, 0, 4)
+	for _, p := range []*numbers.Q{x.lo, x.hi} {
+		for _, q := range []*numbers.Q{arg.lo, arg.hi} {
+			r, e := p.MultiplyChecked(q)
+			if e != nil {
+				return nil, e
+			}
+			products = append(products, r)
+		}
+	}
+	return &Interval{lo: numbers.MinQ(products), hi: numbers.MaxQ(products)}, nil
+}
+
+// Divide returns [a, b] / [c, d] = [a, b] * [1/d, 1/c], ErrContainsZero if c <= 0 <= d or ErrOverflow
+func (x *Interval) Divide(arg *Interval) (*Interval, error) {
+	if arg.Contains(numbers.ZeroQ()) {
+		return nil, ErrContainsZero
+	}
+	lo, e := numbers.OneQ().Divide(arg.hi)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := numbers.OneQ().Divide(arg.lo)
+	if e != nil {
+		return nil, e
+	}
+	return x.Multiply(&Interval{lo: lo, hi: hi})
+}
+
+// Simplest returns the number with the smallest denominator in x (the smallest by absolute value if there are more
+// such integers) - the first number found in x going down Stern–Brocot tree. It keeps the denominators small, when
+// any number of x is good enough.
+func (x *Interval) Simplest() (*numbers.Q, error) {
+	switch {
+	case x.Contains(numbers.ZeroQ()):
+		return numbers.ZeroQ(), nil
+	case x.hi.Compare(numbers.ZeroQ()) < 0:
+		// -[a, b] = [-b, -a]
+		lo, e := numbers.ZeroQ().SubtractChecked(x.hi)
+		if e != nil {
+			return nil, e
+		}
+		hi, e := numbers.ZeroQ().SubtractChecked(x.lo)
+		if e != nil {
+			return nil, e
+		}
+		r, e := simplest(lo, hi)
+		if e != nil {
+			return nil, e
+		}
+		return numbers.ZeroQ().SubtractChecked(r)
+	}
+	return simplest(x.lo, x.hi)
+}
+
+// simplest returns the number with the smallest denominator in [lo, hi], 0 < lo <= hi
+//
+// For fl = floor(lo): if there's an integer in [lo, hi], the smallest one is the answer. Otherwise every number in
+// [lo, hi] is fl + 1/y for y in [1/(hi - fl), 1/(lo - fl)], and the simplest y gives the simplest number.
+func simplest(lo *numbers.Q, hi *numbers.Q) (*numbers.Q, error) {
+	a, b := lo.Ratio()
+	fl := a / b
+	if a%b == 0 {
+		return lo, nil
+	}
+	if c, _ := numbers.QFromInts(fl+1, 1); c.Compare(hi) <= 0 {
+		return c, nil
+	}
+	f, _ := numbers.QFromInts(fl, 1)
+	// lo - fl and hi - fl are in (0, 1), so the reciprocals fit
+	ylo, _ := numbers.OneQ().Divide(hi.Subtract(f))
+	yhi, _ := numbers.OneQ().Divide(lo.Subtract(f))
+	y, e := simplest(ylo, yhi)
+	if e != nil {
+		return nil, e
+	}
+	r, e := numbers.OneQ().Divide(y)
+	if e != nil {
+		return nil, e
+	}
+	return f.AddChecked(r)
+}
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestArithmetic(t *testing.T) {
+	x, y := interval(t, "-1/1", "2/1"), interval(t, "1/2", "3/1")
+	for _, c := range []struct {
+		name string
+		op   func(*Interval) (*Interval, error)
+		exp  string
+	}{
+		{"+", x.Add, "[-1/2, 5/1]"},
+		{"-", x.Subtract, "[-4/1, 3/2]"},
+		{"*", x.Multiply, "[-3/1, 6/1]"},
+		{"/", x.Divide, "[-2/1, 4/1]"},
+	} {
+		if r, e := c.op(y); e != nil || r.String() != c.exp {
+			t.Errorf("%s %s %s: expected %s, got %v (%v)", x, c.name, y, c.exp, r, e)
+		}
+	}
+	if _, e := y.Divide(x); !errors.Is(e, ErrContainsZero) {
+		t.Errorf("expected ErrContainsZero, got %v", e)
+	}
+	huge := Point(numbers.NewQ("9223372036854775807/1"))
+	if _, e := huge.Add(huge); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
+
+func TestSimplest(t *testing.T) {
+	for _, c := range []struct{ lo, hi, exp string }{
+		{"-1/2", "1/3", "0/1"},
+		{"3/1", "7/2", "3/1"},
+		{"5/2", "7/2", "3/1"},
+		{"31415/10000", "31416/10000", "333/106"},
+		{"-31416/10000", "-31415/10000", "-333/106"},
+		{"1/3", "1/3", "1/3"},
+		{"355/113", "355/113", "355/113"},
+		{"3141592/1000000", "3141593/1000000", "355/113"},
+	} {
+		if s, e := interval(t, c.lo, c.hi).Simplest(); e != nil || s.String() != c.exp {
+			t.Errorf("simplest in [%s, %s]: expected %s, got %v (%v)", c.lo, c.hi, c.exp, s, e)
+		}
+	}
+	x, _ := numbers.QFromInts(math.MinInt64, 1)
+	if _, e := Point(x).Simplest(); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
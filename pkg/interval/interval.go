@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package interval implements closed intervals [lo, hi] of ℚ and interval arithmetic. The result of an operation on
+// intervals contains the results of the operation on all numbers from the arguments, so intervals can enclose values
+// which can't be calculated exactly - like irrational roots of polynomials.
+package interval
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// ErrContainsZero is returned when dividing by interval which contains ZERO
+var ErrContainsZero = errors.New("can't divide by interval containing ZERO")
+
+// Interval is closed interval [lo, hi] of ℚ, lo <= hi. Just like numbers, values of Interval are immutable.
+type Interval struct {
+	lo *numbers.Q
+	hi *numbers.Q
+
+	fmt.Stringer
+}
+
+// New creates [lo, hi], returns an error if lo > hi
+func New(lo *numbers.Q, hi *numbers.Q) (*Interval, error) {
+	if lo.Compare(hi) > 0 {
+		return nil, fmt.Errorf("empty interval [%s, %s]", lo, hi)
+	}
+	return &Interval{lo: lo, hi: hi}, nil
+}
+
+// Point creates [q, q]
+func Point(q *numbers.Q) *Interval {
+	return &Interval{lo: q, hi: q}
+}
+
+// Lo returns the lower end of x
+func (x *Interval) Lo() *numbers.Q {
+	return x.lo
+}
+
+// Hi returns the upper end of x
+func (x *Interval) Hi() *numbers.Q {
+	return x.hi
+}
+
+// Width returns hi - lo or ErrOverflow
+func (x *Interval) Width() (*numbers.Q, error) {
+	return x.hi.SubtractChecked(x.lo)
+}
+
+// Midpoint returns (lo + hi) / 2 or ErrOverflow
+func (x *Interval) Midpoint() (*numbers.Q, error) {
+	w, e := x.Width()
+	if e != nil {
+		return nil, e
+	}
+	half, e := w.MultiplyChecked(numbers.NewQ("1/2"))
+	if e != nil {
+		return nil, e
+	}
+	return x.lo.AddChecked(half)
+}
+
+// Contains checks if lo <= q <= hi
+func (x *Interval) Contains(q *numbers.Q) bool {
+	return x.lo.Compare(q) <= 0 && q.Compare(x.hi) <= 0
+}
+
+// Subset checks if x ⊆ arg
+func (x *Interval) Subset(arg *Interval) bool {
+	return arg.lo.Compare(x.lo) <= 0 && x.hi.Compare(arg.hi) <= 0
+}
+
+// Interior checks if x is in the interior of arg: arg.lo < x.lo and x.hi < arg.hi
+func (x *Interval) Interior(arg *Interval) bool {
+	return arg.lo.Compare(x.lo) < 0 && x.hi.Compare(arg.hi) < 0
+}
+
+// Intersect returns x ∩ arg, ok is false if it's empty
+func (x *Interval) Intersect(arg *Interval) (res *Interval, ok bool) {
+	lo, hi := numbers.MaxQ([]*numbers.Q{x.lo, arg.lo}), numbers.MinQ([]*numbers.Q{x.hi, arg.hi})
+	if lo.Compare(hi) > 0 {
+		return nil, false
+	}
+	return &Interval{lo: lo, hi: hi}, true
+}
+
+// Equal checks if x and arg have the same ends
+func (x *Interval) Equal(arg *Interval) bool {
+	return x.lo.Compare(arg.lo) == 0 && x.hi.Compare(arg.hi) == 0
+}
+
+// String formats x as "[lo, hi]"
+func (x *Interval) String() string {
+	return fmt.Sprintf("[%s, %s]", x.lo, x.hi)
+}
+
+var _ = fmt.Stringer(&Interval{})
@@ -0,0 +1,69 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package interval
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func interval(t *testing.T, lo string, hi string) *Interval {
+	x, e := New(numbers.NewQ(lo), numbers.NewQ(hi))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return x
+}
+
+func TestNew(t *testing.T) {
+	x := interval(t, "-1/2", "3/1")
+	if x.String() != "[-1/2, 3/1]" || x.Lo().Compare(numbers.NewQ("-1/2")) != 0 || x.Hi().Compare(numbers.NewQ("3/1")) != 0 {
+		t.Errorf("unexpected interval %s", x)
+	}
+	if _, e := New(numbers.OneQ(), numbers.ZeroQ()); e == nil {
+		t.Error("[1, 0] should be empty")
+	}
+	if p := Point(numbers.OneQ()); p.String() != "[1/1, 1/1]" {
+		t.Errorf("unexpected point %s", p)
+	}
+	if w, _ := x.Width(); w.Compare(numbers.NewQ("7/2")) != 0 {
+		t.Errorf("width of %s: %s", x, w)
+	}
+	if m, _ := x.Midpoint(); m.Compare(numbers.NewQ("5/4")) != 0 {
+		t.Errorf("midpoint of %s: %s", x, m)
+	}
+}
+
+func TestRelations(t *testing.T) {
+	x, y := interval(t, "0/1", "2/1"), interval(t, "1/1", "3/1")
+	if !x.Contains(numbers.ZeroQ()) || x.Contains(numbers.NewQ("-1/3")) {
+		t.Errorf("wrong elements of %s", x)
+	}
+	if i, ok := x.Intersect(y); !ok || !i.Equal(interval(t, "1/1", "2/1")) {
+		t.Errorf("%s ∩ %s = %v", x, y, i)
+	}
+	if _, ok := x.Intersect(interval(t, "5/2", "3/1")); ok {
+		t.Errorf("intersection should be empty")
+	}
+	inner := interval(t, "1/2", "3/2")
+	if !inner.Subset(x) || !inner.Interior(x) || !x.Subset(x) || x.Interior(x) || y.Subset(x) {
+		t.Errorf("wrong inclusions of %s, %s and %s", inner, x, y)
+	}
+}
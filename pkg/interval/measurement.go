@@ -0,0 +1,217 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Measurement is value ± uncertainty - a measured quantity whose true value is somewhere in
+// [value - uncertainty, value + uncertainty]. The uncertainty is propagated through arithmetic as the worst case
+// bound (not the statistical estimate): the true result of an operation is always within the resulting uncertainty
+// of the result of the operation on values. Values of Measurement are immutable.
+type Measurement struct {
+	value       *numbers.Q
+	uncertainty *numbers.Q
+
+	fmt.Stringer
+}
+
+// NewMeasurement creates value ± uncertainty, the uncertainty can't be negative
+func NewMeasurement(value *numbers.Q, uncertainty *numbers.Q) (*Measurement, error) {
+	if uncertainty.Compare(numbers.ZeroQ()) < 0 {
+		return nil, fmt.Errorf("uncertainty %s is negative", uncertainty)
+	}
+	return &Measurement{value: value, uncertainty: uncertainty}, nil
+}
+
+// ParseMeasurement parses "value ± uncertainty" (or "value +- uncertainty"), where both numbers are accepted by
+// numbers.ParseScientific or numbers.ParseQ: "9.81 ± 0.02", "1/3 +- 1/100". Uncertainty may be omitted for exact
+// values.
+func ParseMeasurement(v string) (*Measurement, error) {
+	s := strings.Replace(v, "+-", "±", 1)
+	value, uncertainty, found := strings.Cut(s, "±")
+	if !found {
+		uncertainty = "0"
+	}
+	a, e := parseQ(strings.TrimSpace(value))
+	if e != nil {
+		return nil, fmt.Errorf("invalid measurement %q: %w", v, e)
+	}
+	u, e := parseQ(strings.TrimSpace(uncertainty))
+	if e != nil {
+		return nil, fmt.Errorf("invalid measurement %q: %w", v, e)
+	}
+	return NewMeasurement(a, u)
+}
+
+// parseQ parses decimal number or fraction
+func parseQ(v string) (*numbers.Q, error) {
+	if q, e := numbers.ParseScientific(v); e == nil {
+		return q, nil
+	}
+	return numbers.ParseQ(v)
+}
+
+// Value returns the measured value
+func (m *Measurement) Value() *numbers.Q {
+	return m.value
+}
+
+// Uncertainty returns the absolute uncertainty
+func (m *Measurement) Uncertainty() *numbers.Q {
+	return m.uncertainty
+}
+
+// RelativeUncertainty returns uncertainty / |value|, value can't be ZERO
+func (m *Measurement) RelativeUncertainty() (*numbers.Q, error) {
+	if m.value.Compare(numbers.ZeroQ()) == 0 {
+		return nil, errors.New("relative uncertainty of ZERO is not defined")
+	}
+	v, e := m.value.AbsChecked()
+	if e != nil {
+		return nil, e
+	}
+	return m.uncertainty.Divide(v)
+}
+
+// Interval returns [value - uncertainty, value + uncertainty] or ErrOverflow
+func (m *Measurement) Interval() (*Interval, error) {
+	lo, e := m.value.SubtractChecked(m.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := m.value.AddChecked(m.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	return &Interval{lo: lo, hi: hi}, nil
+}
+
+// Add returns (a ± δa) + (b ± δb) = (a + b) ± (δa + δb) or ErrOverflow
+func (m *Measurement) Add(arg *Measurement) (*Measurement, error) {
+	v, e := m.value.AddChecked(arg.value)
+	if e != nil {
+		return nil, e
+	}
+	u, e := m.uncertainty.AddChecked(arg.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	return &Measurement{value: v, uncertainty: u}, nil
+}
+
+// Subtract returns (a ± δa) - (b ± δb) = (a - b) ± (δa + δb) or ErrOverflow - uncertainties never cancel
+func (m *Measurement) Subtract(arg *Measurement) (*Measurement, error) {
+	v, e := m.value.SubtractChecked(arg.value)
+	if e != nil {
+		return nil, e
+	}
+	u, e := m.uncertainty.AddChecked(arg.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	return &Measurement{value: v, uncertainty: u}, nil
+}
+
+// Multiply returns (a ± δa) * (b ± δb) = ab ± (|a|δb + |b|δa + δaδb) or ErrOverflow. The last term is usually
+// neglected, but without it the bound wouldn't hold: (2 ± 1) * (2 ± 1) can be 9 = 4 + 5.
+func (m *Measurement) Multiply(arg *Measurement) (*Measurement, error) {
+	v, e := m.value.MultiplyChecked(arg.value)
+	if e != nil {
+		return nil, e
+	}
+	u, e := crossUncertainty(m, arg)
+	if e != nil {
+		return nil, e
+	}
+	uu, e := m.uncertainty.MultiplyChecked(arg.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	if u, e = u.AddChecked(uu); e != nil {
+		return nil, e
+	}
+	return &Measurement{value: v, uncertainty: u}, nil
+}
+
+// Divide returns (a ± δa) / (b ± δb) = a/b ± (|a|δb + |b|δa) / (|b|(|b| - δb)) or ErrOverflow. The interval of the
+// divisor can't contain ZERO (|b| > δb), otherwise the quotient has no bound and ErrContainsZero is returned.
+//
+// The bound is the largest of |a/b - (a ± δa)/(b ± δb)|, reached when the divisor is closest to ZERO.
+func (m *Measurement) Divide(arg *Measurement) (*Measurement, error) {
+	b, e := arg.value.AbsChecked()
+	if e != nil {
+		return nil, e
+	}
+	if b.Compare(arg.uncertainty) <= 0 {
+		return nil, ErrContainsZero
+	}
+	v, e := m.value.Divide(arg.value)
+	if e != nil {
+		return nil, e
+	}
+	num, e := crossUncertainty(m, arg)
+	if e != nil {
+		return nil, e
+	}
+	closest, e := b.SubtractChecked(arg.uncertainty)
+	if e != nil {
+		return nil, e
+	}
+	den, e := b.MultiplyChecked(closest)
+	if e != nil {
+		return nil, e
+	}
+	u, e := num.Divide(den)
+	if e != nil {
+		return nil, e
+	}
+	return &Measurement{value: v, uncertainty: u}, nil
+}
+
+// String formats m as "value ± uncertainty"
+func (m *Measurement) String() string {
+	return fmt.Sprintf("%s ± %s", m.value, m.uncertainty)
+}
+
+// crossUncertainty returns |a|δb + |b|δa for a ± δa and b ± δb
+func crossUncertainty(m *Measurement, arg *Measurement) (*numbers.Q, error) {
+	a, e := m.value.AbsChecked()
+	if e != nil {
+		return nil, e
+	}
+	b, e := arg.value.AbsChecked()
+	if e != nil {
+		return nil, e
+	}
+	if a, e = a.MultiplyChecked(arg.uncertainty); e != nil {
+		return nil, e
+	}
+	if b, e = b.MultiplyChecked(m.uncertainty); e != nil {
+		return nil, e
+	}
+	return a.AddChecked(b)
+}
+
+var _ = fmt.Stringer(&Measurement{})
@@ -0,0 +1,115 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package interval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func measurement(t *testing.T, v string) *Measurement {
+	m, e := ParseMeasurement(v)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return m
+}
+
+func TestParseMeasurement(t *testing.T) {
+	for v, exp := range map[string]string{
+		"9.81 ± 0.02":  "981/100 ± 1/50",
+		"1/3 +- 1/100": "1/3 ± 1/100",
+		"-2.5±0.5":     "-5/2 ± 1/2",
+		"7":            "7/1 ± 0/1",
+	} {
+		if m := measurement(t, v); m.String() != exp {
+			t.Errorf("%q: expected %s, got %s", v, exp, m)
+		}
+	}
+	for _, v := range []string{"", "1 ± x", "x ± 1", "1 ± -1"} {
+		if _, e := ParseMeasurement(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+	m := measurement(t, "-4 ± 0.1")
+	if r, e := m.RelativeUncertainty(); e != nil || r.String() != "1/40" {
+		t.Errorf("relative uncertainty of %s: %v (%v)", m, r, e)
+	}
+	if i, e := m.Interval(); e != nil || i.String() != "[-41/10, -39/10]" {
+		t.Errorf("interval of %s: %v (%v)", m, i, e)
+	}
+	if _, e := measurement(t, "0 ± 1").RelativeUncertainty(); e == nil {
+		t.Error("relative uncertainty of 0: expected error")
+	}
+}
+
+func TestMeasurementArithmetic(t *testing.T) {
+	a, b := measurement(t, "2 ± 1"), measurement(t, "-3 ± 0.5")
+	for _, c := range []struct {
+		name string
+		op   func(*Measurement) (*Measurement, error)
+		exp  string
+	}{
+		{"+", a.Add, "-1/1 ± 3/2"},
+		{"-", a.Subtract, "5/1 ± 3/2"},
+		{"*", a.Multiply, "-6/1 ± 9/2"},
+		{"/", a.Divide, "-2/3 ± 8/15"},
+	} {
+		if r, e := c.op(b); e != nil || r.String() != c.exp {
+			t.Errorf("(%s) %s (%s): expected %s, got %v (%v)", a, c.name, b, c.exp, r, e)
+		}
+	}
+	if _, e := b.Divide(measurement(t, "0.5 ± 1")); !errors.Is(e, ErrContainsZero) {
+		t.Errorf("expected ErrContainsZero, got %v", e)
+	}
+}
+
+func TestMeasurementBounds(t *testing.T) {
+	// the true results at the ends of the intervals have to be within the uncertainty
+	a, b := measurement(t, "2 ± 1"), measurement(t, "-3 ± 0.5")
+	ia, _ := a.Interval()
+	ib, _ := b.Interval()
+	for _, c := range []struct {
+		op func(*Measurement) (*Measurement, error)
+		q  func(*numbers.Q, *numbers.Q) (*numbers.Q, error)
+	}{
+		{a.Add, (*numbers.Q).AddChecked},
+		{a.Subtract, (*numbers.Q).SubtractChecked},
+		{a.Multiply, (*numbers.Q).MultiplyChecked},
+		{a.Divide, (*numbers.Q).Divide},
+	} {
+		r, _ := c.op(b)
+		ir, _ := r.Interval()
+		tight := false
+		for _, x := range []*numbers.Q{ia.Lo(), ia.Hi()} {
+			for _, y := range []*numbers.Q{ib.Lo(), ib.Hi()} {
+				v, _ := c.q(x, y)
+				if !ir.Contains(v) {
+					t.Errorf("%s is not in %s", v, ir)
+				}
+				tight = tight || v.Compare(ir.Lo()) == 0 || v.Compare(ir.Hi()) == 0
+			}
+		}
+		if !tight {
+			t.Errorf("%s is not the worst case bound", r)
+		}
+	}
+}
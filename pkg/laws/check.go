@@ -0,0 +1,132 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package laws
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// DefaultSamples is the number of random tuples checked for every law by Check
+const DefaultSamples = 100
+
+// Law is a named property which has to hold for every tuple of Arity elements
+type Law[T any] struct {
+	Name  string
+	Arity int
+	Holds func(args ...T) bool
+}
+
+// Violation is returned when a law doesn't hold - Args is the counterexample
+type Violation[T any] struct {
+	Law  string
+	Args []T
+}
+
+func (v *Violation[T]) Error() string {
+	return fmt.Sprintf("%s doesn't hold for %v", v.Law, v.Args)
+}
+
+// SemiringLaws returns the rules (a) - (e) for addition and multiplication
+func SemiringLaws[T Semiring[T]]() []Law[T] {
+	return []Law[T]{
+		binary("addition commutes", AdditionCommutes[T]),
+		ternary("addition associates", AdditionAssociates[T]),
+		binary("multiplication commutes", MultiplicationCommutes[T]),
+		ternary("multiplication distributes over addition", MultiplicationDistributes[T]),
+		ternary("multiplication associates", MultiplicationAssociates[T]),
+	}
+}
+
+// RingLaws returns SemiringLaws with the rules for subtraction and additive identity
+func RingLaws[T Ring[T]]() []Law[T] {
+	return append(SemiringLaws[T](),
+		binary("ZERO is additive identity", AdditiveIdentity[T]),
+		binary("subtraction inverts addition", SubtractionInverts[T]),
+	)
+}
+
+// FieldLaws returns RingLaws with the rules for division and multiplicative identity
+func FieldLaws[T Field[T]]() []Law[T] {
+	return append(RingLaws[T](),
+		binary("ONE is multiplicative identity", MultiplicativeIdentity[T]),
+		binary("division inverts multiplication", DivisionInverts[T]),
+		binary("division by ZERO fails", DivisionByZeroFails[T]),
+	)
+}
+
+// PowerLaws returns the rules (f) - (h) for raising to a power
+func PowerLaws[T Exponential[T]]() []Law[T] {
+	return []Law[T]{
+		ternary("power of product", PowerOfProduct[T]),
+		ternary("product of powers", ProductOfPowers[T]),
+		ternary("power of power", PowerOfPower[T]),
+	}
+}
+
+// Check checks every law for DefaultSamples tuples of random elements - see CheckWith
+func Check[T any](laws []Law[T], generate func(r *rand.Rand) T) error {
+	return CheckWith(laws, generate, DefaultSamples, 1)
+}
+
+// CheckWith checks every law for samples tuples of elements created by generate from random source seeded with seed,
+// so the same seed checks the same tuples. Returns *Violation for the first tuple for which a law doesn't hold.
+//
+// A tuple for which an operation panics with numbers.ErrOverflow is skipped - the law can't be checked, because the
+// result doesn't fit the representation, which is not a counterexample. Other panics are not recovered.
+func CheckWith[T any](laws []Law[T], generate func(r *rand.Rand) T, samples int, seed uint64) error {
+	r := rand.New(rand.NewPCG(seed, seed))
+	for _, law := range laws {
+		for range samples {
+			args := make([]T, law.Arity)
+			for i := range args {
+				args[i] = generate(r)
+			}
+			if !holds(law, args) {
+				return &Violation[T]{Law: law.Name, Args: args}
+			}
+		}
+	}
+	return nil
+}
+
+// holds checks law for args, treating overflow as success
+func holds[T any](law Law[T], args []T) (ok bool) {
+	defer func() {
+		if p := recover(); p != nil {
+			if e, isErr := p.(error); isErr && errors.Is(e, numbers.ErrOverflow) {
+				ok = true
+				return
+			}
+			panic(p)
+		}
+	}()
+	return law.Holds(args...)
+}
+
+func binary[T any](name string, p func(a T, b T) bool) Law[T] {
+	return Law[T]{Name: name, Arity: 2, Holds: func(args ...T) bool { return p(args[0], args[1]) }}
+}
+
+func ternary[T any](name string, p func(a T, b T, c T) bool) Law[T] {
+	return Law[T]{Name: name, Arity: 3, Holds: func(args ...T) bool { return p(args[0], args[1], args[2]) }}
+}
@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package laws
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestCheckN(t *testing.T) {
+	// ℕ arithmetic is repeated addition, so the values have to be small
+	small := func(limit uint64) func(r *rand.Rand) *numbers.N {
+		return func(r *rand.Rand) *numbers.N { return numbers.NFromUint64(r.Uint64N(limit)) }
+	}
+	if e := Check(SemiringLaws[*numbers.N](), small(100)); e != nil {
+		t.Error(e)
+	}
+	if e := Check(PowerLaws[*numbers.N](), small(4)); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestCheckZ(t *testing.T) {
+	// ℤ is built from ℕ, so the values have to be small too
+	if e := Check(RingLaws[*numbers.Z](), func(r *rand.Rand) *numbers.Z {
+		return numbers.ZFromInt64(r.Int64N(201) - 100)
+	}); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestCheckQ(t *testing.T) {
+	q := func(r *rand.Rand) *numbers.Q {
+		v, _ := numbers.QFromInts(r.Int64N(201)-100, r.Int64N(100)+1)
+		return v
+	}
+	if e := CheckWith(FieldLaws[*numbers.Q](), q, 1000, 42); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestCheckSkipsOverflow(t *testing.T) {
+	huge := func(r *rand.Rand) *numbers.Q {
+		v, _ := numbers.QFromInts(math.MaxInt64-r.Int64N(10), r.Int64N(3)+1)
+		return v
+	}
+	if e := Check(FieldLaws[*numbers.Q](), huge); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestCheckReportsViolation(t *testing.T) {
+	e := Check(RingLaws[brokenZ](), func(r *rand.Rand) brokenZ { return brokenZ(r.Int64N(10) + 1) })
+	var v *Violation[brokenZ]
+	if !errors.As(e, &v) || v.Law != "addition commutes" || len(v.Args) != 2 || v.Args[0] == v.Args[1] {
+		t.Errorf("expected violation of commutativity, got %v", e)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package laws exposes the basic rules of arithmetic documented for ℕ, ℤ and ℚ (commutativity, associativity,
+// distributivity and power laws) as predicates, which can be checked against any implementation of Semiring, Ring
+// or Field - the types of package numbers as well as users' own extensions.
+package laws
+
+import (
+	"errors"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Semiring is a set with addition and multiplication, like ℕ. Compare is needed to check if two results are equal.
+type Semiring[T any] interface {
+	Add(T) T
+	Multiply(T) T
+	Compare(T) int
+}
+
+// Ring is Semiring with subtraction, like ℤ
+type Ring[T any] interface {
+	Semiring[T]
+	Subtract(T) T
+}
+
+// Field is Ring with division by non-zero elements, like ℚ
+type Field[T any] interface {
+	Ring[T]
+	Divide(T) (T, error)
+}
+
+// Exponential is Semiring raised to powers from the same set, like ℕ
+type Exponential[T any] interface {
+	Semiring[T]
+	Power(T) T
+}
+
+// AdditionCommutes checks (a) a+b = b+a
+func AdditionCommutes[T Semiring[T]](a T, b T) bool {
+	return a.Add(b).Compare(b.Add(a)) == 0
+}
+
+// AdditionAssociates checks (d) a+(b+c) = (a+b)+c
+func AdditionAssociates[T Semiring[T]](a T, b T, c T) bool {
+	return a.Add(b.Add(c)).Compare(a.Add(b).Add(c)) == 0
+}
+
+// MultiplicationCommutes checks (b) a*b = b*a
+func MultiplicationCommutes[T Semiring[T]](a T, b T) bool {
+	return a.Multiply(b).Compare(b.Multiply(a)) == 0
+}
+
+// MultiplicationDistributes checks (c) a*(b+c) = a*b + a*c
+func MultiplicationDistributes[T Semiring[T]](a T, b T, c T) bool {
+	return a.Multiply(b.Add(c)).Compare(a.Multiply(b).Add(a.Multiply(c))) == 0
+}
+
+// MultiplicationAssociates checks (e) (a*b)*c = a*(b*c)
+func MultiplicationAssociates[T Semiring[T]](a T, b T, c T) bool {
+	return a.Multiply(b).Multiply(c).Compare(a.Multiply(b.Multiply(c))) == 0
+}
+
+// PowerOfProduct checks (f) (a*b)^c = a^c * b^c
+func PowerOfProduct[T Exponential[T]](a T, b T, c T) bool {
+	return a.Multiply(b).Power(c).Compare(a.Power(c).Multiply(b.Power(c))) == 0
+}
+
+// ProductOfPowers checks (g) a^b * a^c = a^(b+c)
+func ProductOfPowers[T Exponential[T]](a T, b T, c T) bool {
+	return a.Power(b).Multiply(a.Power(c)).Compare(a.Power(b.Add(c))) == 0
+}
+
+// PowerOfPower checks (h) (a^b)^c = a^(b*c)
+func PowerOfPower[T Exponential[T]](a T, b T, c T) bool {
+	return a.Power(b).Power(c).Compare(a.Power(b.Multiply(c))) == 0
+}
+
+// AdditiveIdentity checks (i) a+0 = a, where 0 = b-b
+func AdditiveIdentity[T Ring[T]](a T, b T) bool {
+	return a.Add(b.Subtract(b)).Compare(a) == 0
+}
+
+// SubtractionInverts checks (a-b)+b = a - subtraction undoes addition
+func SubtractionInverts[T Ring[T]](a T, b T) bool {
+	return a.Subtract(b).Add(b).Compare(a) == 0
+}
+
+// MultiplicativeIdentity checks (j) a*1 = a, where 1 = b/b for b != 0
+func MultiplicativeIdentity[T Field[T]](a T, b T) bool {
+	one, e := b.Divide(b)
+	if e != nil {
+		// b = 0 - there's nothing to check
+		return true
+	}
+	return a.Multiply(one).Compare(a) == 0
+}
+
+// DivisionInverts checks (a/b)*b = a for b != 0 - division undoes multiplication
+func DivisionInverts[T Field[T]](a T, b T) bool {
+	q, e := a.Divide(b)
+	if e != nil {
+		// only ZERO can't divide, unless the result doesn't fit the representation
+		return errors.Is(e, numbers.ErrOverflow) || b.Compare(b.Subtract(b)) == 0
+	}
+	return q.Multiply(b).Compare(a) == 0
+}
+
+// DivisionByZeroFails checks that a/0 returns an error, where 0 = b-b
+func DivisionByZeroFails[T Field[T]](a T, b T) bool {
+	_, e := a.Divide(b.Subtract(b))
+	return e != nil
+}
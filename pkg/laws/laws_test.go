@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package laws
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPredicates(t *testing.T) {
+	a, b, c := numbers.NewQ("1/2"), numbers.NewQ("-2/3"), numbers.NewQ("5/7")
+	if !AdditionCommutes(a, b) || !AdditionAssociates(a, b, c) || !MultiplicationCommutes(a, b) ||
+		!MultiplicationDistributes(a, b, c) || !MultiplicationAssociates(a, b, c) {
+		t.Error("semiring laws should hold for ℚ")
+	}
+	if !AdditiveIdentity(a, b) || !SubtractionInverts(a, b) {
+		t.Error("ring laws should hold for ℚ")
+	}
+	if !MultiplicativeIdentity(a, numbers.ZeroQ()) || !DivisionInverts(a, numbers.ZeroQ()) ||
+		!DivisionByZeroFails(a, b) || !DivisionInverts(a, b) {
+		t.Error("field laws should hold for ℚ")
+	}
+	x, y, z := numbers.NFromUint64(2), numbers.NFromUint64(3), numbers.NFromUint64(4)
+	if !PowerOfProduct(x, y, z) || !ProductOfPowers(x, y, z) || !PowerOfPower(x, y, z) {
+		t.Error("power laws should hold for ℕ")
+	}
+}
+
+// brokenZ has non-commutative "addition" and "multiplication" distributing only from the left
+type brokenZ int64
+
+func (a brokenZ) Add(b brokenZ) brokenZ      { return a - b }
+func (a brokenZ) Multiply(b brokenZ) brokenZ { return a * b }
+func (a brokenZ) Subtract(b brokenZ) brokenZ { return a + b }
+func (a brokenZ) Compare(b brokenZ) int      { return int(a - b) }
+
+func TestPredicatesFindCounterexamples(t *testing.T) {
+	if AdditionCommutes(brokenZ(1), brokenZ(2)) {
+		t.Error("1 - 2 = 2 - 1")
+	}
+	if AdditionAssociates(brokenZ(1), brokenZ(2), brokenZ(3)) {
+		t.Error("1 - (2 - 3) = (1 - 2) - 3")
+	}
+	if !AdditionCommutes(brokenZ(2), brokenZ(2)) {
+		t.Error("2 - 2 != 2 - 2")
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Add works out long addition - digits are added column by column from the right and the carries are written above
+// the next column:
+//
+//	  11
+//	  478
+//	+ 356
+//	-----
+//	  834
+func Add(a *numbers.N, b *numbers.N) *Worked {
+	sum, carries := addColumns([][]int{digitsOf(a), digitsOf(b)})
+	res := format(sum)
+	sa, sb := a.String(), b.String()
+	s := &sheet{width: max(len(sa), len(sb), len(res)) + 2}
+	if row, _ := marks(carries); row != "" {
+		s.row(row, len(sum)-len(row))
+	}
+	s.row(sa, 0)
+	s.row(fmt.Sprintf("+ %*s", s.width-2, sb), 0)
+	s.rule(s.width, 0)
+	s.row(res, 0)
+	return s.worked(res)
+}
+
+// addColumns adds numbers given as digits (the least significant first) column by column. carries[i] is what's carried
+// from column i-1 to column i.
+func addColumns(rows [][]int) (sum []int, carries []int) {
+	columns := 0
+	for _, r := range rows {
+		columns = max(columns, len(r))
+	}
+	carries = make([]int, columns)
+	carry := 0
+	for i := range columns {
+		carries[i] = carry
+		total := carry
+		for _, r := range rows {
+			if i < len(r) {
+				total += r[i]
+			}
+		}
+		sum, carry = append(sum, total%10), total/10
+	}
+	for carry > 0 {
+		carries = append(carries, carry)
+		sum, carry = append(sum, carry%10), carry/10
+	}
+	return sum, carries
+}
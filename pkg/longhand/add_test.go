@@ -0,0 +1,41 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestAddLayout(t *testing.T) {
+	expectLayout(t, Add(n(478), n(356)), "  11", "  478", "+ 356", "-----", "  834")
+	expectLayout(t, Add(n(999), n(1)), "  111", "   999", "+    1", "------", "  1000")
+	expectLayout(t, Add(n(12), n(34)), "  12", "+ 34", "----", "  46")
+}
+
+func TestAddResult(t *testing.T) {
+	for _, c := range [][2]uint64{{0, 0}, {5, 5}, {123456789, 987654321}} {
+		if r := Add(n(c[0]), n(c[1])).Result(); r != strconv.FormatUint(c[0]+c[1], 10) {
+			t.Errorf("%d + %d: got %s", c[0], c[1], r)
+		}
+	}
+	if r := Add(n(18446744073709551615), n(1)).Result(); r != "18446744073709551616" {
+		t.Errorf("got %s", r)
+	}
+}
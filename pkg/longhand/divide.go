@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Divide works out long division - digits of a are brought down one by one next to the remainder, the biggest digit q
+// such that q·b fits in it is the next digit of the quotient and q·b is subtracted:
+//
+//	      27 r 1
+//	     ---
+//	12 ) 325
+//	     24
+//	     --
+//	      85
+//	      84
+//	      --
+//	       1
+//
+// The division starts with the shortest prefix of a which isn't smaller than b.
+func Divide(a *numbers.N, b *numbers.N) (*Worked, error) {
+	if b.Uint64() == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	sa, sb := a.String(), b.String()
+	divisor := new(big.Int).SetUint64(b.Uint64())
+	type step struct {
+		text  string
+		shift int
+	}
+	var steps []step
+	var quotient strings.Builder
+	cur := new(big.Int)
+	for i := range len(sa) {
+		cur.Mul(cur, big.NewInt(10))
+		cur.Add(cur, big.NewInt(int64(sa[i]-'0')))
+		if quotient.Len() == 0 && cur.Cmp(divisor) < 0 && i < len(sa)-1 {
+			continue
+		}
+		shift := len(sa) - 1 - i
+		if quotient.Len() > 0 {
+			// the remainder with the digit brought down
+			steps = append(steps, step{cur.String(), shift})
+		}
+		q, product := quotientDigit(cur, divisor)
+		quotient.WriteByte(byte('0' + q))
+		cur.Sub(cur, product)
+		p := product.String()
+		steps = append(steps, step{p, shift}, step{strings.Repeat("-", len(p)), shift})
+	}
+	rem := cur.String()
+	steps = append(steps, step{rem, 0})
+
+	res := quotient.String()
+	if rem != "0" {
+		res += " r " + rem
+	}
+	s := &sheet{width: len(sb) + 3 + len(sa)}
+	s.lines = append(s.lines, fmt.Sprintf("%*s", s.width+len(res)-quotient.Len(), res))
+	s.row(strings.Repeat("-", len(sa)), 0)
+	s.lines = append(s.lines, fmt.Sprintf("%s ) %s", sb, sa))
+	for _, st := range steps {
+		s.row(st.text, st.shift)
+	}
+	return s.worked(res), nil
+}
+
+// quotientDigit returns the biggest digit q such that q·divisor <= v (v < 10·divisor) and the product
+func quotientDigit(v *big.Int, divisor *big.Int) (int, *big.Int) {
+	product := new(big.Int)
+	for q := 9; q > 0; q-- {
+		product.Mul(divisor, big.NewInt(int64(q)))
+		if product.Cmp(v) <= 0 {
+			return q, product
+		}
+	}
+	return 0, product.SetInt64(0)
+}
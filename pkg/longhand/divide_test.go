@@ -0,0 +1,52 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDivideLayout(t *testing.T) {
+	w, e := Divide(n(325), n(12))
+	if e != nil {
+		t.Fatal(e)
+	}
+	expectLayout(t, w, "      27 r 1", "     ---", "12 ) 325", "     24", "     --", "      85", "      84", "      --",
+		"       1")
+	w, _ = Divide(n(3), n(5))
+	expectLayout(t, w, "    0 r 3", "    -", "5 ) 3", "    0", "    -", "    3")
+}
+
+func TestDivideResult(t *testing.T) {
+	for _, c := range [][2]uint64{{0, 7}, {1005, 5}, {999, 1}, {18446744073709551615, 18446744073709551614},
+		{18446744073709551615, 10}} {
+		expected := fmt.Sprint(c[0] / c[1])
+		if c[0]%c[1] != 0 {
+			expected += fmt.Sprintf(" r %d", c[0]%c[1])
+		}
+		w, e := Divide(n(c[0]), n(c[1]))
+		if e != nil || w.Result() != expected {
+			t.Errorf("%d / %d: expected %s, got %v (%v)", c[0], c[1], expected, w, e)
+		}
+	}
+	if _, e := Divide(n(3), n(0)); e == nil {
+		t.Error("expected error")
+	}
+}
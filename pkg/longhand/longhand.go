@@ -0,0 +1,115 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package longhand shows grade-school pencil-and-paper arithmetic: long addition, subtraction, multiplication and
+// division of natural numbers laid out column by column as text, with the carries, borrows, partial products and
+// remainders written down by the same digit-by-digit algorithms which calculate the result.
+package longhand
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Worked is a calculation worked out on paper - the lines of text and the result
+type Worked struct {
+	lines  []string
+	result string
+
+	fmt.Stringer
+}
+
+// Lines returns the lines of the layout, top to bottom
+func (w *Worked) Lines() []string {
+	return append([]string(nil), w.lines...)
+}
+
+// Result returns the result as decimal digits (it may not fit uint64) - "27 r 1" for division with remainder
+func (w *Worked) Result() string {
+	return w.result
+}
+
+func (w *Worked) String() string {
+	return strings.Join(w.lines, "\n")
+}
+
+var _ = fmt.Stringer(&Worked{})
+
+// sheet collects the lines of a layout with every line right-aligned to the same column
+type sheet struct {
+	width int
+	lines []string
+}
+
+// row adds s right-aligned, followed by shift spaces
+func (s *sheet) row(v string, shift int) {
+	line := fmt.Sprintf("%*s%s", s.width-shift, v, strings.Repeat(" ", shift))
+	s.lines = append(s.lines, strings.TrimRight(line, " "))
+}
+
+// rule adds an underline of given length, followed by shift spaces
+func (s *sheet) rule(length int, shift int) {
+	s.row(strings.Repeat("-", length), shift)
+}
+
+// worked returns the collected lines with the result
+func (s *sheet) worked(result string) *Worked {
+	return &Worked{lines: s.lines, result: result}
+}
+
+// digitsOf returns decimal digits of n, the least significant first
+func digitsOf(n *numbers.N) []int {
+	v := n.Uint64()
+	res := []int{int(v % 10)}
+	for v /= 10; v > 0; v /= 10 {
+		res = append(res, int(v%10))
+	}
+	return res
+}
+
+// format returns digits (the least significant first) as decimal number without leading zeros
+func format(ds []int) string {
+	i := len(ds) - 1
+	for i > 0 && ds[i] == 0 {
+		i--
+	}
+	var sb strings.Builder
+	for ; i >= 0; i-- {
+		sb.WriteByte(byte('0' + ds[i]))
+	}
+	return sb.String()
+}
+
+// marks returns the carries or borrows (the least significant column first) as a row of single digits with spaces for
+// columns without any. ok is false if some value has more digits.
+func marks(values []int) (row string, ok bool) {
+	var sb strings.Builder
+	for i := len(values) - 1; i >= 0; i-- {
+		switch v := values[i]; {
+		case v == 0:
+			sb.WriteByte(' ')
+		case v < 10:
+			sb.WriteByte(byte('0' + v))
+		default:
+			return "", false
+		}
+	}
+	return strings.TrimRight(sb.String(), " "), true
+}
@@ -0,0 +1,55 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func n(v uint64) *numbers.N {
+	return numbers.NFromUint64(v)
+}
+
+// expectLayout checks the lines of w
+func expectLayout(t *testing.T, w *Worked, lines ...string) {
+	t.Helper()
+	got := w.Lines()
+	if len(got) != len(lines) {
+		t.Fatalf("expected:\n%v\ngot:\n%s", lines, w)
+	}
+	for i := range lines {
+		if got[i] != lines[i] {
+			t.Errorf("line %d: expected %q, got %q", i, lines[i], got[i])
+		}
+	}
+}
+
+func TestMarks(t *testing.T) {
+	if row, ok := marks([]int{0, 1, 0, 1, 0}); !ok || row != " 1 1" {
+		t.Errorf("unexpected %q", row)
+	}
+	if _, ok := marks([]int{0, 12}); ok {
+		t.Error("expected two-digit carry to be rejected")
+	}
+	if format([]int{0, 0, 0}) != "0" || format([]int{1, 2, 0}) != "21" {
+		t.Error("unexpected format")
+	}
+}
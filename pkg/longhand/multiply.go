@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Multiply works out long multiplication - a is multiplied by each digit of b from the right, every partial product
+// is shifted one column more to the left and the partial products are added with the carries written above them:
+//
+//	   123
+//	×   45
+//	------
+//	  1
+//	   615
+//	+ 492
+//	------
+//	  5535
+//
+// Multiplying by single-digit b doesn't need the addition. The carries are shown only when each fits one column.
+func Multiply(a *numbers.N, b *numbers.N) *Worked {
+	da, db := digitsOf(a), digitsOf(b)
+	partials := make([][]int, len(db))
+	shifted := make([][]int, len(db))
+	for j, d := range db {
+		partials[j] = multiplyDigit(da, d)
+		shifted[j] = append(make([]int, j), partials[j]...)
+	}
+	sum, carries := addColumns(shifted)
+	res := format(sum)
+	sa, sb := a.String(), b.String()
+	width := max(len(sa), len(sb), len(res))
+	for j, p := range partials {
+		width = max(width, len(format(p))+j)
+	}
+	s := &sheet{width: width + 2}
+	s.row(sa, 0)
+	s.row(fmt.Sprintf("× %*s", s.width-2, sb), 0)
+	s.rule(s.width, 0)
+	if len(db) > 1 {
+		if row, ok := marks(carries); ok && row != "" {
+			s.row(row, len(carries)-len(row))
+		}
+		for j, p := range partials {
+			if j == len(partials)-1 {
+				s.row(fmt.Sprintf("+ %*s", s.width-2-j, format(p)), j)
+			} else {
+				s.row(format(p), j)
+			}
+		}
+		s.rule(s.width, 0)
+	}
+	s.row(res, 0)
+	return s.worked(res)
+}
+
+// multiplyDigit multiplies digits (the least significant first) by a single digit
+func multiplyDigit(ds []int, d int) []int {
+	res := make([]int, 0, len(ds)+1)
+	carry := 0
+	for _, v := range ds {
+		p := v*d + carry
+		res, carry = append(res, p%10), p/10
+	}
+	if carry > 0 {
+		res = append(res, carry)
+	}
+	return res
+}
@@ -0,0 +1,40 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMultiplyLayout(t *testing.T) {
+	expectLayout(t, Multiply(n(123), n(45)), "   123", "×   45", "------", "  1", "   615", "+ 492", "------", "  5535")
+	expectLayout(t, Multiply(n(123), n(405)), "    123", "×   405", "-------", "    615", "     0", "+ 492",
+		"-------", "  49815")
+	expectLayout(t, Multiply(n(7), n(8)), "   7", "×  8", "----", "  56")
+}
+
+func TestMultiplyResult(t *testing.T) {
+	for _, c := range [][2]uint64{{0, 0}, {0, 123}, {99, 99}, {18446744073709551615, 18446744073709551615}} {
+		expected := new(big.Int).Mul(new(big.Int).SetUint64(c[0]), new(big.Int).SetUint64(c[1]))
+		if r := Multiply(n(c[0]), n(c[1])).Result(); r != expected.String() {
+			t.Errorf("%d × %d: expected %s, got %s", c[0], c[1], expected, r)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Subtract works out long subtraction of b <= a - digits are subtracted column by column from the right and when the
+// digit of b is bigger, ten is borrowed from the next column of a, which is marked by 1 above the column receiving it:
+//
+//	   11
+//	  503
+//	- 178
+//	-----
+//	  325
+func Subtract(a *numbers.N, b *numbers.N) (*Worked, error) {
+	if a.Compare(b) < 0 {
+		return nil, fmt.Errorf("%s - %s has no solution in ℕ", a, b)
+	}
+	da, db := digitsOf(a), digitsOf(b)
+	diff := make([]int, len(da))
+	borrows := make([]int, len(da))
+	borrow := 0
+	for i := range da {
+		d := da[i] - borrow
+		if i < len(db) {
+			d -= db[i]
+		}
+		borrow = 0
+		if d < 0 {
+			d += 10
+			borrows[i], borrow = 1, 1
+		}
+		diff[i] = d
+	}
+	res := format(diff)
+	sa, sb := a.String(), b.String()
+	s := &sheet{width: len(sa) + 2}
+	if row, _ := marks(borrows); row != "" {
+		s.row(row, len(borrows)-len(row))
+	}
+	s.row(sa, 0)
+	s.row(fmt.Sprintf("- %*s", s.width-2, sb), 0)
+	s.rule(s.width, 0)
+	s.row(res, 0)
+	return s.worked(res), nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package longhand
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestSubtractLayout(t *testing.T) {
+	w, e := Subtract(n(503), n(178))
+	if e != nil {
+		t.Fatal(e)
+	}
+	expectLayout(t, w, "   11", "  503", "- 178", "-----", "  325")
+	w, _ = Subtract(n(1000), n(1))
+	expectLayout(t, w, "   111", "  1000", "-    1", "------", "   999")
+}
+
+func TestSubtractResult(t *testing.T) {
+	for _, c := range [][2]uint64{{0, 0}, {5, 5}, {987654321, 123456789}, {18446744073709551615, 1}} {
+		w, e := Subtract(n(c[0]), n(c[1]))
+		if e != nil || w.Result() != strconv.FormatUint(c[0]-c[1], 10) {
+			t.Errorf("%d - %d: got %v (%v)", c[0], c[1], w, e)
+		}
+	}
+	if _, e := Subtract(n(3), n(4)); e == nil {
+		t.Error("expected error")
+	}
+}
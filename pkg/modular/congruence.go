@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxCongruenceSolutions is the biggest number of solution classes returned by SolveCongruence - there are
+// gcd(a, n) of them, so 0·x = 0 (mod n) has n solutions
+const MaxCongruenceSolutions = 1 << 20
+
+// NoSolutionError is returned when a·x = b (mod n) has no solution, because gcd(a, n) doesn't divide b
+type NoSolutionError struct {
+	A   *numbers.Z
+	B   *numbers.Z
+	N   *numbers.Z
+	GCD *numbers.Z
+}
+
+func (e *NoSolutionError) Error() string {
+	return fmt.Sprintf("%s·x ≡ %s (mod %s) has no solution: gcd(%s, %s) = %s doesn't divide %s", e.A, e.B, e.N, e.A,
+		e.N, e.GCD, e.B)
+}
+
+// SolveCongruence returns all solutions 0 <= x < n of a·x = b (mod n) in increasing order or *NoSolutionError.
+//
+// For g = gcd(a, n), a·x - b is divisible by g for every x, so g has to divide b. Then the congruence is equivalent
+// to (a/g)·x = b/g (mod n/g), where a/g is invertible (extended Euclid's algorithm), so there's single solution x0
+// modulo n/g and g solutions x0, x0 + n/g, ..., x0 + (g-1)·n/g modulo n.
+func SolveCongruence(a *numbers.Z, b *numbers.Z, n *numbers.Z) ([]*numbers.Z, error) {
+	if n.Int64() <= 0 {
+		return nil, errors.New("modulus has to be positive")
+	}
+	mod := uint64(n.Int64())
+	ra, rb := residue(a.Int64(), mod), residue(b.Int64(), mod)
+	g, x := extendedGCD(int64(ra), int64(mod))
+	if rb%uint64(g) != 0 {
+		return nil, &NoSolutionError{A: a, B: b, N: n, GCD: numbers.ZFromInt64(g)}
+	}
+	if g > MaxCongruenceSolutions {
+		return nil, fmt.Errorf("too many solutions: %d > %d", g, MaxCongruenceSolutions)
+	}
+	// a·x = g (mod n), so a·x = 1 (mod n/g) and x0 = x·b/g
+	step := mod / uint64(g)
+	x0 := modarith.MulMod(residue(x, step), (rb/uint64(g))%step, step)
+	res := make([]*numbers.Z, g)
+	for k := range res {
+		res[k] = numbers.ZFromInt64(int64(x0 + uint64(k)*step))
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSolveCongruence(t *testing.T) {
+	for _, c := range []struct {
+		a, b, n int64
+		exp     string
+	}{
+		{3, 4, 7, "[6]"},
+		{6, 4, 10, "[4 9]"},
+		{-6, 6, 10, "[4 9]"},
+		{4, 8, 12, "[2 5 8 11]"},
+		{0, 0, 3, "[0 1 2]"},
+		{5, 3, 1, "[0]"},
+		{2, math.MaxInt64 - 1, math.MaxInt64, "[4611686018427387903]"},
+	} {
+		xs, e := SolveCongruence(z(c.a), z(c.b), z(c.n))
+		if e != nil || fmt.Sprint(xs) != c.exp {
+			t.Errorf("%d·x = %d (mod %d): expected %s, got %v, %v", c.a, c.b, c.n, c.exp, xs, e)
+		}
+	}
+}
+
+func TestSolveCongruenceByBruteForce(t *testing.T) {
+	for n := int64(1); n <= 30; n++ {
+		for a := int64(-n); a <= n; a++ {
+			for b := int64(0); b < n; b++ {
+				var exp []int64
+				for x := int64(0); x < n; x++ {
+					if ((a*x-b)%n+n)%n == 0 {
+						exp = append(exp, x)
+					}
+				}
+				xs, e := SolveCongruence(z(a), z(b), z(n))
+				if len(exp) == 0 {
+					var nse *NoSolutionError
+					if !errors.As(e, &nse) {
+						t.Errorf("%d·x = %d (mod %d): expected NoSolutionError, got %v, %v", a, b, n, xs, e)
+					}
+					continue
+				}
+				if e != nil || fmt.Sprint(xs) != fmt.Sprint(exp) {
+					t.Errorf("%d·x = %d (mod %d): expected %v, got %v, %v", a, b, n, exp, xs, e)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveCongruenceErrors(t *testing.T) {
+	_, e := SolveCongruence(z(6), z(3), z(10))
+	var nse *NoSolutionError
+	if !errors.As(e, &nse) || nse.GCD.Int64() != 2 {
+		t.Errorf("expected NoSolutionError with gcd 2, got %v", e)
+	} else if s := e.Error(); s != "6·x ≡ 3 (mod 10) has no solution: gcd(6, 10) = 2 doesn't divide 3" {
+		t.Errorf("unexpected message %q", s)
+	}
+	if _, e := SolveCongruence(z(1), z(1), z(0)); e == nil {
+		t.Errorf("expected error for modulus 0")
+	}
+	if _, e := SolveCongruence(z(0), z(0), z(MaxCongruenceSolutions+1)); e == nil {
+		t.Errorf("expected error for too many solutions")
+	}
+}
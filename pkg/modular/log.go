@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// DefaultDiscreteLogBound is the bound used by DiscreteLog - it needs a table of 2^18 baby steps
+const DefaultDiscreteLogBound = 1 << 36
+
+// ErrNoDiscreteLog is returned when base^x = target (mod m) has no solution at all
+var ErrNoDiscreteLog = errors.New("no discrete logarithm exists")
+
+// DiscreteLog returns the least x >= 0 such that base^x = target (mod modulus), searching below
+// DefaultDiscreteLogBound. base has to be invertible modulo modulus.
+func DiscreteLog(base *numbers.Z, target *numbers.Z, modulus *numbers.Z) (*numbers.N, error) {
+	return DiscreteLogBelow(base, target, modulus, numbers.NFromUint64(DefaultDiscreteLogBound))
+}
+
+// DiscreteLogBelow is DiscreteLog searching only x < bound.
+//
+// It's baby-step giant-step algorithm: for s = ⌈√bound⌉ every x is i*s + j with 0 <= i, j < s, so it's enough to
+// remember s "baby steps" base^j and check s "giant steps" target * base^(-i*s) against them. It takes O(√bound) time
+// and memory instead of O(bound). ErrNoDiscreteLog is returned only when the whole cycle of base was searched,
+// otherwise the error reports that no solution was found below the bound.
+func DiscreteLogBelow(base *numbers.Z, target *numbers.Z, modulus *numbers.Z, bound *numbers.N) (*numbers.N, error) {
+	inv, e := Inverse(base, modulus)
+	if e != nil {
+		return nil, fmt.Errorf("base %s has to be invertible modulo %s: %w", base, modulus, e)
+	}
+	m := uint64(modulus.Int64())
+	b := residue(base.Int64(), m)
+	t := residue(target.Int64(), m)
+
+	// the powers of base repeat with period (order of base) < m, so there's no need to search further
+	limit := min(bound.Uint64(), m)
+	s := uint64(math.Ceil(math.Sqrt(float64(limit))))
+	for s > 0 && (s-1)*(s-1) >= limit {
+		s--
+	}
+	for s*s < limit {
+		s++
+	}
+
+	baby := make(map[uint64]uint64, s)
+	p := 1 % m
+	for j := uint64(0); j < s; j++ {
+		if _, ok := baby[p]; !ok {
+			baby[p] = j
+		}
+		p = modarith.MulMod(p, b, m)
+	}
+	giant := modarith.PowMod(residue(inv.Int64(), m), s, m)
+	for i := uint64(0); i < s; i++ {
+		if j, ok := baby[t]; ok && i*s+j < limit {
+			return numbers.NFromUint64(i*s + j), nil
+		}
+		t = modarith.MulMod(t, giant, m)
+	}
+	if limit == m {
+		return nil, ErrNoDiscreteLog
+	}
+	return nil, fmt.Errorf("no discrete logarithm below %s", bound)
+}
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDiscreteLog(t *testing.T) {
+	for _, c := range [][4]int64{
+		{2, 1, 11, 0},
+		{2, 2, 11, 1},
+		{2, 9, 11, 6},
+		{3, 13, 17, 4},
+		{5, 1, 1, 0},
+		{2, 3, 1000000007, 0}, // checked by PowerMod
+		{-1, 6, 7, 1},
+		{4, 2, 7, 2}, // 4 has order 3 modulo 7
+		{3, 7, 10, 3},
+	} {
+		x, e := DiscreteLog(z(c[0]), z(c[1]), z(c[2]))
+		if e != nil {
+			t.Errorf("log_%d %d mod %d: unexpected error %v", c[0], c[1], c[2], e)
+			continue
+		}
+		if c[3] != 0 && x.Uint64() != uint64(c[3]) {
+			t.Errorf("log_%d %d mod %d: expected %d, got %s", c[0], c[1], c[2], c[3], x)
+		}
+		if r, _ := PowerMod(z(c[0]), z(int64(x.Uint64())), z(c[2])); r.Int64() != ((c[1]%c[2])+c[2])%c[2] {
+			t.Errorf("log_%d %d mod %d: %s is not a solution", c[0], c[1], c[2], x)
+		}
+	}
+}
+
+func TestDiscreteLogErrors(t *testing.T) {
+	// 2 has order 3 modulo 7: 1, 2, 4
+	if _, e := DiscreteLog(z(2), z(3), z(7)); !errors.Is(e, ErrNoDiscreteLog) {
+		t.Errorf("expected ErrNoDiscreteLog, got %v", e)
+	}
+	if _, e := DiscreteLog(z(2), z(1), z(4)); e == nil {
+		t.Errorf("expected error for non-invertible base")
+	}
+	if _, e := DiscreteLog(z(2), z(1), z(0)); e == nil {
+		t.Errorf("expected error for modulus 0")
+	}
+	// 2^10 = 1024 = 9 (mod 1019), but the search stops at 10
+	_, e := DiscreteLogBelow(z(2), z(5), z(1019), numbers.NFromUint64(10))
+	if e == nil || errors.Is(e, ErrNoDiscreteLog) {
+		t.Errorf("expected bound error, got %v", e)
+	}
+	if x, e := DiscreteLogBelow(z(2), z(1024%1019), z(1019), numbers.NFromUint64(11)); e != nil || x.Uint64() != 10 {
+		t.Errorf("expected 10, got %v, %v", x, e)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package modular implements arithmetic in ℤ modulo m - residues, powers and inverses, square roots and discrete
+// logarithms
+package modular
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Mod returns a mod m - the residue 0 <= r < m of a, for m > 0
+func Mod(a *numbers.Z, m *numbers.Z) (*numbers.Z, error) {
+	if m.Int64() <= 0 {
+		return nil, errors.New("modulus has to be positive")
+	}
+	return numbers.ZFromInt64(int64(residue(a.Int64(), uint64(m.Int64())))), nil
+}
+
+// PowerMod returns base^exp mod m by repeated squaring. Negative exp means the power of the inverse of base.
+func PowerMod(base *numbers.Z, exp *numbers.Z, m *numbers.Z) (*numbers.Z, error) {
+	if m.Int64() <= 0 {
+		return nil, errors.New("modulus has to be positive")
+	}
+	e := exp.Int64()
+	if e < 0 {
+		inv, err := Inverse(base, m)
+		if err != nil {
+			return nil, err
+		}
+		base = inv
+	}
+	mod := uint64(m.Int64())
+	r := modarith.PowMod(residue(base.Int64(), mod), modarith.AbsUint64(e), mod)
+	return numbers.ZFromInt64(int64(r)), nil
+}
+
+// Inverse returns x, 0 <= x < m, such that a * x = 1 (mod m). It exists only when gcd(a, m) = 1.
+func Inverse(a *numbers.Z, m *numbers.Z) (*numbers.Z, error) {
+	if m.Int64() <= 0 {
+		return nil, errors.New("modulus has to be positive")
+	}
+	mod := m.Int64()
+	g, x := extendedGCD(int64(residue(a.Int64(), uint64(mod))), mod)
+	if g != 1 {
+		return nil, fmt.Errorf("%s has no inverse modulo %s", a, m)
+	}
+	return numbers.ZFromInt64(int64(residue(x, uint64(mod)))), nil
+}
+
+// extendedGCD returns g = gcd(a, m) and x such that a * x = g (mod m), for 0 <= a < m.
+//
+// Extended Euclid's algorithm keeps r(i) = a * x(i) (mod m) for the remainders of Euclid's algorithm, so for the last
+// non-ZERO remainder r = g, x is the coefficient.
+func extendedGCD(a int64, m int64) (g int64, x int64) {
+	r0, r1 := m, a
+	var x0, x1 int64 = 0, 1
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		// |x| <= m, so it's never overflowing
+		x0, x1 = x1, x0-q*x1
+	}
+	return r0, x0
+}
+
+// residue returns a mod m in [0, m)
+func residue(a int64, m uint64) uint64 {
+	if a >= 0 {
+		return uint64(a) % m
+	}
+	r := modarith.AbsUint64(a) % m
+	if r == 0 {
+		return 0
+	}
+	return m - r
+}
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func z(v int64) *numbers.Z {
+	return numbers.ZFromInt64(v)
+}
+
+func TestMod(t *testing.T) {
+	for _, c := range [][3]int64{{7, 3, 1}, {-7, 3, 2}, {-6, 3, 0}, {0, 5, 0}, {math.MinInt64, 10, 2},
+		{math.MaxInt64, math.MaxInt64, 0}} {
+		r, e := Mod(z(c[0]), z(c[1]))
+		if e != nil || r.Int64() != c[2] {
+			t.Errorf("%d mod %d: expected %d, got %v, %v", c[0], c[1], c[2], r, e)
+		}
+	}
+	if _, e := Mod(z(1), z(0)); e == nil {
+		t.Errorf("expected error for modulus 0")
+	}
+}
+
+func TestPowerMod(t *testing.T) {
+	for _, c := range [][4]int64{{2, 10, 1000, 24}, {-2, 3, 7, 6}, {3, -1, 7, 5}, {3, -2, 7, 4}, {5, 0, 1, 0},
+		{2, 1000000006, 1000000007, 1}, {math.MaxInt64 - 1, 2, math.MaxInt64, 1}} {
+		r, e := PowerMod(z(c[0]), z(c[1]), z(c[2]))
+		if e != nil || r.Int64() != c[3] {
+			t.Errorf("%d^%d mod %d: expected %d, got %v, %v", c[0], c[1], c[2], c[3], r, e)
+		}
+	}
+	if _, e := PowerMod(z(2), z(-1), z(4)); e == nil {
+		t.Errorf("expected error for non-invertible base")
+	}
+}
+
+func TestInverse(t *testing.T) {
+	for _, c := range [][3]int64{{3, 7, 5}, {-3, 7, 2}, {1, 1, 0}, {10, 17, 12}, {2, math.MaxInt64, math.MaxInt64/2 + 1}} {
+		r, e := Inverse(z(c[0]), z(c[1]))
+		if e != nil || r.Int64() != c[2] {
+			t.Errorf("1/%d mod %d: expected %d, got %v, %v", c[0], c[1], c[2], r, e)
+		}
+	}
+	for _, c := range [][2]int64{{2, 4}, {0, 7}, {6, 9}} {
+		if _, e := Inverse(z(c[0]), z(c[1])); e == nil {
+			t.Errorf("1/%d mod %d: expected error", c[0], c[1])
+		}
+	}
+}
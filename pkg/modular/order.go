@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// OrderMod returns the multiplicative order of a modulo n - the least k > 0 with a^k = 1 (mod n). It exists only
+// when gcd(a, n) = 1.
+//
+// By Euler's theorem a^φ(n) = 1, so the order divides φ(n). It's found by removing prime factors from φ(n) as long
+// as the power stays 1.
+func OrderMod(a *numbers.Z, n *numbers.Z) (*numbers.N, error) {
+	if _, e := Inverse(a, n); e != nil {
+		return nil, fmt.Errorf("%s has no multiplicative order modulo %s: %w", a, n, e)
+	}
+	m := uint64(n.Int64())
+	b := residue(a.Int64(), m)
+	phi, _ := primes.Totient(numbers.NFromUint64(m))
+	factors, _ := primes.Factorize(phi)
+	order := phi.Uint64()
+	for _, f := range factors {
+		p := f.Prime.Uint64()
+		for order%p == 0 && modarith.PowMod(b, order/p, m) == 1%m {
+			order /= p
+		}
+	}
+	return numbers.NFromUint64(order), nil
+}
+
+// HasPrimitiveRoot checks if the multiplicative group modulo n > 0 is cyclic, which is true only for n = 1, 2, 4,
+// p^k and 2p^k (p odd prime)
+func HasPrimitiveRoot(n *numbers.Z) bool {
+	m := n.Int64()
+	switch {
+	case m <= 0:
+		return false
+	case m <= 4:
+		return true
+	case m%4 == 0:
+		return false
+	case m%2 == 0:
+		m /= 2
+	}
+	factors, _ := primes.Factorize(numbers.NFromUint64(uint64(m)))
+	return len(factors) == 1 && factors[0].Prime.Uint64() != 2
+}
+
+// PrimitiveRoot returns the smallest primitive root modulo n - g with order φ(n), so its powers generate all residues
+// coprime with n. An error is returned if there's no primitive root (see HasPrimitiveRoot).
+//
+// g is a primitive root if g^(φ(n)/q) != 1 for every prime q dividing φ(n).
+func PrimitiveRoot(n *numbers.Z) (*numbers.Z, error) {
+	if n.Int64() <= 0 {
+		return nil, errors.New("modulus has to be positive")
+	}
+	if !HasPrimitiveRoot(n) {
+		return nil, fmt.Errorf("there's no primitive root modulo %s", n)
+	}
+	m := uint64(n.Int64())
+	if m == 1 {
+		return numbers.ZFromInt64(0), nil
+	}
+	phi, _ := primes.Totient(numbers.NFromUint64(m))
+	factors, _ := primes.Factorize(phi)
+	for g := uint64(1); ; g++ {
+		if _, e := Inverse(numbers.ZFromInt64(int64(g)), n); e != nil {
+			continue
+		}
+		root := true
+		for _, f := range factors {
+			if modarith.PowMod(g, phi.Uint64()/f.Prime.Uint64(), m) == 1 {
+				root = false
+				break
+			}
+		}
+		if root {
+			return numbers.ZFromInt64(int64(g)), nil
+		}
+	}
+}
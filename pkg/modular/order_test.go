@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"testing"
+)
+
+func TestOrderMod(t *testing.T) {
+	for _, c := range [][3]int64{
+		{2, 7, 3}, {3, 7, 6}, {1, 7, 1}, {-1, 7, 2}, {10, 7, 6}, {2, 9, 6}, {7, 15, 4}, {5, 1, 1},
+		{2, 1000000007, 500000003}, {5, 1000000007, 1000000006},
+	} {
+		k, e := OrderMod(z(c[0]), z(c[1]))
+		if e != nil || k.Uint64() != uint64(c[2]) {
+			t.Errorf("ord_%d(%d): expected %d, got %v, %v", c[1], c[0], c[2], k, e)
+		}
+	}
+	for _, c := range [][2]int64{{2, 4}, {0, 7}, {6, 9}, {1, 0}} {
+		if _, e := OrderMod(z(c[0]), z(c[1])); e == nil {
+			t.Errorf("ord_%d(%d): expected error", c[1], c[0])
+		}
+	}
+}
+
+func TestPrimitiveRoot(t *testing.T) {
+	for n, exp := range map[int64]int64{
+		1: 0, 2: 1, 3: 2, 4: 3, 5: 2, 6: 5, 7: 3, 9: 2, 10: 3, 11: 2, 18: 5, 23: 5, 25: 2, 41: 6, 50: 3,
+		1000000007: 5,
+	} {
+		g, e := PrimitiveRoot(z(n))
+		if e != nil || g.Int64() != exp {
+			t.Errorf("%d: expected %d, got %v, %v", n, exp, g, e)
+		}
+	}
+	for _, n := range []int64{0, -7, 8, 12, 15, 21, 100} {
+		if HasPrimitiveRoot(z(n)) {
+			t.Errorf("%d: expected no primitive root", n)
+		}
+		if _, e := PrimitiveRoot(z(n)); e == nil {
+			t.Errorf("%d: expected error", n)
+		}
+	}
+
+	// the powers of primitive root are all residues coprime with n
+	g, _ := PrimitiveRoot(z(54))
+	seen := map[int64]bool{}
+	p := int64(1)
+	for range 18 {
+		seen[p] = true
+		p = p * g.Int64() % 54
+	}
+	if len(seen) != 18 {
+		t.Errorf("%s is not a primitive root modulo 54", g)
+	}
+}
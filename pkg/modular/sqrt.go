@@ -0,0 +1,95 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// NonResidueError is returned when a is not a square modulo p - x^2 = a (mod p) has no solution
+type NonResidueError struct {
+	A *numbers.Z
+	P *numbers.Z
+}
+
+func (e *NonResidueError) Error() string {
+	return fmt.Sprintf("%s is not a quadratic residue modulo %s", e.A, e.P)
+}
+
+// SqrtMod returns both solutions r1 <= r2 of x^2 = a (mod p) for prime p (r1 = r2 only for a = 0 or p = 2) or
+// *NonResidueError if there are none.
+//
+// It's Tonelli–Shanks algorithm: for p - 1 = Q * 2^S (Q odd), R = a^((Q+1)/2) is the root "up to" t = a^Q, which is
+// in the subgroup of order 2^S. Each step fixes one factor of 2 of t's order using powers of c = z^Q for non-residue
+// z, until t = 1 and R^2 = a.
+func SqrtMod(a *numbers.Z, p *numbers.Z) (*numbers.Z, *numbers.Z, error) {
+	if p.Int64() <= 0 || !primes.IsPrime(numbers.NFromUint64(uint64(p.Int64()))) {
+		return nil, nil, errors.New("modulus has to be prime")
+	}
+	mod := uint64(p.Int64())
+	n := residue(a.Int64(), mod)
+	if n == 0 || mod == 2 {
+		r := numbers.ZFromInt64(int64(n))
+		return r, r, nil
+	}
+	// Euler's criterion: a^((p-1)/2) is 1 for residues and -1 for non-residues
+	if modarith.PowMod(n, (mod-1)/2, mod) != 1 {
+		return nil, nil, &NonResidueError{A: a, P: p}
+	}
+
+	var r uint64
+	if mod%4 == 3 {
+		// (a^((p+1)/4))^2 = a^((p+1)/2) = a * a^((p-1)/2) = a
+		r = modarith.PowMod(n, (mod+1)/4, mod)
+	} else {
+		q, s := mod-1, 0
+		for q%2 == 0 {
+			q, s = q/2, s+1
+		}
+		z := uint64(2)
+		for modarith.PowMod(z, (mod-1)/2, mod) != mod-1 {
+			z++
+		}
+		c := modarith.PowMod(z, q, mod)
+		t := modarith.PowMod(n, q, mod)
+		r = modarith.PowMod(n, (q+1)/2, mod)
+		for m := s; t != 1; {
+			// the least i with t^(2^i) = 1
+			i := 0
+			for t2 := t; t2 != 1; i++ {
+				t2 = modarith.MulMod(t2, t2, mod)
+			}
+			b := c
+			for range m - i - 1 {
+				b = modarith.MulMod(b, b, mod)
+			}
+			m = i
+			c = modarith.MulMod(b, b, mod)
+			t = modarith.MulMod(t, c, mod)
+			r = modarith.MulMod(r, b, mod)
+		}
+	}
+	r1, r2 := min(r, mod-r), max(r, mod-r)
+	return numbers.ZFromInt64(int64(r1)), numbers.ZFromInt64(int64(r2)), nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package modular
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSqrtMod(t *testing.T) {
+	for _, c := range [][4]int64{
+		{10, 13, 6, 7},
+		{4, 7, 2, 5},
+		{2, 7, 3, 4},
+		{-1, 5, 2, 3},
+		{0, 11, 0, 0},
+		{1, 2, 1, 1},
+		{5, 41, 13, 28},           // p = 1 (mod 8), S = 3
+		{2, 113, 51, 62},          // p - 1 = 7 * 2^4
+		{1030, 10009, 1632, 8377}, // p = 1 (mod 8)
+		{2, 1000000007, 0, 0},     // only checked as roots
+	} {
+		r1, r2, e := SqrtMod(z(c[0]), z(c[1]))
+		if e != nil {
+			t.Errorf("√%d mod %d: unexpected error %v", c[0], c[1], e)
+			continue
+		}
+		p := c[1]
+		a := ((c[0] % p) + p) % p
+		for _, r := range []int64{r1.Int64(), r2.Int64()} {
+			if sq, _ := PowerMod(z(r), z(2), z(p)); sq.Int64() != a {
+				t.Errorf("√%d mod %d: %d is not a root", c[0], p, r)
+			}
+		}
+		if c[2] != 0 && (r1.Int64() != c[2] || r2.Int64() != c[3]) {
+			t.Errorf("√%d mod %d: expected %d, %d, got %s, %s", c[0], p, c[2], c[3], r1, r2)
+		}
+	}
+
+	// all residues modulo 17 (p - 1 = 2^4)
+	for a := int64(1); a < 17; a++ {
+		r1, r2, e := SqrtMod(z(a), z(17))
+		var nr *NonResidueError
+		if e != nil {
+			if !errors.As(e, &nr) {
+				t.Errorf("√%d mod 17: unexpected error %v", a, e)
+			}
+			continue
+		}
+		if r1.Int64()*r1.Int64()%17 != a || r2.Int64()*r2.Int64()%17 != a || r1.Int64()+r2.Int64() != 17 {
+			t.Errorf("√%d mod 17: wrong roots %s, %s", a, r1, r2)
+		}
+	}
+}
+
+func TestSqrtModErrors(t *testing.T) {
+	_, _, e := SqrtMod(z(3), z(7))
+	var nr *NonResidueError
+	if !errors.As(e, &nr) || nr.A.Int64() != 3 || nr.P.Int64() != 7 {
+		t.Errorf("√3 mod 7: expected non-residue error, got %v", e)
+	}
+	for _, p := range []int64{0, -7, 1, 15} {
+		if _, _, e := SqrtMod(z(4), z(p)); e == nil {
+			t.Errorf("modulus %d: expected error", p)
+		}
+	}
+}
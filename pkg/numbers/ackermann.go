@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultAckermannLimit is the number of steps Ackermann can take - enough for A(4, 1) = 65533
+const DefaultAckermannLimit = 10_000_000
+
+// MaxAckermannEntries limits the memory of AckermannWithLimit - the number of remembered values together with the
+// arguments waiting on the stack (A(4, 1) needs about 180000 of them)
+const MaxAckermannEntries = 1 << 20
+
+// LimitError is returned when a computation needs more steps than allowed
+type LimitError struct {
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("limit of %d steps exceeded", e.Limit)
+}
+
+// Ackermann returns Ackermann function A(m, n), taking at most DefaultAckermannLimit steps
+func Ackermann(m *N, n *N) (*N, error) {
+	return AckermannWithLimit(m, n, DefaultAckermannLimit)
+}
+
+// AckermannWithLimit returns Ackermann function
+//
+//	A(0, n) = n + 1
+//	A(m, 0) = A(m - 1, 1)
+//	A(m, n) = A(m - 1, A(m, n - 1))
+//
+// It's computable (the recursion always ends), but not primitive recursive - it grows faster than any function
+// defined only with loops of known length: A(1, n) = n + 2, A(2, n) = 2n + 3, A(3, n) = 2^(n+3) - 3 and
+// A(4, 2) = 2^65536 - 3 already has 19729 digits.
+//
+// Recursion is replaced with explicit stack and each computed value is remembered, so A(m, n) is computed only once.
+// Each look at the stack is a step and *LimitError is returned when there are more than limit steps. The memory is
+// bounded too - an error is returned before the stack and the remembered values grow over MaxAckermannEntries.
+// ErrOverflow is returned when the result doesn't fit uint64.
+func AckermannWithLimit(m *N, n *N, limit int) (*N, error) {
+	type args struct{ m, n uint64 }
+	memo := make(map[args]uint64)
+	stack := []args{{m.value, n.value}}
+	for steps := 0; len(stack) > 0; steps++ {
+		if steps >= limit {
+			return nil, &LimitError{Limit: limit}
+		}
+		// each step adds at most one entry
+		if len(stack)+len(memo) >= MaxAckermannEntries {
+			return nil, fmt.Errorf("A(%s, %s) needs more than %d remembered values", m, n, MaxAckermannEntries)
+		}
+		top := stack[len(stack)-1]
+		if _, ok := memo[top]; ok {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		var next args
+		switch {
+		case top.m == 0:
+			if top.n == math.MaxUint64 {
+				return nil, ErrOverflow
+			}
+			memo[top] = top.n + 1
+			continue
+		case top.n == 0:
+			next = args{top.m - 1, 1}
+		default:
+			inner, ok := memo[args{top.m, top.n - 1}]
+			if !ok {
+				stack = append(stack, args{top.m, top.n - 1})
+				continue
+			}
+			next = args{top.m - 1, inner}
+		}
+		if v, ok := memo[next]; ok {
+			memo[top] = v
+		} else {
+			stack = append(stack, next)
+		}
+	}
+	return NFromUint64(memo[args{m.value, n.value}]), nil
+}
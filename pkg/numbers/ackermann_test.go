@@ -0,0 +1,54 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAckermann(t *testing.T) {
+	for _, c := range [][3]uint64{
+		{0, 0, 1}, {0, 5, 6}, {1, 0, 2}, {1, 5, 7}, {2, 0, 3}, {2, 5, 13}, {3, 0, 5}, {3, 3, 61}, {3, 10, 8189},
+		{4, 0, 13}, {4, 1, 65533},
+	} {
+		a, e := Ackermann(NFromUint64(c[0]), NFromUint64(c[1]))
+		if e != nil || a.value != c[2] {
+			t.Errorf("A(%d, %d): expected %d, got %v, %v", c[0], c[1], c[2], a, e)
+		}
+	}
+}
+
+func TestAckermannLimit(t *testing.T) {
+	_, e := AckermannWithLimit(NFromUint64(4), NFromUint64(2), 1000000)
+	var le *LimitError
+	if !errors.As(e, &le) || le.Limit != 1000000 {
+		t.Errorf("A(4, 2): expected limit error, got %v", e)
+	}
+	if _, e = AckermannWithLimit(NFromUint64(3), NFromUint64(3), 10); !errors.As(e, &le) || le.Limit != 10 {
+		t.Errorf("A(3, 3): expected limit error, got %v", e)
+	}
+	// the memory is bounded also when the step limit is high
+	if _, e = Ackermann(NFromUint64(4), NFromUint64(2)); e == nil || errors.As(e, &le) {
+		t.Errorf("A(4, 2): expected error for too many values, got %v", e)
+	}
+	if _, e = Ackermann(NFromUint64(0), NFromUint64(18446744073709551615)); e != ErrOverflow {
+		t.Errorf("A(0, 2^64 - 1): expected overflow, got %v", e)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math/big"
+)
+
+// SumN returns the sum of all elements (ZERO for empty slice) or ErrOverflow
+func SumN(s []*N) (*N, error) {
+	var sum uint64
+	var e error
+	for _, n := range s {
+		if sum, e = addUint64(sum, n.value); e != nil {
+			return nil, e
+		}
+	}
+	return &N{value: sum}, nil
+}
+
+// ProductN returns the product of all elements (ONE for empty slice) or ErrOverflow
+func ProductN(s []*N) (*N, error) {
+	var product uint64 = 1
+	var e error
+	for _, n := range s {
+		if product, e = mulUint64(product, n.value); e != nil {
+			return nil, e
+		}
+	}
+	return &N{value: product}, nil
+}
+
+// SumZ returns the sum of all elements (0 for empty slice) or ErrOverflow
+func SumZ(s []*Z) (*Z, error) {
+	var sum int64
+	var e error
+	for _, z := range s {
+		if sum, e = addInt64(sum, z.value); e != nil {
+			return nil, e
+		}
+	}
+	return &Z{value: sum}, nil
+}
+
+// ProductZ returns the product of all elements (1 for empty slice) or ErrOverflow
+func ProductZ(s []*Z) (*Z, error) {
+	var product int64 = 1
+	var e error
+	for _, z := range s {
+		if product, e = mulInt64(product, z.value); e != nil {
+			return nil, e
+		}
+	}
+	return &Z{value: product}, nil
+}
+
+// SumQ returns the sum of all elements (0/1 for empty slice) or ErrOverflow
+//
+// Instead of adding fractions pair by pair (and reducing every partial sum), all of them are brought to the common
+// denominator L = lcm(B1, B2, ...) and A1/B1 + A2/B2 + ... = (A1 * L/B1 + A2 * L/B2 + ...) / L is reduced once.
+// If L or the sum of nominators doesn't fit 64 bits, the same is done with math/big - the result may still fit after
+// reducing, like H(n) - H(n-1) = 1/n.
+func SumQ(s []*Q) (*Q, error) {
+	var l int64 = 1
+	var e error
+	for _, q := range s {
+		if l, e = lcmInt64(l, q.b); e != nil {
+			return sumQBig(s)
+		}
+	}
+	var sum int64
+	for _, q := range s {
+		term, e := mulInt64(q.a, l/q.b)
+		if e != nil {
+			return sumQBig(s)
+		}
+		if sum, e = addInt64(sum, term); e != nil {
+			return sumQBig(s)
+		}
+	}
+	return reduceQ(sum, l)
+}
+
+// SumAll returns q + s[0] + s[1] + ... or ErrOverflow. The terms are added over their common denominator, just as
+// in SumQ, so it's much faster than repeated Add for many terms.
+func (q *Q) SumAll(s []*Q) (*Q, error) {
+	return SumQ(append([]*Q{q}, s...))
+}
+
+// sumQBig is SumQ with the common denominator and the sum of nominators kept in math/big
+func sumQBig(s []*Q) (*Q, error) {
+	l, g, b := big.NewInt(1), new(big.Int), new(big.Int)
+	for _, q := range s {
+		b.SetInt64(q.b)
+		g.GCD(nil, nil, l, b)
+		l.Mul(l, b.Quo(b, g))
+	}
+	sum, term := new(big.Int), new(big.Int)
+	for _, q := range s {
+		term.Quo(l, b.SetInt64(q.b))
+		sum.Add(sum, term.Mul(term, b.SetInt64(q.a)))
+	}
+	return QFromRat(new(big.Rat).SetFrac(sum, l))
+}
+
+// ProductQ returns the product of all elements (1/1 for empty slice) or ErrOverflow
+//
+// Every multiplication cancels common factors crosswise first, so the partial products stay in lowest terms.
+func ProductQ(s []*Q) (*Q, error) {
+	product := OneQ()
+	var e error
+	for _, q := range s {
+		if product, e = product.multiply(q); e != nil {
+			return nil, e
+		}
+	}
+	return product, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumN(t *testing.T) {
+	if s, e := SumN([]*N{NewN("1"), NewN("2"), NewN("39")}); e != nil || s.value != 42 {
+		t.Errorf("1+2+39: expected 42, got %v (%v)", s, e)
+	}
+	if s, e := SumN(nil); e != nil || s.value != 0 {
+		t.Errorf("empty sum: expected 0, got %v (%v)", s, e)
+	}
+	if _, e := SumN([]*N{NFromUint64(math.MaxUint64), OneN()}); e != ErrOverflow {
+		t.Errorf("MaxUint64+1: expected overflow, got %v", e)
+	}
+}
+
+func TestProductN(t *testing.T) {
+	if p, e := ProductN([]*N{NewN("2"), NewN("3"), NewN("7")}); e != nil || p.value != 42 {
+		t.Errorf("2*3*7: expected 42, got %v (%v)", p, e)
+	}
+	if p, e := ProductN(nil); e != nil || p.value != 1 {
+		t.Errorf("empty product: expected 1, got %v (%v)", p, e)
+	}
+	if _, e := ProductN([]*N{NFromUint64(1 << 32), NFromUint64(1 << 32)}); e != ErrOverflow {
+		t.Errorf("2^32*2^32: expected overflow, got %v", e)
+	}
+}
+
+func TestSumZ(t *testing.T) {
+	if s, e := SumZ([]*Z{NewZ("-10"), NewZ("3"), NewZ("-35")}); e != nil || s.value != -42 {
+		t.Errorf("-10+3-35: expected -42, got %v (%v)", s, e)
+	}
+	if _, e := SumZ([]*Z{ZFromInt64(math.MinInt64), NewZ("-1")}); e != ErrOverflow {
+		t.Errorf("MinInt64-1: expected overflow, got %v", e)
+	}
+}
+
+func TestProductZ(t *testing.T) {
+	if p, e := ProductZ([]*Z{NewZ("-2"), NewZ("3"), NewZ("-7")}); e != nil || p.value != 42 {
+		t.Errorf("-2*3*-7: expected 42, got %v (%v)", p, e)
+	}
+}
+
+func TestSumQ(t *testing.T) {
+	// harmonic number H10 = 7381/2520
+	h := make([]*Q, 0)
+	for i := int64(1); i <= 10; i++ {
+		h = append(h, &Q{a: 1, b: i})
+	}
+	s, e := SumQ(h)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "H10", s, 7381, 2520)
+	s, e = SumQ([]*Q{NewQ("1/2"), NewQ("1/-3"), NewQ("-1/6")})
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "1/2 - 1/3 - 1/6", s, 0, 1)
+	s, e = SumQ(nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "empty sum", s, 0, 1)
+	// common denominator of these doesn't fit 64 bits, but pairwise sums cancel
+	big1, big2 := int64(math.MaxInt64/3), int64(math.MaxInt64/5)
+	s, e = SumQ([]*Q{{a: 1, b: big1}, {a: -1, b: big1}, {a: 1, b: big2}})
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "pairwise fallback", s, 1, big2)
+}
+
+func TestProductQ(t *testing.T) {
+	p, e := ProductQ([]*Q{NewQ("2/3"), NewQ("3/4"), NewQ("-4/5")})
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "2/3 * 3/4 * -4/5", p, -2, 5)
+}
+
+func TestSumAll(t *testing.T) {
+	// H(100) - H(99) = 1/100, lcm(1..100) doesn't fit 64 bits
+	terms := make([]*Q, 0)
+	for i := int64(1); i <= 100; i++ {
+		terms = append(terms, &Q{a: 1, b: i})
+	}
+	for i := int64(1); i <= 99; i++ {
+		terms = append(terms, &Q{a: -1, b: i})
+	}
+	s, e := NewQ("1/2").SumAll(terms)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "1/2 + H(100) - H(99)", s, 51, 100)
+	if _, e := OneQ().SumAll(terms[:100]); e != ErrOverflow {
+		t.Errorf("1 + H(100) should overflow, got %v", e)
+	}
+	s, e = OneQ().SumAll(nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "1 + nothing", s, 1, 1)
+}
+
+func BenchmarkSumQ(b *testing.B) {
+	// terms with denominators dividing 720720 = lcm(1..16)
+	terms := make([]*Q, 0)
+	for i := range int64(10000) {
+		q, _ := QFromInts(i%7-3, 1+i%16)
+		terms = append(terms, q)
+	}
+	b.Run("SumQ", func(b *testing.B) {
+		for range b.N {
+			_, _ = SumQ(terms)
+		}
+	})
+	b.Run("pairwise", func(b *testing.B) {
+		for range b.N {
+			sum := ZeroQ()
+			for _, q := range terms {
+				sum = sum.Add(q)
+			}
+		}
+	})
+}
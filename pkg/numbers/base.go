@@ -0,0 +1,38 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"strconv"
+)
+
+// Text returns n in given base (2 <= base <= 36), using lower-case letters for digits >= 10 and no prefix
+func (n *N) Text(base int) string {
+	return strconv.FormatUint(n.value, base)
+}
+
+// Text returns z in given base (2 <= base <= 36), using lower-case letters for digits >= 10 and no prefix
+func (z *Z) Text(base int) string {
+	return strconv.FormatInt(z.value, base)
+}
+
+// Text returns q in given base (2 <= base <= 36) as "A/B" - both nominator and denominator are written in this base
+func (q *Q) Text(base int) string {
+	return strconv.FormatInt(q.a, base) + "/" + strconv.FormatInt(q.b, base)
+}
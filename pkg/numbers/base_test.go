@@ -0,0 +1,41 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	if s := NewN("255").Text(16); s != "ff" {
+		t.Errorf("expected ff, got %s", s)
+	}
+	if s := NewN("10").Text(2); s != "1010" {
+		t.Errorf("expected 1010, got %s", s)
+	}
+	if s := NewZ("-8").Text(8); s != "-10" {
+		t.Errorf("expected -10, got %s", s)
+	}
+	if s := NewQ("-10/35").Text(2); s != "-10/111" {
+		t.Errorf("expected -10/111, got %s", s)
+	}
+	if s := NewN("35").Text(36); s != "z" {
+		t.Errorf("expected z, got %s", s)
+	}
+}
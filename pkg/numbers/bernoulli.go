@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math"
+)
+
+// BernoulliNumbers returns n+1 Bernoulli numbers B0, B1, ..., Bn - https://en.wikipedia.org/wiki/Bernoulli_number
+//
+// They are generated from the recurrence which uses only operations in ℚ:
+//   - B0 = 1
+//   - C(m+1, 0)*B0 + C(m+1, 1)*B1 + ... + C(m+1, m-1)*Bm-1 = -(m+1) * Bm
+//
+// With this recurrence B1 = -1/2 (the "first" Bernoulli numbers).
+//
+// Numerators and denominators are kept in 64 bits, so ErrOverflow is returned when they no longer fit - the first n for
+// which it happens is 36.
+func BernoulliNumbers(n *N) ([]*Q, error) {
+	res := make([]*Q, 0, 16)
+	res = append(res, OneQ())
+	for m := uint64(1); m <= n.value; m++ {
+		if m >= math.MaxInt64 {
+			return nil, ErrOverflow
+		}
+		sum := ZeroQ()
+		var binomial int64 = 1 // C(m+1, 0)
+		for k := uint64(0); k < m; k++ {
+			term, e := res[k].multiply(&Q{a: binomial, b: 1})
+			if e != nil {
+				return nil, e
+			}
+			if sum, e = sum.add(term); e != nil {
+				return nil, e
+			}
+			// C(m+1, k+1) = C(m+1, k) * (m+1-k) / (k+1), dividing first to stay within 64 bits
+			g := gcdInt64(binomial, int64(k)+1)
+			if binomial, e = mulInt64(binomial/g, int64(m-k+1)/((int64(k)+1)/g)); e != nil {
+				return nil, e
+			}
+		}
+		bm, e := sum.divide(&Q{a: -int64(m) - 1, b: 1})
+		if e != nil {
+			return nil, e
+		}
+		res = append(res, bm)
+	}
+	return res, nil
+}
+
+// Bernoulli returns single Bernoulli number Bn, see BernoulliNumbers
+func Bernoulli(n *N) (*Q, error) {
+	res, e := BernoulliNumbers(n)
+	if e != nil {
+		return nil, e
+	}
+	return res[len(res)-1], nil
+}
+
+// SumOfPowers calculates 1^p + 2^p + ... + n^p using Faulhaber's formula:
+// 1/(p+1) * sum(j=0..p) C(p+1, j) * Bj * n^(p+1-j), where B1 = +1/2
+//
+// It takes p+1 operations in ℚ regardless of n. ErrOverflow is returned when any intermediate value doesn't fit
+// 64 bits.
+func SumOfPowers(n *N, p *N) (*N, error) {
+	if n.value > math.MaxInt64 {
+		return nil, ErrOverflow
+	}
+	b, e := BernoulliNumbers(p)
+	if e != nil {
+		return nil, e
+	}
+	if len(b) > 1 {
+		b[1] = &Q{a: 1, b: 2}
+	}
+	sum := ZeroQ()
+	binomial := OneQ() // C(p+1, 0)
+	for j := uint64(0); j <= p.value; j++ {
+		var power int64 = 1
+		for i := uint64(0); i < p.value+1-j; i++ {
+			if power, e = mulInt64(power, int64(n.value)); e != nil {
+				return nil, e
+			}
+		}
+		term, e := binomial.multiply(b[j])
+		if e != nil {
+			return nil, e
+		}
+		if term, e = term.multiply(&Q{a: power, b: 1}); e != nil {
+			return nil, e
+		}
+		if sum, e = sum.add(term); e != nil {
+			return nil, e
+		}
+		// C(p+1, j+1) = C(p+1, j) * (p+1-j) / (j+1)
+		if binomial, e = binomial.multiply(mustQ(reduceQ(int64(p.value+1-j), int64(j)+1))); e != nil {
+			return nil, e
+		}
+	}
+	res, e := sum.divide(&Q{a: int64(p.value) + 1, b: 1})
+	if e != nil {
+		return nil, e
+	}
+	if res.b != 1 || res.a < 0 {
+		return nil, errors.New("sum of powers is not a natural number")
+	}
+	return &N{value: uint64(res.a)}, nil
+}
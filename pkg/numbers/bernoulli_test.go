@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func TestBernoulliNumbers(t *testing.T) {
+	expected := []string{"1/1", "-1/2", "1/6", "0/1", "-1/30", "0/1", "1/42", "0/1", "-1/30", "0/1", "5/66"}
+	res, e := BernoulliNumbers(NewN("10"))
+	if e != nil {
+		t.Fatalf("B0..B10: %s", e)
+	}
+	if len(res) != 11 {
+		t.Fatalf("B0..B10: expected 11 numbers, got %d", len(res))
+	}
+	for i, b := range res {
+		if b.String() != expected[i] {
+			t.Errorf("B%d: expected %s, got %s", i, expected[i], b)
+		}
+	}
+}
+
+func TestBernoulli(t *testing.T) {
+	b, e := Bernoulli(NewN("20"))
+	if e != nil || b.a != -174611 || b.b != 330 {
+		t.Errorf("B20: expected -174611/330, got %v (%v)", b, e)
+	}
+	b, e = Bernoulli(NewN("34"))
+	if e != nil || b.a != 2577687858367 || b.b != 6 {
+		t.Errorf("B34: expected 2577687858367/6, got %v (%v)", b, e)
+	}
+	if _, e = Bernoulli(NewN("36")); e != ErrOverflow {
+		t.Errorf("B36: expected overflow, got %v", e)
+	}
+}
+
+func TestSumOfPowers(t *testing.T) {
+	sums := []struct {
+		n, p     string
+		expected uint64
+	}{
+		{"10", "0", 10},
+		{"10", "1", 55},
+		{"100", "1", 5050},
+		{"10", "2", 385},
+		{"20", "2", 2870},
+		{"20", "3", 44100},
+		{"0", "5", 0},
+		{"1000", "4", 200500333333300},
+	}
+	for _, s := range sums {
+		res, e := SumOfPowers(NewN(s.n), NewN(s.p))
+		if e != nil || res.value != s.expected {
+			t.Errorf("sum(k^%s, k=1..%s): expected %d, got %v (%v)", s.p, s.n, s.expected, res, e)
+		}
+	}
+	if _, e := SumOfPowers(NewN("1000000"), NewN("5")); e != ErrOverflow {
+		t.Errorf("sum(k^5, k=1..1000000): expected overflow, got %v", e)
+	}
+}
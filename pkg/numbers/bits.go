@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// PopCount returns the number of bits set to 1 in binary representation of n: 3 for 0b1011
+func (n *N) PopCount() int {
+	return bits.OnesCount64(n.value)
+}
+
+// Bit returns i-th bit (0 or 1) of n, where 0-th bit is the least significant one. Bits above 63 are 0.
+func (n *N) Bit(i int) uint {
+	if i < 0 {
+		panic(errors.New("bit index can't be negative"))
+	}
+	if i >= 64 {
+		return 0
+	}
+	return uint(n.value>>i) & 1
+}
+
+// SetBit returns n with i-th bit set to b (0 or 1). It panics with ErrOverflow when setting a bit above 63.
+func (n *N) SetBit(i int, b uint) *N {
+	if i < 0 {
+		panic(errors.New("bit index can't be negative"))
+	}
+	if b > 1 {
+		panic(fmt.Errorf("bit can be 0 or 1, not %d", b))
+	}
+	if i >= 64 {
+		if b == 1 {
+			panic(ErrOverflow)
+		}
+		return n
+	}
+	if b == 1 {
+		return NFromUint64(n.value | 1<<i)
+	}
+	return NFromUint64(n.value &^ (1 << i))
+}
+
+// RotateLeft returns n treated as width-bit number (1 <= width <= 64) with its bits rotated left by k positions -
+// the bits shifted out on the left come back on the right: 0b0011 rotated by 3 in 4 bits is 0b1001. Negative k
+// rotates right. An error is returned if n doesn't fit width bits.
+func (n *N) RotateLeft(k int, width int) (*N, error) {
+	if width < 1 || width > 64 {
+		return nil, fmt.Errorf("width has to be between 1 and 64, not %d", width)
+	}
+	if width < 64 && n.value>>width != 0 {
+		return nil, fmt.Errorf("%d doesn't fit %d bits", n.value, width)
+	}
+	if width == 64 {
+		return NFromUint64(bits.RotateLeft64(n.value, k)), nil
+	}
+	k %= width
+	if k < 0 {
+		k += width
+	}
+	mask := uint64(1)<<width - 1
+	return NFromUint64((n.value<<k | n.value>>(width-k)) & mask), nil
+}
+
+// RotateRight returns n treated as width-bit number with its bits rotated right by k positions (see RotateLeft)
+func (n *N) RotateRight(k int, width int) (*N, error) {
+	return n.RotateLeft(-k, width)
+}
@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPopCount(t *testing.T) {
+	for v, exp := range map[uint64]int{0: 0, 1: 1, 0b1011: 3, 255: 8, math.MaxUint64: 64} {
+		if c := NFromUint64(v).PopCount(); c != exp {
+			t.Errorf("%d: expected %d, got %d", v, exp, c)
+		}
+	}
+}
+
+func TestBit(t *testing.T) {
+	n := NFromUint64(0b1010)
+	for i, exp := range []uint{0, 1, 0, 1, 0} {
+		if b := n.Bit(i); b != exp {
+			t.Errorf("bit %d: expected %d, got %d", i, exp, b)
+		}
+	}
+	if NFromUint64(math.MaxUint64).Bit(63) != 1 || NFromUint64(math.MaxUint64).Bit(64) != 0 {
+		t.Errorf("wrong high bits")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic for negative index")
+		}
+	}()
+	n.Bit(-1)
+}
+
+func TestSetBit(t *testing.T) {
+	for _, c := range []struct {
+		v   uint64
+		i   int
+		b   uint
+		exp uint64
+	}{
+		{0, 0, 1, 1},
+		{0b1010, 0, 1, 0b1011},
+		{0b1010, 1, 0, 0b1000},
+		{0b1010, 1, 1, 0b1010},
+		{0, 63, 1, 1 << 63},
+		{5, 100, 0, 5},
+	} {
+		if r := NFromUint64(c.v).SetBit(c.i, c.b); r.value != c.exp {
+			t.Errorf("%d with bit %d = %d: expected %d, got %d", c.v, c.i, c.b, c.exp, r.value)
+		}
+	}
+	defer func() {
+		if r := recover(); r != ErrOverflow {
+			t.Errorf("expected overflow, got %v", r)
+		}
+	}()
+	NFromUint64(1).SetBit(64, 1)
+}
+
+func TestRotate(t *testing.T) {
+	for _, c := range []struct {
+		v        uint64
+		k, width int
+		exp      uint64
+	}{
+		{0b0011, 3, 4, 0b1001},
+		{0b0011, 1, 4, 0b0110},
+		{0b0011, -1, 4, 0b1001},
+		{0b0011, 9, 4, 0b0110},
+		{0b1, 0, 1, 0b1},
+		{1, 1, 64, 2},
+		{1 << 63, 1, 64, 1},
+		{0x12, 4, 8, 0x21},
+	} {
+		r, e := NFromUint64(c.v).RotateLeft(c.k, c.width)
+		if e != nil || r.value != c.exp {
+			t.Errorf("%b <<< %d (%d bits): expected %b, got %v, %v", c.v, c.k, c.width, c.exp, r, e)
+		}
+		back, e := r.RotateRight(c.k, c.width)
+		if e != nil || back.value != c.v {
+			t.Errorf("%b >>> %d (%d bits): expected %b, got %v, %v", c.exp, c.k, c.width, c.v, back, e)
+		}
+	}
+	for _, c := range [][3]int{{16, 1, 4}, {1, 1, 0}, {1, 1, 65}} {
+		if _, e := NFromUint64(uint64(c[0])).RotateLeft(c[1], c[2]); e == nil {
+			t.Errorf("%d in %d bits: expected error", c[0], c[2])
+		}
+	}
+}
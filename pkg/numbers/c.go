@@ -0,0 +1,239 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// Complex numbers ℂ with rational parts - a + bi for a, b in ℚ (Gaussian rationals ℚ(i)). It's closed under
+// addition, subtraction, multiplication and division, so all of them are exact. Values of C are immutable.
+type C struct {
+	re *Q
+	im *Q
+
+	fmt.Stringer
+}
+
+// Quadrant classifies the argument of complex number - the angle from positive real axis
+type Quadrant int
+
+const (
+	// Origin is ZERO, which has no argument
+	Origin Quadrant = iota
+	// PositiveReal is the argument 0
+	PositiveReal
+	// FirstQuadrant is the argument between 0 and π/2
+	FirstQuadrant
+	// PositiveImaginary is the argument π/2
+	PositiveImaginary
+	// SecondQuadrant is the argument between π/2 and π
+	SecondQuadrant
+	// NegativeReal is the argument π
+	NegativeReal
+	// ThirdQuadrant is the argument between π and 3π/2
+	ThirdQuadrant
+	// NegativeImaginary is the argument 3π/2
+	NegativeImaginary
+	// FourthQuadrant is the argument between 3π/2 and 2π
+	FourthQuadrant
+)
+
+var quadrantNames = []string{"Origin", "PositiveReal", "FirstQuadrant", "PositiveImaginary", "SecondQuadrant",
+	"NegativeReal", "ThirdQuadrant", "NegativeImaginary", "FourthQuadrant"}
+
+func (q Quadrant) String() string {
+	if q < 0 || int(q) >= len(quadrantNames) {
+		return "Quadrant(?)"
+	}
+	return quadrantNames[q]
+}
+
+// NewC creates re + im·i
+func NewC(re *Q, im *Q) *C {
+	return &C{re: re, im: im}
+}
+
+// Re returns the real part of c
+func (c *C) Re() *Q {
+	return c.re
+}
+
+// Im returns the imaginary part of c
+func (c *C) Im() *Q {
+	return c.im
+}
+
+// Add returns (a + bi) + (c + di) = (a + c) + (b + d)i or ErrOverflow
+func (c *C) Add(arg *C) (*C, error) {
+	re, e := c.re.AddChecked(arg.re)
+	if e != nil {
+		return nil, e
+	}
+	im, e := c.im.AddChecked(arg.im)
+	if e != nil {
+		return nil, e
+	}
+	return &C{re: re, im: im}, nil
+}
+
+// Subtract returns (a + bi) - (c + di) = (a - c) + (b - d)i or ErrOverflow
+func (c *C) Subtract(arg *C) (*C, error) {
+	re, e := c.re.SubtractChecked(arg.re)
+	if e != nil {
+		return nil, e
+	}
+	im, e := c.im.SubtractChecked(arg.im)
+	if e != nil {
+		return nil, e
+	}
+	return &C{re: re, im: im}, nil
+}
+
+// Multiply returns (a + bi)(c + di) = (ac - bd) + (ad + bc)i (i^2 = -1) or ErrOverflow
+func (c *C) Multiply(arg *C) (*C, error) {
+	re, e := difference(c.re, arg.re, c.im, arg.im)
+	if e != nil {
+		return nil, e
+	}
+	ad, e := c.re.MultiplyChecked(arg.im)
+	if e != nil {
+		return nil, e
+	}
+	bc, e := c.im.MultiplyChecked(arg.re)
+	if e != nil {
+		return nil, e
+	}
+	im, e := ad.AddChecked(bc)
+	if e != nil {
+		return nil, e
+	}
+	return &C{re: re, im: im}, nil
+}
+
+// Divide returns (a + bi) / (c + di) = (a + bi)(c - di) / (c^2 + d^2), an error for ZERO or ErrOverflow
+func (c *C) Divide(arg *C) (*C, error) {
+	m, e := arg.ModulusSquared()
+	if e != nil {
+		return nil, e
+	}
+	if m.a == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	conj, e := arg.Conjugate()
+	if e != nil {
+		return nil, e
+	}
+	p, e := c.Multiply(conj)
+	if e != nil {
+		return nil, e
+	}
+	re, e := p.re.Divide(m)
+	if e != nil {
+		return nil, e
+	}
+	im, e := p.im.Divide(m)
+	if e != nil {
+		return nil, e
+	}
+	return &C{re: re, im: im}, nil
+}
+
+// Conjugate returns a - bi or ErrOverflow
+func (c *C) Conjugate() (*C, error) {
+	im, e := ZeroQ().SubtractChecked(c.im)
+	if e != nil {
+		return nil, e
+	}
+	return &C{re: c.re, im: im}, nil
+}
+
+// ModulusSquared returns |a + bi|^2 = a^2 + b^2 - always in ℚ, unlike the modulus, which may be irrational
+func (c *C) ModulusSquared() (*Q, error) {
+	re2, e := c.re.MultiplyChecked(c.re)
+	if e != nil {
+		return nil, e
+	}
+	im2, e := c.im.MultiplyChecked(c.im)
+	if e != nil {
+		return nil, e
+	}
+	return re2.AddChecked(im2)
+}
+
+// Quadrant classifies the argument of c by the signs of its parts
+func (c *C) Quadrant() Quadrant {
+	return quadrant(c.re.Compare(ZeroQ()), c.im.Compare(ZeroQ()))
+}
+
+// Equal checks if c and arg have equal parts
+func (c *C) Equal(arg *C) bool {
+	return c.re.Compare(arg.re) == 0 && c.im.Compare(arg.im) == 0
+}
+
+// String formats c as "a/b + c/di": "1/2 - 3/4i"
+func (c *C) String() string {
+	if c.im.a < 0 {
+		return fmt.Sprintf("%s - %d/%di", c.re, modarith.AbsUint64(c.im.a), c.im.b)
+	}
+	return fmt.Sprintf("%s + %si", c.re, c.im)
+}
+
+// quadrant classifies the argument by signs of real and imaginary part
+func quadrant(re int, im int) Quadrant {
+	switch {
+	case re == 0 && im == 0:
+		return Origin
+	case im == 0:
+		if re > 0 {
+			return PositiveReal
+		}
+		return NegativeReal
+	case re == 0:
+		if im > 0 {
+			return PositiveImaginary
+		}
+		return NegativeImaginary
+	case re > 0 && im > 0:
+		return FirstQuadrant
+	case re < 0 && im > 0:
+		return SecondQuadrant
+	case re < 0:
+		return ThirdQuadrant
+	}
+	return FourthQuadrant
+}
+
+// difference returns ab - cd or ErrOverflow
+func difference(a *Q, b *Q, c *Q, d *Q) (*Q, error) {
+	ab, e := a.MultiplyChecked(b)
+	if e != nil {
+		return nil, e
+	}
+	cd, e := c.MultiplyChecked(d)
+	if e != nil {
+		return nil, e
+	}
+	return ab.SubtractChecked(cd)
+}
+
+var _ = fmt.Stringer(&C{})
@@ -0,0 +1,127 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func c(re string, im string) *C {
+	return NewC(NewQ(re), NewQ(im))
+}
+
+func TestCArithmetic(t *testing.T) {
+	a, b := c("1/2", "-3/4"), c("2/1", "1/1")
+	for op, exp := range map[string]struct {
+		f   func(*C) (*C, error)
+		exp string
+	}{
+		"+": {a.Add, "5/2 + 1/4i"},
+		"-": {a.Subtract, "-3/2 - 7/4i"},
+		"*": {a.Multiply, "7/4 - 1/1i"},
+		"/": {a.Divide, "1/20 - 2/5i"},
+	} {
+		v, e := exp.f(b)
+		if e != nil || v.String() != exp.exp {
+			t.Errorf("%s %s %s: expected %s, got %v, %v", a, op, b, exp.exp, v, e)
+		}
+	}
+	if v, _ := c("0/1", "1/1").Multiply(c("0/1", "1/1")); !v.Equal(c("-1/1", "0/1")) {
+		t.Errorf("i^2: expected -1, got %s", v)
+	}
+	if _, e := a.Divide(c("0/1", "0/1")); e == nil {
+		t.Errorf("expected error dividing by ZERO")
+	}
+	big := c("9223372036854775807/1", "1/1")
+	if _, e := big.Multiply(big); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
+
+func TestCString(t *testing.T) {
+	if s := c("1/2", "-3/4").String(); s != "1/2 - 3/4i" {
+		t.Errorf("expected 1/2 - 3/4i, got %s", s)
+	}
+	if s := c("-1/1", "0/1").String(); s != "-1/1 + 0/1i" {
+		t.Errorf("expected -1/1 + 0/1i, got %s", s)
+	}
+}
+
+func TestModulusSquared(t *testing.T) {
+	for v, exp := range map[*C]string{
+		c("3/1", "4/1"):  "25/1",
+		c("1/2", "-1/2"): "1/2",
+		c("0/1", "0/1"):  "0/1",
+		c("-3/5", "4/5"): "1/1",
+		c("-2/3", "0/1"): "4/9",
+	} {
+		m, e := v.ModulusSquared()
+		if e != nil || m.String() != exp {
+			t.Errorf("|%s|^2: expected %s, got %v, %v", v, exp, m, e)
+		}
+	}
+	big := c("4294967296/1", "0/1")
+	if _, e := big.ModulusSquared(); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", e)
+	}
+}
+
+func TestQuadrant(t *testing.T) {
+	for v, exp := range map[*C]Quadrant{
+		c("0/1", "0/1"):   Origin,
+		c("1/2", "0/1"):   PositiveReal,
+		c("1/2", "1/3"):   FirstQuadrant,
+		c("0/1", "1/3"):   PositiveImaginary,
+		c("-1/2", "1/3"):  SecondQuadrant,
+		c("-1/2", "0/1"):  NegativeReal,
+		c("-1/2", "-1/3"): ThirdQuadrant,
+		c("0/1", "-1/3"):  NegativeImaginary,
+		c("1/2", "-1/3"):  FourthQuadrant,
+	} {
+		if q := v.Quadrant(); q != exp {
+			t.Errorf("%s: expected %s, got %s", v, exp, q)
+		}
+	}
+	if s := Quadrant(42).String(); s != "Quadrant(?)" {
+		t.Errorf("expected Quadrant(?), got %s", s)
+	}
+}
+
+func TestCAgreesWithComplex128(t *testing.T) {
+	vs := []*C{c("1/2", "-3/4"), c("2/1", "1/1"), c("-5/7", "3/11"), c("0/1", "-1/1")}
+	for _, a := range vs {
+		for _, b := range vs {
+			p, e := a.Multiply(b)
+			if e != nil {
+				t.Fatal(e)
+			}
+			if d := complex128Of(p) - complex128Of(a)*complex128Of(b); math.Abs(real(d))+math.Abs(imag(d)) > 1e-12 {
+				t.Errorf("%s * %s: got %s", a, b, p)
+			}
+		}
+	}
+}
+
+func complex128Of(c *C) complex128 {
+	re, _ := c.re.Float64()
+	im, _ := c.im.Float64()
+	return complex(re, im)
+}
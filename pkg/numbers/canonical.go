@@ -0,0 +1,432 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CanonicalVersion is the version of canonical forms of N, Z, Q and C. It's the first byte of the binary form and
+// it'll change if the forms ever have to change (like when arbitrary precision lands).
+//
+// Every value has exactly one canonical form, so Parse(Format(x)) == x and Format(Parse(s)) == s for every valid s.
+// The text forms are "42" for ℕ, "-42" for ℤ, "-3/4" for ℚ (always reduced, the sign only in the numerator and the
+// denominator written even if it's 1: "5/1") and "1/2-3/4i" for ℂ. There's no "+" sign, no leading zeros and no
+// "-0". The binary form is the version, the type ('N', 'Z', 'Q' or 'C') and the shortest varints: unsigned for ℕ and
+// the denominator, zig-zag signed for ℤ and the numerator. ℂ is two ℚ payloads, the real part first.
+//
+// Parsing is strict - non-canonical forms ("2/4", "+1", "007", overlong varints) are rejected - and every encoder of
+// the module goes through these forms: encoding/json and encoding/xml use the text form, encoding/gob the binary one.
+// CBOR (MarshalCBOR) and Protocol Buffers (package numberspb) follow the same rules.
+const CanonicalVersion = 1
+
+// Canonical is a number with canonical text and binary forms - see CanonicalVersion
+type Canonical interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// ErrNotCanonical is returned for input which is not in canonical form, even if it represents a valid number
+var ErrNotCanonical = errors.New("not in canonical form")
+
+// ErrShared is returned when unmarshalling into a shared value, like ZeroN() or OneQ(). Such values are returned to
+// every caller, so changing one of them would change the number for the whole process - unmarshal into new(N) (or
+// a nil pointer field, which encoding packages allocate) instead.
+var ErrShared = errors.New("can't unmarshal into a shared value")
+
+// VerifyRoundTrip checks the guarantee Parse(Format(x)) == x for both forms of x, parsing them into empty (which
+// has to be of the same type as x). Canonical forms are unique, so the values are equal if their forms are.
+func VerifyRoundTrip(x Canonical, empty Canonical) error {
+	text, e := x.MarshalText()
+	if e != nil {
+		return e
+	}
+	if e = empty.UnmarshalText(text); e != nil {
+		return fmt.Errorf("parsing %q: %w", text, e)
+	}
+	if again, e := empty.MarshalText(); e != nil || !bytes.Equal(again, text) {
+		return fmt.Errorf("%q parsed and formatted again is %q (%v)", text, again, e)
+	}
+	data, e := x.MarshalBinary()
+	if e != nil {
+		return e
+	}
+	if e = empty.UnmarshalBinary(data); e != nil {
+		return fmt.Errorf("parsing %x: %w", data, e)
+	}
+	if again, e := empty.MarshalBinary(); e != nil || !bytes.Equal(again, data) {
+		return fmt.Errorf("%x parsed and formatted again is %x (%v)", data, again, e)
+	}
+	return nil
+}
+
+// MarshalText returns canonical text form of n: "42"
+func (n *N) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, n.value, 10), nil
+}
+
+// UnmarshalText parses canonical text form of ℕ
+func (n *N) UnmarshalText(text []byte) error {
+	if n.shared() {
+		return ErrShared
+	}
+	v, e := parseCanonicalNatural(string(text))
+	if e != nil {
+		return fmt.Errorf("invalid ℕ %q: %w", text, e)
+	}
+	n.value = v
+	return nil
+}
+
+// MarshalBinary returns canonical binary form of n
+func (n *N) MarshalBinary() ([]byte, error) {
+	return binary.AppendUvarint([]byte{CanonicalVersion, 'N'}, n.value), nil
+}
+
+// UnmarshalBinary parses canonical binary form of ℕ
+func (n *N) UnmarshalBinary(data []byte) error {
+	if n.shared() {
+		return ErrShared
+	}
+	payload, e := canonicalPayload(data, 'N')
+	if e != nil {
+		return e
+	}
+	v, e := readUvarint(&payload)
+	if e == nil {
+		e = noTrailingBytes(payload)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℕ: %w", e)
+	}
+	n.value = v
+	return nil
+}
+
+// MarshalText returns canonical text form of z: "-42"
+func (z *Z) MarshalText() ([]byte, error) {
+	return strconv.AppendInt(nil, z.value, 10), nil
+}
+
+// UnmarshalText parses canonical text form of ℤ
+func (z *Z) UnmarshalText(text []byte) error {
+	if z.shared() {
+		return ErrShared
+	}
+	v, e := parseCanonicalInteger(string(text))
+	if e != nil {
+		return fmt.Errorf("invalid ℤ %q: %w", text, e)
+	}
+	z.value = v
+	return nil
+}
+
+// MarshalBinary returns canonical binary form of z
+func (z *Z) MarshalBinary() ([]byte, error) {
+	return binary.AppendVarint([]byte{CanonicalVersion, 'Z'}, z.value), nil
+}
+
+// UnmarshalBinary parses canonical binary form of ℤ
+func (z *Z) UnmarshalBinary(data []byte) error {
+	if z.shared() {
+		return ErrShared
+	}
+	payload, e := canonicalPayload(data, 'Z')
+	if e != nil {
+		return e
+	}
+	v, e := readVarint(&payload)
+	if e == nil {
+		e = noTrailingBytes(payload)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℤ: %w", e)
+	}
+	z.value = v
+	return nil
+}
+
+// MarshalText returns canonical text form of q: "-3/4" or "5/1"
+func (q *Q) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText parses canonical text form of ℚ
+func (q *Q) UnmarshalText(text []byte) error {
+	if q.shared() {
+		return ErrShared
+	}
+	v, e := parseCanonicalQ(string(text))
+	if e != nil {
+		return fmt.Errorf("invalid ℚ %q: %w", text, e)
+	}
+	*q = *v
+	return nil
+}
+
+// MarshalBinary returns canonical binary form of q
+func (q *Q) MarshalBinary() ([]byte, error) {
+	return appendQ([]byte{CanonicalVersion, 'Q'}, q), nil
+}
+
+// UnmarshalBinary parses canonical binary form of ℚ
+func (q *Q) UnmarshalBinary(data []byte) error {
+	if q.shared() {
+		return ErrShared
+	}
+	payload, e := canonicalPayload(data, 'Q')
+	if e != nil {
+		return e
+	}
+	v, e := readQ(&payload)
+	if e == nil {
+		e = noTrailingBytes(payload)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℚ: %w", e)
+	}
+	*q = *v
+	return nil
+}
+
+// MarshalText returns canonical text form of c: "1/2-3/4i" or "0/1+1/1i"
+func (c *C) MarshalText() ([]byte, error) {
+	im := c.im.String()
+	if c.im.a >= 0 {
+		im = "+" + im
+	}
+	return []byte(c.re.String() + im + "i"), nil
+}
+
+// UnmarshalText parses canonical text form of ℂ
+func (c *C) UnmarshalText(text []byte) error {
+	body, found := strings.CutSuffix(string(text), "i")
+	// the imaginary part starts with the sign after the real part's denominator
+	slash := strings.IndexByte(body, '/')
+	sign := -1
+	if found && slash >= 0 {
+		sign = strings.IndexAny(body[slash+1:], "+-")
+	}
+	if sign < 0 {
+		return fmt.Errorf("invalid ℂ %q: %w", text, ErrNotCanonical)
+	}
+	sign += slash + 1
+	re, e := parseCanonicalQ(body[:sign])
+	if e != nil {
+		return fmt.Errorf("invalid ℂ %q: %w", text, e)
+	}
+	imText := body[sign:]
+	if imText[0] == '+' {
+		if imText = imText[1:]; strings.HasPrefix(imText, "-") {
+			return fmt.Errorf("invalid ℂ %q: %w", text, ErrNotCanonical)
+		}
+	}
+	im, e := parseCanonicalQ(imText)
+	if e != nil {
+		return fmt.Errorf("invalid ℂ %q: %w", text, e)
+	}
+	c.re, c.im = re, im
+	return nil
+}
+
+// MarshalBinary returns canonical binary form of c
+func (c *C) MarshalBinary() ([]byte, error) {
+	return appendQ(appendQ([]byte{CanonicalVersion, 'C'}, c.re), c.im), nil
+}
+
+// UnmarshalBinary parses canonical binary form of ℂ
+func (c *C) UnmarshalBinary(data []byte) error {
+	payload, e := canonicalPayload(data, 'C')
+	if e != nil {
+		return e
+	}
+	re, e := readQ(&payload)
+	if e != nil {
+		return fmt.Errorf("invalid ℂ: %w", e)
+	}
+	im, e := readQ(&payload)
+	if e == nil {
+		e = noTrailingBytes(payload)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℂ: %w", e)
+	}
+	c.re, c.im = re, im
+	return nil
+}
+
+// shared checks if n is one of interned values
+func (n *N) shared() bool {
+	return n.value <= maxInterned && smallN[n.value] == n
+}
+
+// shared checks if z is one of interned values
+func (z *Z) shared() bool {
+	return z.value >= 0 && z.value <= maxInterned && smallZ[z.value] == z
+}
+
+// shared checks if q is one of well known values
+func (q *Q) shared() bool {
+	return q == &qZero || q == &qOne
+}
+
+var _ = Canonical(&N{})
+var _ = Canonical(&Z{})
+var _ = Canonical(&Q{})
+var _ = Canonical(&C{})
+
+// parseCanonicalNatural parses decimal digits without leading zeros
+func parseCanonicalNatural(s string) (uint64, error) {
+	if s == "" || s[0] < '0' || s[0] > '9' || s[0] == '0' && len(s) > 1 {
+		return 0, ErrNotCanonical
+	}
+	v, e := strconv.ParseUint(s, 10, 64)
+	if errors.Is(e, strconv.ErrRange) {
+		return 0, ErrOverflow
+	}
+	if e != nil {
+		return 0, ErrNotCanonical
+	}
+	return v, nil
+}
+
+// parseCanonicalInteger parses canonical ℕ with optional "-" (but not "-0")
+func parseCanonicalInteger(s string) (int64, error) {
+	digits, neg := strings.CutPrefix(s, "-")
+	if neg && digits == "0" {
+		return 0, ErrNotCanonical
+	}
+	if _, e := parseCanonicalNatural(digits); e != nil && !errors.Is(e, ErrOverflow) {
+		return 0, e
+	}
+	v, e := strconv.ParseInt(s, 10, 64)
+	if e != nil {
+		return 0, ErrOverflow
+	}
+	return v, nil
+}
+
+// parseCanonicalQ parses "a/b" with b > 0 and a/b in lowest terms
+func parseCanonicalQ(s string) (*Q, error) {
+	num, den, found := strings.Cut(s, "/")
+	if !found {
+		return nil, ErrNotCanonical
+	}
+	a, e := parseCanonicalInteger(num)
+	if e != nil {
+		return nil, e
+	}
+	b, e := parseCanonicalInteger(den)
+	if e != nil {
+		return nil, e
+	}
+	return canonicalQ(a, b)
+}
+
+// canonicalQ returns a/b if it's already in lowest terms with b > 0
+func canonicalQ(a int64, b int64) (*Q, error) {
+	if b <= 0 {
+		return nil, ErrNotCanonical
+	}
+	q, e := QFromInts(a, b)
+	if e != nil {
+		return nil, e
+	}
+	if q.a != a || q.b != b {
+		return nil, ErrNotCanonical
+	}
+	return q, nil
+}
+
+// canonicalPayload checks the version and type of binary form and returns the rest
+func canonicalPayload(data []byte, kind byte) ([]byte, error) {
+	if len(data) < 2 {
+		return nil, errors.New("binary form too short")
+	}
+	if data[0] != CanonicalVersion {
+		return nil, fmt.Errorf("unsupported version %d of binary form", data[0])
+	}
+	if data[1] != kind {
+		return nil, fmt.Errorf("expected type %q, got %q", kind, data[1])
+	}
+	return data[2:], nil
+}
+
+// appendQ appends zig-zag varint numerator and varint denominator of q
+func appendQ(data []byte, q *Q) []byte {
+	return binary.AppendUvarint(binary.AppendVarint(data, q.a), uint64(q.b))
+}
+
+// readQ reads ℚ written by appendQ from the beginning of data
+func readQ(data *[]byte) (*Q, error) {
+	a, e := readVarint(data)
+	if e != nil {
+		return nil, e
+	}
+	b, e := readUvarint(data)
+	if e != nil {
+		return nil, e
+	}
+	if b > 1<<63-1 {
+		return nil, ErrOverflow
+	}
+	return canonicalQ(a, int64(b))
+}
+
+// readUvarint reads the shortest unsigned varint from the beginning of data
+func readUvarint(data *[]byte) (uint64, error) {
+	v, n := binary.Uvarint(*data)
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	if n != len(binary.AppendUvarint(nil, v)) {
+		return 0, ErrNotCanonical
+	}
+	*data = (*data)[n:]
+	return v, nil
+}
+
+// readVarint reads the shortest zig-zag signed varint from the beginning of data
+func readVarint(data *[]byte) (int64, error) {
+	v, n := binary.Varint(*data)
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	if n != len(binary.AppendVarint(nil, v)) {
+		return 0, ErrNotCanonical
+	}
+	*data = (*data)[n:]
+	return v, nil
+}
+
+// noTrailingBytes checks if the whole binary form was read
+func noTrailingBytes(data []byte) error {
+	if len(data) > 0 {
+		return fmt.Errorf("%d unexpected bytes after the value", len(data))
+	}
+	return nil
+}
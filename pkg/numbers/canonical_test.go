@@ -0,0 +1,222 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCanonicalText(t *testing.T) {
+	for _, c := range []struct {
+		x    Canonical
+		text string
+	}{
+		{NFromUint64(0), "0"},
+		{NFromUint64(math.MaxUint64), "18446744073709551615"},
+		{ZFromInt64(-42), "-42"},
+		{ZFromInt64(math.MinInt64), "-9223372036854775808"},
+		{NewQ("-6/8"), "-3/4"},
+		{NewQ("5/1"), "5/1"},
+		{NewQ("0/7"), "0/1"},
+		{NewC(NewQ("1/2"), NewQ("-3/4")), "1/2-3/4i"},
+		{NewC(NewQ("-1/2"), NewQ("3/4")), "-1/2+3/4i"},
+		{NewC(ZeroQ(), OneQ()), "0/1+1/1i"},
+	} {
+		text, e := c.x.MarshalText()
+		if e != nil || string(text) != c.text {
+			t.Errorf("expected %s, got %s (%v)", c.text, text, e)
+		}
+	}
+}
+
+func TestVerifyRoundTrip(t *testing.T) {
+	for _, v := range []uint64{0, 1, 127, 128, math.MaxUint64} {
+		if e := VerifyRoundTrip(NFromUint64(v), &N{}); e != nil {
+			t.Errorf("%d: %v", v, e)
+		}
+	}
+	for _, v := range []int64{0, -1, 63, -64, 64, math.MaxInt64, math.MinInt64} {
+		if e := VerifyRoundTrip(ZFromInt64(v), &Z{}); e != nil {
+			t.Errorf("%d: %v", v, e)
+		}
+	}
+	for _, v := range []string{"0/1", "-3/4", "9223372036854775807/2", "-9223372036854775808/1",
+		"1/9223372036854775807"} {
+		if e := VerifyRoundTrip(NewQ(v), &Q{}); e != nil {
+			t.Errorf("%s: %v", v, e)
+		}
+	}
+	for _, c := range []*C{NewC(NewQ("1/2"), NewQ("-3/4")), NewC(NewQ("-5/1"), ZeroQ()), NewC(ZeroQ(), NewQ("-1/3"))} {
+		if e := VerifyRoundTrip(c, &C{}); e != nil {
+			t.Errorf("%s: %v", c, e)
+		}
+	}
+	if e := VerifyRoundTrip(NFromUint64(1), &Z{}); e == nil {
+		t.Error("expected error for different types")
+	}
+}
+
+func TestNonCanonicalText(t *testing.T) {
+	for _, c := range []struct {
+		x     Canonical
+		texts []string
+	}{
+		{&N{}, []string{"", "+1", "-1", "007", "1_000", "0x10", "2⁵", " 1", "1.0"}},
+		{&Z{}, []string{"", "+1", "-0", "-007", "--1", "1-"}},
+		{&Q{}, []string{"", "1", "2/4", "1/-2", "-1/-2", "0/5", "+1/2", "1/02", "1/0", "1⁄2"}},
+		{&C{}, []string{"", "1/2", "1/2+3/4", "1/2+-3/4i", "1/2 - 3/4i", "1/2+2/4i", "i", "1+1i", "1/1+1/1ii"}},
+	} {
+		for _, text := range c.texts {
+			if e := c.x.UnmarshalText([]byte(text)); !errors.Is(e, ErrNotCanonical) {
+				t.Errorf("%T %q: expected ErrNotCanonical, got %v", c.x, text, e)
+			}
+		}
+	}
+	if e := (&N{}).UnmarshalText([]byte("18446744073709551616")); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if e := (&Z{}).UnmarshalText([]byte("-9223372036854775809")); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestCanonicalBinary(t *testing.T) {
+	for _, c := range []struct {
+		x    Canonical
+		data []byte
+	}{
+		{NFromUint64(300), []byte{CanonicalVersion, 'N', 0xac, 0x02}},
+		{ZFromInt64(-1), []byte{CanonicalVersion, 'Z', 0x01}},
+		{NewQ("-3/4"), []byte{CanonicalVersion, 'Q', 0x05, 0x04}},
+		{NewC(OneQ(), NewQ("-1/2")), []byte{CanonicalVersion, 'C', 0x02, 0x01, 0x01, 0x02}},
+	} {
+		data, e := c.x.MarshalBinary()
+		if e != nil || !bytes.Equal(data, c.data) {
+			t.Errorf("%v: expected %x, got %x (%v)", c.x, c.data, data, e)
+		}
+	}
+}
+
+func TestNonCanonicalBinary(t *testing.T) {
+	for _, data := range [][]byte{
+		{CanonicalVersion, 'N', 0x80, 0x00}, // overlong 0
+		{CanonicalVersion, 'Q', 0x04, 0x08}, // 2/4
+		{CanonicalVersion, 'Q', 0x02, 0x00}, // 1/0
+	} {
+		var x Canonical = &N{}
+		if data[1] == 'Q' {
+			x = &Q{}
+		}
+		if e := x.UnmarshalBinary(data); !errors.Is(e, ErrNotCanonical) {
+			t.Errorf("%x: expected ErrNotCanonical, got %v", data, e)
+		}
+	}
+	for _, data := range [][]byte{
+		nil,
+		{CanonicalVersion},
+		{2, 'N', 0x01},
+		{CanonicalVersion, 'Z', 0x01},
+		{CanonicalVersion, 'N'},
+		{CanonicalVersion, 'N', 0x01, 0x01},
+		{CanonicalVersion, 'N', 0x80},
+	} {
+		if e := (&N{}).UnmarshalBinary(data); e == nil {
+			t.Errorf("%x: expected error", data)
+		}
+	}
+}
+
+func TestEncodersUseCanonicalForms(t *testing.T) {
+	type record struct {
+		N *N
+		Z *Z
+		Q *Q
+		C *C
+	}
+	in := record{NFromUint64(7), ZFromInt64(-7), NewQ("6/-8"), NewC(NewQ("1/2"), NewQ("-3/4"))}
+
+	j, e := json.Marshal(in)
+	if e != nil || string(j) != `{"N":"7","Z":"-7","Q":"-3/4","C":"1/2-3/4i"}` {
+		t.Errorf("unexpected JSON %s (%v)", j, e)
+	}
+	var fromJSON record
+	if e = json.Unmarshal(j, &fromJSON); e != nil || fromJSON.Q.Compare(in.Q) != 0 || !fromJSON.C.Equal(in.C) {
+		t.Errorf("unexpected %v (%v)", fromJSON, e)
+	}
+	if e = json.Unmarshal([]byte(`{"Q":"2/4"}`), &fromJSON); !errors.Is(e, ErrNotCanonical) {
+		t.Errorf("expected ErrNotCanonical, got %v", e)
+	}
+
+	x, e := xml.Marshal(in)
+	if e != nil || string(x) != "<record><N>7</N><Z>-7</Z><Q>-3/4</Q><C>1/2-3/4i</C></record>" {
+		t.Errorf("unexpected XML %s (%v)", x, e)
+	}
+
+	var buf bytes.Buffer
+	if e = gob.NewEncoder(&buf).Encode(in); e != nil {
+		t.Fatal(e)
+	}
+	var fromGob record
+	if e = gob.NewDecoder(&buf).Decode(&fromGob); e != nil {
+		t.Fatal(e)
+	}
+	if fromGob.N.Uint64() != 7 || fromGob.Z.Int64() != -7 || fromGob.Q.Compare(in.Q) != 0 || !fromGob.C.Equal(in.C) {
+		t.Errorf("unexpected %v", fromGob)
+	}
+}
+
+func TestUnmarshalIntoSharedValue(t *testing.T) {
+	cfg := struct {
+		Limit *N
+		Step  *Z
+		Ratio *Q
+	}{Limit: ZeroN(), Step: OneZ(), Ratio: OneQ()}
+	for _, in := range []string{`{"Limit":"5"}`, `{"Step":"3"}`, `{"Ratio":"7/2"}`} {
+		if e := json.Unmarshal([]byte(in), &cfg); !errors.Is(e, ErrShared) {
+			t.Errorf("%s: expected ErrShared, got %v", in, e)
+		}
+	}
+	for _, unmarshal := range []func() error{
+		func() error { return NFromUint64(200).UnmarshalBinary([]byte{CanonicalVersion, 'N', 5}) },
+		func() error { return ZFromInt64(2).UnmarshalCBOR([]byte{0x05}) },
+		func() error { return ZeroQ().UnmarshalText([]byte("7/2")) },
+		func() error { return OneQ().UnmarshalCBOR([]byte{0xd8, 0x1e, 0x82, 0x07, 0x02}) },
+	} {
+		if e := unmarshal(); !errors.Is(e, ErrShared) {
+			t.Errorf("expected ErrShared, got %v", e)
+		}
+	}
+	if ZeroN().Uint64() != 0 || NFromUint64(0).Uint64() != 0 || OneN().Add(ZeroN()).Uint64() != 1 ||
+		NFromUint64(200).Uint64() != 200 || OneZ().Int64() != 1 || ZFromInt64(2).Int64() != 2 ||
+		ZeroQ().String() != "0/1" || OneQ().String() != "1/1" {
+		t.Error("shared values changed")
+	}
+
+	// values which aren't shared can still be reused
+	n := NFromUint64(1000)
+	if e := n.UnmarshalText([]byte("5")); e != nil || n.Uint64() != 5 {
+		t.Errorf("unexpected %v (%v)", n, e)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// CBOR major types and the tag of rational numbers (RFC 8949 and the IANA registry of CBOR tags)
+const (
+	cborUnsigned byte   = 0
+	cborNegative byte   = 1
+	cborArray    byte   = 4
+	cborTag      byte   = 6
+	cborTagQ     uint64 = 30
+)
+
+// MarshalCBOR returns CBOR unsigned integer for n. It implements cbor.Marshaler of github.com/fxamacker/cbor without
+// depending on it.
+func (n *N) MarshalCBOR() ([]byte, error) {
+	return appendCBORHead(nil, cborUnsigned, n.value), nil
+}
+
+// UnmarshalCBOR parses CBOR unsigned integer in the shortest form
+func (n *N) UnmarshalCBOR(data []byte) error {
+	if n.shared() {
+		return ErrShared
+	}
+	major, v, rest, e := readCBORHead(data)
+	if e == nil && major != cborUnsigned {
+		e = fmt.Errorf("expected unsigned integer, got major type %d", major)
+	}
+	if e == nil {
+		e = noTrailingBytes(rest)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℕ: %w", e)
+	}
+	n.value = v
+	return nil
+}
+
+// MarshalCBOR returns CBOR unsigned or negative integer for z
+func (z *Z) MarshalCBOR() ([]byte, error) {
+	return appendCBORInt(nil, z.value), nil
+}
+
+// UnmarshalCBOR parses CBOR integer in the shortest form
+func (z *Z) UnmarshalCBOR(data []byte) error {
+	if z.shared() {
+		return ErrShared
+	}
+	v, rest, e := readCBORInt(data)
+	if e == nil {
+		e = noTrailingBytes(rest)
+	}
+	if e != nil {
+		return fmt.Errorf("invalid ℤ: %w", e)
+	}
+	z.value = v
+	return nil
+}
+
+// MarshalCBOR returns CBOR rational number for q - tag 30 with array [numerator, denominator], in lowest terms with
+// positive denominator
+func (q *Q) MarshalCBOR() ([]byte, error) {
+	data := appendCBORHead(nil, cborTag, cborTagQ)
+	data = appendCBORHead(data, cborArray, 2)
+	return appendCBORInt(appendCBORInt(data, q.a), q.b), nil
+}
+
+// UnmarshalCBOR parses CBOR rational number in canonical form
+func (q *Q) UnmarshalCBOR(data []byte) error {
+	if q.shared() {
+		return ErrShared
+	}
+	v, e := readCBORQ(data)
+	if e != nil {
+		return fmt.Errorf("invalid ℚ: %w", e)
+	}
+	*q = *v
+	return nil
+}
+
+// readCBORQ reads tag 30 with [numerator, denominator]
+func readCBORQ(data []byte) (*Q, error) {
+	major, tag, rest, e := readCBORHead(data)
+	if e != nil {
+		return nil, e
+	}
+	if major != cborTag || tag != cborTagQ {
+		return nil, fmt.Errorf("expected tag %d", cborTagQ)
+	}
+	major, size, rest, e := readCBORHead(rest)
+	if e != nil {
+		return nil, e
+	}
+	if major != cborArray || size != 2 {
+		return nil, errors.New("expected array of numerator and denominator")
+	}
+	a, rest, e := readCBORInt(rest)
+	if e != nil {
+		return nil, e
+	}
+	b, rest, e := readCBORInt(rest)
+	if e != nil {
+		return nil, e
+	}
+	if e = noTrailingBytes(rest); e != nil {
+		return nil, e
+	}
+	return canonicalQ(a, b)
+}
+
+// appendCBORHead appends the major type with its argument in the shortest form
+func appendCBORHead(data []byte, major byte, v uint64) []byte {
+	m := major << 5
+	switch {
+	case v < 24:
+		return append(data, m|byte(v))
+	case v <= math.MaxUint8:
+		return append(data, m|24, byte(v))
+	case v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(data, m|25), uint16(v))
+	case v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(data, m|26), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(data, m|27), v)
+}
+
+// appendCBORInt appends v as unsigned integer or negative integer -1-n
+func appendCBORInt(data []byte, v int64) []byte {
+	if v < 0 {
+		return appendCBORHead(data, cborNegative, uint64(-(v + 1)))
+	}
+	return appendCBORHead(data, cborUnsigned, uint64(v))
+}
+
+// readCBORHead reads the major type and its argument, which has to be in the shortest form
+func readCBORHead(data []byte) (major byte, v uint64, rest []byte, e error) {
+	if len(data) == 0 {
+		return 0, 0, nil, errors.New("unexpected end of CBOR")
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	size := 0
+	switch {
+	case info < 24:
+		return major, uint64(info), data[1:], nil
+	case info <= 27:
+		size = 1 << (info - 24)
+	default:
+		return 0, 0, nil, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+	if len(data) < 1+size {
+		return 0, 0, nil, errors.New("unexpected end of CBOR")
+	}
+	for _, b := range data[1 : 1+size] {
+		v = v<<8 | uint64(b)
+	}
+	if len(appendCBORHead(nil, major, v)) != 1+size {
+		return 0, 0, nil, ErrNotCanonical
+	}
+	return major, v, data[1+size:], nil
+}
+
+// readCBORInt reads unsigned or negative integer which fits int64
+func readCBORInt(data []byte) (int64, []byte, error) {
+	major, v, rest, e := readCBORHead(data)
+	if e != nil {
+		return 0, nil, e
+	}
+	switch {
+	case major != cborUnsigned && major != cborNegative:
+		return 0, nil, fmt.Errorf("expected integer, got major type %d", major)
+	case v > math.MaxInt64:
+		return 0, nil, ErrOverflow
+	case major == cborNegative:
+		return -int64(v) - 1, rest, nil
+	}
+	return int64(v), rest, nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// cborOf decodes hex of expected CBOR
+func cborOf(t *testing.T, h string) []byte {
+	t.Helper()
+	data, e := hex.DecodeString(h)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return data
+}
+
+func TestCBORIntegers(t *testing.T) {
+	// examples of RFC 8949, appendix A
+	for v, h := range map[uint64]string{0: "00", 23: "17", 24: "1818", 1000: "1903e8", 1000000: "1a000f4240",
+		18446744073709551615: "1bffffffffffffffff"} {
+		data, _ := NFromUint64(v).MarshalCBOR()
+		if !bytes.Equal(data, cborOf(t, h)) {
+			t.Errorf("%d: expected %s, got %x", v, h, data)
+		}
+		var n N
+		if e := n.UnmarshalCBOR(data); e != nil || n.Uint64() != v {
+			t.Errorf("%s: expected %d, got %s (%v)", h, v, &n, e)
+		}
+	}
+	for v, h := range map[int64]string{-1: "20", -10: "29", -100: "3863", -1000: "3903e7", 100: "1864",
+		-9223372036854775808: "3b7fffffffffffffff"} {
+		data, _ := ZFromInt64(v).MarshalCBOR()
+		if !bytes.Equal(data, cborOf(t, h)) {
+			t.Errorf("%d: expected %s, got %x", v, h, data)
+		}
+		var z Z
+		if e := z.UnmarshalCBOR(data); e != nil || z.Int64() != v {
+			t.Errorf("%s: expected %d, got %s (%v)", h, v, &z, e)
+		}
+	}
+}
+
+func TestCBORRational(t *testing.T) {
+	data, _ := NewQ("-3/4").MarshalCBOR()
+	if !bytes.Equal(data, cborOf(t, "d81e822204")) {
+		t.Errorf("unexpected %x", data)
+	}
+	var q Q
+	if e := q.UnmarshalCBOR(data); e != nil || q.String() != "-3/4" {
+		t.Errorf("unexpected %s (%v)", &q, e)
+	}
+	for _, h := range []string{
+		"d81e820204", // 2/4
+		"d81e820120", // 1/-1
+		"d81e820100", // 1/0
+	} {
+		if e := q.UnmarshalCBOR(cborOf(t, h)); !errors.Is(e, ErrNotCanonical) {
+			t.Errorf("%s: expected ErrNotCanonical, got %v", h, e)
+		}
+	}
+	for _, h := range []string{"", "d81f820104", "d81e830104", "d81e8201", "d81e82010400", "d81e8201f6"} {
+		if e := q.UnmarshalCBOR(cborOf(t, h)); e == nil {
+			t.Errorf("%s: expected error", h)
+		}
+	}
+}
+
+func TestCBORStrictness(t *testing.T) {
+	var n N
+	if e := n.UnmarshalCBOR(cborOf(t, "1817")); !errors.Is(e, ErrNotCanonical) {
+		t.Errorf("expected ErrNotCanonical for overlong 23, got %v", e)
+	}
+	for _, h := range []string{"20", "1801", "190001", "0000", "1c", "f6", "1a0001"} {
+		if e := n.UnmarshalCBOR(cborOf(t, h)); e == nil {
+			t.Errorf("%s: expected error", h)
+		}
+	}
+	var z Z
+	for _, h := range []string{"1b8000000000000000", "3b8000000000000000"} {
+		if e := z.UnmarshalCBOR(cborOf(t, h)); !errors.Is(e, ErrOverflow) {
+			t.Errorf("%s: expected overflow, got %v", h, e)
+		}
+	}
+}
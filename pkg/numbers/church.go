@@ -0,0 +1,105 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Church is ℕ encoded as a function in lambda calculus: numeral n takes a function f and returns f applied n times,
+// so 0 is λf.λx.x, 1 is λf.λx.f(x) and 2 is λf.λx.f(f(x)). There are no digits or counters - the number is only
+// what it does, and arithmetic is function composition. Values are any, so numerals can be applied to numerals too.
+type Church func(f func(any) any) func(any) any
+
+// ChurchZero returns numeral 0 = λf.λx.x, which applies f no times
+func ChurchZero() Church {
+	return func(func(any) any) func(any) any {
+		return func(x any) any {
+			return x
+		}
+	}
+}
+
+// ChurchFromN returns numeral n = λf.λx.f(...f(x)), which applies f n times
+func ChurchFromN(n *N) Church {
+	times := n.value
+	return func(f func(any) any) func(any) any {
+		return func(x any) any {
+			for i := uint64(0); i < times; i++ {
+				x = f(x)
+			}
+			return x
+		}
+	}
+}
+
+// Succ returns numeral c + 1 = λf.λx.f(c(f)(x)) - one more application of f
+func (c Church) Succ() Church {
+	return func(f func(any) any) func(any) any {
+		return func(x any) any {
+			return f(c(f)(x))
+		}
+	}
+}
+
+// Add returns numeral c + d = λf.λx.c(f)(d(f)(x)) - f applied d times and then c times more
+func (c Church) Add(d Church) Church {
+	return func(f func(any) any) func(any) any {
+		return func(x any) any {
+			return c(f)(d(f)(x))
+		}
+	}
+}
+
+// Multiply returns numeral c · d = λf.c(d(f)) - applying f d times, repeated c times
+func (c Church) Multiply(d Church) Church {
+	return func(f func(any) any) func(any) any {
+		return c(d(f))
+	}
+}
+
+// Power returns numeral c^d = λf.d(c)(f) - d applications of "apply c times" (0^0 is 1)
+func (c Church) Power(d Church) Church {
+	return func(f func(any) any) func(any) any {
+		// c as a function of the value type, so d can apply it
+		apply := func(g any) any {
+			return c(g.(func(any) any))
+		}
+		return d(apply)(f).(func(any) any)
+	}
+}
+
+// N decodes the numeral by applying it to "add one unit" and ZERO
+func (c Church) N() *N {
+	succ := func(x any) any {
+		acc := accumulator{value: x.(*N).value}
+		acc.addOne()
+		return acc.n()
+	}
+	return c(succ)(ZeroN()).(*N)
+}
+
+// String formats c as lambda term: "λf.λx.f(f(x))"
+func (c Church) String() string {
+	k := int(c.N().value)
+	return "λf.λx." + strings.Repeat("f(", k) + "x" + strings.Repeat(")", k)
+}
+
+var _ = fmt.Stringer(ChurchZero())
@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import "testing"
+
+func TestChurchNumerals(t *testing.T) {
+	zero := ChurchZero()
+	two := zero.Succ().Succ()
+	if zero.N().Uint64() != 0 || two.N().Uint64() != 2 {
+		t.Errorf("unexpected %s, %s", zero.N(), two.N())
+	}
+	if zero.String() != "λf.λx.x" || two.String() != "λf.λx.f(f(x))" {
+		t.Errorf("unexpected %s, %s", zero, two)
+	}
+	// numerals apply any function, not only the successor
+	double := func(x any) any { return x.(int) * 2 }
+	if v := ChurchFromN(NFromUint64(10))(double)(1); v != 1024 {
+		t.Errorf("expected 2^10, got %v", v)
+	}
+	for _, v := range []uint64{0, 1, 5, 1000} {
+		if n := ChurchFromN(NFromUint64(v)).N(); n.Uint64() != v {
+			t.Errorf("%d: got %s", v, n)
+		}
+	}
+}
+
+func TestChurchArithmetic(t *testing.T) {
+	c := func(v uint64) Church {
+		return ChurchFromN(NFromUint64(v))
+	}
+	for _, p := range [][2]uint64{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {2, 3}, {3, 2}, {7, 4}} {
+		a, b := p[0], p[1]
+		if n := c(a).Add(c(b)).N().Uint64(); n != a+b {
+			t.Errorf("%d + %d: got %d", a, b, n)
+		}
+		if n := c(a).Multiply(c(b)).N().Uint64(); n != a*b {
+			t.Errorf("%d · %d: got %d", a, b, n)
+		}
+		expected := NFromUint64(a).Power(NFromUint64(b)).Uint64()
+		if n := c(a).Power(c(b)).N().Uint64(); n != expected {
+			t.Errorf("%d^%d: expected %d, got %d", a, b, expected, n)
+		}
+	}
+	if n := ChurchZero().Succ().Succ().Succ().Multiply(c(4)).Add(c(1)).N().Uint64(); n != 13 {
+		t.Errorf("expected 13, got %d", n)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ContinuedFraction is a finite (simple) continued fraction [a0; a1, a2, ..., an] = a0 + 1/(a1 + 1/(a2 + ...)), with
+// a0 ∈ ℤ and a1, ..., an positive ℕ. Every ℚ has exactly one such representation with an > 1 (or n = 0), because
+// [..., an, 1] = [..., an + 1] - and it's the form always kept. Values of ContinuedFraction are immutable.
+type ContinuedFraction struct {
+	a0    *Z
+	terms []*N
+
+	fmt.Stringer
+}
+
+// NewContinuedFraction creates [a0; a1, ..., an] - all terms except a0 have to be positive
+func NewContinuedFraction(a0 *Z, terms ...*N) (*ContinuedFraction, error) {
+	for _, t := range terms {
+		if t.value == 0 {
+			return nil, errors.New("terms of continued fraction (except the first) have to be positive")
+		}
+	}
+	terms = append([]*N(nil), terms...)
+	if n := len(terms); n > 0 && terms[n-1].value == 1 {
+		// [..., an, 1] = [..., an + 1]
+		terms = terms[:n-1]
+		if n == 1 {
+			a, e := addInt64(a0.value, 1)
+			if e != nil {
+				return nil, e
+			}
+			a0 = ZFromInt64(a)
+		} else {
+			a, e := addUint64(terms[n-2].value, 1)
+			if e != nil {
+				return nil, e
+			}
+			terms[n-2] = NFromUint64(a)
+		}
+	}
+	return &ContinuedFraction{a0: a0, terms: terms}, nil
+}
+
+// ContinuedFraction expands q using Euclid's algorithm:
+// a0 = floor(A/B), A = a0*B + R0 (0 <= R0 < B) -> A/B = a0 + R0/B = a0 + 1/(B/R0) and so on, until remainder is 0
+func (q *Q) ContinuedFraction() *ContinuedFraction {
+	a, b := q.Ratio()
+	a0, r := a/b, a%b
+	if r < 0 {
+		// floor, not truncation
+		a0, r = a0-1, r+b
+	}
+	terms := make([]*N, 0)
+	for a, b = b, r; b != 0; a, b = b, a%b {
+		terms = append(terms, NFromUint64(uint64(a/b)))
+	}
+	return &ContinuedFraction{a0: ZFromInt64(a0), terms: terms}
+}
+
+// LimitDenominator returns the closest ℚ to q with denominator not greater than maxDenominator - just like
+// Fraction.limit_denominator in Python. q itself is returned if its denominator is small enough.
+func (q *Q) LimitDenominator(maxDenominator *N) (*Q, error) {
+	if maxDenominator.value == 0 {
+		return nil, errors.New("maximal denominator has to be positive")
+	}
+	if uint64(q.b) <= maxDenominator.value {
+		return q, nil
+	}
+	return q.ContinuedFraction().BestApproximation(maxDenominator)
+}
+
+// Terms returns a0 and the remaining terms a1, ..., an
+func (cf *ContinuedFraction) Terms() (*Z, []*N) {
+	return cf.a0, append([]*N(nil), cf.terms...)
+}
+
+// Q reconstructs ℚ from the continued fraction, starting from the last term: an + 1/(h/k) = (an*h + k) / h
+func (cf *ContinuedFraction) Q() (*Q, error) {
+	var h, k int64 = 1, 0
+	for i := len(cf.terms) - 1; i >= -1; i-- {
+		a, e := cf.term(i)
+		if e != nil {
+			return nil, e
+		}
+		ah, e := mulInt64(a, h)
+		if e != nil {
+			return nil, e
+		}
+		if ah, e = addInt64(ah, k); e != nil {
+			return nil, e
+		}
+		h, k = ah, h
+	}
+	return reduceQ(h, k)
+}
+
+// Convergents returns [a0], [a0; a1], ..., [a0; a1, ..., an] as ℚ. They're calculated with h(n) = a(n)*h(n-1) + h(n-2)
+// and k(n) = a(n)*k(n-1) + k(n-2), starting from h(-1)/k(-1) = 1/0 and h(-2)/k(-2) = 0/1. Each convergent is the
+// best approximation of the value with its denominator or smaller (convergents are always in lowest terms).
+func (cf *ContinuedFraction) Convergents() ([]*Q, error) {
+	res := make([]*Q, 0, len(cf.terms)+1)
+	var h1, h2 int64 = 1, 0
+	var k1, k2 int64 = 0, 1
+	for i := -1; i < len(cf.terms); i++ {
+		a, e := cf.term(i)
+		if e != nil {
+			return nil, e
+		}
+		h, e := mulInt64(a, h1)
+		if e != nil {
+			return nil, e
+		}
+		if h, e = addInt64(h, h2); e != nil {
+			return nil, e
+		}
+		k, e := mulInt64(a, k1)
+		if e != nil {
+			return nil, e
+		}
+		if k, e = addInt64(k, k2); e != nil {
+			return nil, e
+		}
+		h1, h2 = h, h1
+		k1, k2 = k, k1
+		res = append(res, &Q{a: h, b: k})
+	}
+	return res, nil
+}
+
+// BestApproximation returns the closest ℚ to the continued fraction with denominator not greater than maxDenominator
+// (like 355/113 for π and 113).
+//
+// The result is either the last convergent h(n)/k(n) with k(n) <= maxDenominator or the semiconvergent
+// (h(n-1) + m*h(n)) / (k(n-1) + m*k(n)) with the biggest m, for which the denominator is still small enough.
+func (cf *ContinuedFraction) BestApproximation(maxDenominator *N) (*Q, error) {
+	if maxDenominator.value == 0 {
+		return nil, errors.New("maximal denominator has to be positive")
+	}
+	maxDen := int64(min(maxDenominator.value, math.MaxInt64))
+	var h1, h2 int64 = 1, 0
+	var k1, k2 int64 = 0, 1
+	i := -1
+	for ; i < len(cf.terms); i++ {
+		a, e := cf.term(i)
+		if e != nil {
+			return nil, e
+		}
+		if k1 > 0 && a > (maxDen-k2)/k1 {
+			// k(n) would be too big
+			break
+		}
+		h, e := mulInt64(a, h1)
+		if e != nil {
+			return nil, e
+		}
+		if h, e = addInt64(h, h2); e != nil {
+			return nil, e
+		}
+		h1, h2 = h, h1
+		k1, k2 = a*k1+k2, k1
+	}
+	if i == len(cf.terms) {
+		// the value itself is good enough
+		return &Q{a: h1, b: k1}, nil
+	}
+
+	m := (maxDen - k2) / k1
+	hm, e := mulInt64(m, h1)
+	if e != nil {
+		return nil, e
+	}
+	if hm, e = addInt64(hm, h2); e != nil {
+		return nil, e
+	}
+	semi := new(big.Rat).SetFrac64(hm, m*k1+k2)
+	conv := new(big.Rat).SetFrac64(h1, k1)
+	x := cf.rat()
+	if new(big.Rat).Abs(new(big.Rat).Sub(semi, x)).Cmp(new(big.Rat).Abs(new(big.Rat).Sub(conv, x))) < 0 {
+		return reduceQ(hm, m*k1+k2)
+	}
+	return &Q{a: h1, b: k1}, nil
+}
+
+// term returns a(i+1) - a0 for i = -1 - as int64
+func (cf *ContinuedFraction) term(i int) (int64, error) {
+	if i < 0 {
+		return cf.a0.value, nil
+	}
+	if cf.terms[i].value > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(cf.terms[i].value), nil
+}
+
+// rat returns exact value of the continued fraction, which may not fit ℚ
+func (cf *ContinuedFraction) rat() *big.Rat {
+	res := new(big.Rat)
+	for i := len(cf.terms) - 1; i >= 0; i-- {
+		res.Add(res, new(big.Rat).SetUint64(cf.terms[i].value))
+		res.Inv(res)
+	}
+	return res.Add(res, new(big.Rat).SetInt64(cf.a0.value))
+}
+
+// String formats the continued fraction as "[2; 3, 4]" or "[2]"
+func (cf *ContinuedFraction) String() string {
+	if len(cf.terms) == 0 {
+		return fmt.Sprintf("[%s]", cf.a0)
+	}
+	terms := make([]string, len(cf.terms))
+	for i, t := range cf.terms {
+		terms[i] = t.String()
+	}
+	return fmt.Sprintf("[%s; %s]", cf.a0, strings.Join(terms, ", "))
+}
+
+var _ = fmt.Stringer(&ContinuedFraction{})
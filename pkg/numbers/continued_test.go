@@ -0,0 +1,180 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContinuedFraction(t *testing.T) {
+	for q, exp := range map[string]string{
+		"30/13":                 "[2; 3, 4]",
+		"415/93":                "[4; 2, 6, 7]",
+		"7/1":                   "[7]",
+		"0/1":                   "[0]",
+		"1/3":                   "[0; 3]",
+		"-7/3":                  "[-3; 1, 2]",
+		"-1/2":                  "[-1; 2]",
+		"355/113":               "[3; 7, 16]",
+		"13/8":                  "[1; 1, 1, 1, 2]",
+		"1/9223372036854775807": "[0; 9223372036854775807]",
+	} {
+		cf := NewQ(q).ContinuedFraction()
+		if cf.String() != exp {
+			t.Errorf("%s: expected %s, got %s", q, exp, cf)
+		}
+		r, e := cf.Q()
+		if e != nil || r.String() != NewQ(q).String() {
+			t.Errorf("%s: reconstructed %v, %v", q, r, e)
+		}
+	}
+}
+
+func TestNewContinuedFraction(t *testing.T) {
+	cf, e := NewContinuedFraction(NewZ("2"), NewN("3"), NewN("4"))
+	if e != nil || cf.String() != "[2; 3, 4]" {
+		t.Errorf("expected [2; 3, 4], got %v, %v", cf, e)
+	}
+	q, _ := cf.Q()
+	checkQ(t, "[2; 3, 4]", q, 30, 13)
+
+	// canonical form
+	cf, _ = NewContinuedFraction(NewZ("2"), NewN("3"), NewN("1"))
+	if cf.String() != "[2; 4]" {
+		t.Errorf("expected [2; 4], got %s", cf)
+	}
+	cf, _ = NewContinuedFraction(NewZ("2"), NewN("1"))
+	if cf.String() != "[3]" {
+		t.Errorf("expected [3], got %s", cf)
+	}
+
+	if _, e = NewContinuedFraction(NewZ("2"), NewN("0")); e == nil {
+		t.Errorf("expected error for zero term")
+	}
+
+	// arguments are copied
+	terms := []*N{NewN("3"), NewN("4")}
+	cf, _ = NewContinuedFraction(NewZ("2"), terms...)
+	terms[0] = NewN("5")
+	_, got := cf.Terms()
+	got[1] = NewN("6")
+	if cf.String() != "[2; 3, 4]" {
+		t.Errorf("continued fraction changed: %s", cf)
+	}
+
+	cf, _ = NewContinuedFraction(NewZ("0"), NFromUint64(math.MaxUint64), NewN("2"))
+	if _, e = cf.Q(); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+// first terms of continued fraction of π
+func piCF(t *testing.T) *ContinuedFraction {
+	terms := make([]*N, 0)
+	for _, a := range []uint64{7, 15, 1, 292, 1, 1, 1, 2, 1, 3, 1, 14} {
+		terms = append(terms, NFromUint64(a))
+	}
+	cf, e := NewContinuedFraction(NewZ("3"), terms...)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return cf
+}
+
+func TestConvergents(t *testing.T) {
+	cs, e := piCF(t).Convergents()
+	if e != nil {
+		t.Fatal(e)
+	}
+	exp := []string{"3/1", "22/7", "333/106", "355/113", "103993/33102", "104348/33215"}
+	for i, c := range exp {
+		if cs[i].String() != c {
+			t.Errorf("convergent %d: expected %s, got %s", i, c, cs[i])
+		}
+	}
+	cs, _ = NewQ("-7/3").ContinuedFraction().Convergents()
+	if len(cs) != 3 || cs[0].String() != "-3/1" || cs[1].String() != "-2/1" || cs[2].String() != "-7/3" {
+		t.Errorf("expected -3/1, -2/1, -7/3, got %v", cs)
+	}
+}
+
+func TestBestApproximation(t *testing.T) {
+	for maxDen, exp := range map[uint64]string{
+		1:      "3/1",
+		6:      "19/6",
+		7:      "22/7",
+		10:     "22/7",
+		100:    "311/99",
+		113:    "355/113",
+		1000:   "355/113",
+		100000: "312689/99532",
+	} {
+		q, e := piCF(t).BestApproximation(NFromUint64(maxDen))
+		if e != nil || q.String() != exp {
+			t.Errorf("π with max denominator %d: expected %s, got %v, %v", maxDen, exp, q, e)
+		}
+	}
+	for _, c := range []struct {
+		q      string
+		maxDen uint64
+		exp    string
+	}{
+		{"3/8", 100, "3/8"},
+		{"3/8", 3, "1/3"},
+		{"-3/8", 3, "-1/3"},
+		{"1/4", 3, "1/3"},
+		{"5/1", 1, "5/1"},
+		{"1/1000", 10, "0/1"},
+	} {
+		q, e := NewQ(c.q).ContinuedFraction().BestApproximation(NFromUint64(c.maxDen))
+		if e != nil || q.String() != c.exp {
+			t.Errorf("%s with max denominator %d: expected %s, got %v, %v", c.q, c.maxDen, c.exp, q, e)
+		}
+	}
+	if _, e := piCF(t).BestApproximation(&ZERO); e == nil {
+		t.Errorf("expected error for zero denominator")
+	}
+}
+
+func TestLimitDenominator(t *testing.T) {
+	for _, c := range []struct {
+		q      string
+		maxDen uint64
+		exp    string
+	}{
+		{"3141592653589793/1000000000000000", 10, "22/7"},
+		{"3141592653589793/1000000000000000", 100, "311/99"},
+		{"3141592653589793/1000000000000000", 1000, "355/113"},
+		{"-3141592653589793/1000000000000000", 1000, "-355/113"},
+		{"1/3", 3, "1/3"},
+		{"1/3", 2, "1/2"},
+		{"4/7", 1, "1/1"},
+		{"123/1", 1, "123/1"},
+		{"7/1000", 100, "1/100"},
+	} {
+		q, e := NewQ(c.q).LimitDenominator(NFromUint64(c.maxDen))
+		if e != nil || q.String() != c.exp {
+			t.Errorf("%s with max denominator %d: expected %s, got %v, %v", c.q, c.maxDen, c.exp, q, e)
+		}
+	}
+	if _, e := OneQ().LimitDenominator(&ZERO); e == nil {
+		t.Errorf("expected error for zero denominator")
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxDecimalScale is the maximal number of decimal places of Decimal - 10^scale has to fit int64
+const MaxDecimalScale = 18
+
+// Decimal is a fixed-point decimal number mantissa / 10^scale, for money-like computations where fixed number of
+// decimal places is required. Just like other numbers, values of Decimal are immutable. Add, Subtract and Multiply are
+// exact (result has as many decimal places as needed) and only Divide requires rounding.
+type Decimal struct {
+	mantissa *Z
+	scale    int
+
+	fmt.Stringer
+}
+
+// NewDecimal creates new Decimal from string like "-12.340" - the scale is the number of given decimal places (3)
+func NewDecimal(v string) (*Decimal, error) {
+	s := v
+	sign := ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		sign = s[:1]
+		s = s[1:]
+	}
+	integer, frac, _ := strings.Cut(s, ".")
+	if integer == "" || !allDigits(integer) || !allDigits(frac) {
+		return nil, fmt.Errorf("invalid decimal number %q", v)
+	}
+	if len(frac) > MaxDecimalScale {
+		return nil, fmt.Errorf("too many decimal places in %q", v)
+	}
+	m, e := strconv.ParseInt(sign+integer+frac, 10, 64)
+	if e != nil {
+		return nil, ErrOverflow
+	}
+	return &Decimal{mantissa: ZFromInt64(m), scale: len(frac)}, nil
+}
+
+// DecimalFromZ creates mantissa / 10^scale
+func DecimalFromZ(mantissa *Z, scale int) (*Decimal, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return nil, fmt.Errorf("scale has to be between 0 and %d", MaxDecimalScale)
+	}
+	return &Decimal{mantissa: mantissa, scale: scale}, nil
+}
+
+// Mantissa returns the integer value of d without decimal point
+func (d *Decimal) Mantissa() *Z {
+	return d.mantissa
+}
+
+// Scale returns the number of decimal places of d
+func (d *Decimal) Scale() int {
+	return d.scale
+}
+
+// Add returns d + arg with the bigger of both scales
+func (d *Decimal) Add(arg *Decimal) (*Decimal, error) {
+	a, b, scale, e := align(d, arg)
+	if e != nil {
+		return nil, e
+	}
+	m, e := addInt64(a, b)
+	if e != nil {
+		return nil, e
+	}
+	return &Decimal{mantissa: ZFromInt64(m), scale: scale}, nil
+}
+
+// Subtract returns d - arg with the bigger of both scales
+func (d *Decimal) Subtract(arg *Decimal) (*Decimal, error) {
+	a, b, scale, e := align(d, arg)
+	if e != nil {
+		return nil, e
+	}
+	if b, e = mulInt64(b, -1); e != nil {
+		return nil, e
+	}
+	m, e := addInt64(a, b)
+	if e != nil {
+		return nil, e
+	}
+	return &Decimal{mantissa: ZFromInt64(m), scale: scale}, nil
+}
+
+// Multiply returns d * arg with the sum of both scales
+func (d *Decimal) Multiply(arg *Decimal) (*Decimal, error) {
+	scale := d.scale + arg.scale
+	if scale > MaxDecimalScale {
+		return nil, ErrOverflow
+	}
+	m, e := mulInt64(d.mantissa.value, arg.mantissa.value)
+	if e != nil {
+		return nil, e
+	}
+	return &Decimal{mantissa: ZFromInt64(m), scale: scale}, nil
+}
+
+// Divide returns d / arg with given scale, rounded using given mode
+func (d *Decimal) Divide(arg *Decimal, scale int, mode RoundingMode) (*Decimal, error) {
+	if arg.mantissa.value == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	if scale < 0 || scale > MaxDecimalScale {
+		return nil, fmt.Errorf("scale has to be between 0 and %d", MaxDecimalScale)
+	}
+	// (A / 10^a) / (B / 10^b) * 10^scale = (A * 10^(b + scale)) / (B * 10^a)
+	n := new(big.Int).Mul(big.NewInt(d.mantissa.value), pow10(arg.scale+scale))
+	den := new(big.Int).Mul(big.NewInt(arg.mantissa.value), pow10(d.scale))
+	if den.Sign() < 0 {
+		n.Neg(n)
+		den.Neg(den)
+	}
+	return decimalFromBig(roundDiv(n, den, mode), scale)
+}
+
+// Rescale returns d with given number of decimal places, rounded using given mode if needed
+func (d *Decimal) Rescale(scale int, mode RoundingMode) (*Decimal, error) {
+	return d.Divide(&Decimal{mantissa: OneZ(), scale: 0}, scale, mode)
+}
+
+// Q returns d as exact ℚ
+func (d *Decimal) Q() *Q {
+	return mustQ(reduceQ(d.mantissa.value, pow10(d.scale).Int64()))
+}
+
+// Compare returns -1 if d < arg, 0 if d = arg and +1 if d > arg. Scale doesn't matter - 1.50 = 1.5.
+func (d *Decimal) Compare(arg *Decimal) int {
+	a := new(big.Int).Mul(big.NewInt(d.mantissa.value), pow10(arg.scale))
+	b := new(big.Int).Mul(big.NewInt(arg.mantissa.value), pow10(d.scale))
+	return a.Cmp(b)
+}
+
+// String formats d with all its decimal places: "-12.340"
+func (d *Decimal) String() string {
+	v := d.mantissa.value
+	digits := strconv.FormatInt(v, 10)
+	sign := ""
+	if v < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	i := len(digits) - d.scale
+	return sign + digits[:i] + "." + digits[i:]
+}
+
+// align returns mantissas of a and b scaled to the same number of decimal places
+func align(a *Decimal, b *Decimal) (int64, int64, int, error) {
+	scale := max(a.scale, b.scale)
+	am, e := mulInt64(a.mantissa.value, pow10(scale-a.scale).Int64())
+	if e != nil {
+		return 0, 0, 0, e
+	}
+	bm, e := mulInt64(b.mantissa.value, pow10(scale-b.scale).Int64())
+	if e != nil {
+		return 0, 0, 0, e
+	}
+	return am, bm, scale, nil
+}
+
+// decimalFromBig creates Decimal checking if the mantissa fits int64
+func decimalFromBig(m *big.Int, scale int) (*Decimal, error) {
+	if !m.IsInt64() {
+		return nil, ErrOverflow
+	}
+	return &Decimal{mantissa: ZFromInt64(m.Int64()), scale: scale}, nil
+}
+
+var _ = fmt.Stringer(&Decimal{})
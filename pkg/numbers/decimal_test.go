@@ -0,0 +1,145 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func dec(t *testing.T, v string) *Decimal {
+	d, e := NewDecimal(v)
+	if e != nil {
+		t.Fatalf("%s: %v", v, e)
+	}
+	return d
+}
+
+func checkDecimal(t *testing.T, label string, d *Decimal, e error, exp string) {
+	if e != nil {
+		t.Errorf("%s: unexpected error: %v", label, e)
+	} else if d.String() != exp {
+		t.Errorf("%s: expected %s, got %s", label, exp, d)
+	}
+}
+
+func TestNewDecimal(t *testing.T) {
+	for v, exp := range map[string]string{
+		"12.340": "12.340",
+		"-0.05":  "-0.05",
+		"+7":     "7",
+		"0.000":  "0.000",
+		"-1.5":   "-1.5",
+		"3.":     "3",
+	} {
+		d, e := NewDecimal(v)
+		checkDecimal(t, v, d, e, exp)
+	}
+	if d := dec(t, "12.340"); d.Scale() != 3 || d.Mantissa().Int64() != 12340 {
+		t.Errorf("expected 12340 with scale 3, got %s with scale %d", d.Mantissa(), d.Scale())
+	}
+	for _, v := range []string{"", ".5", "1.2.3", "1e5", "-", "1,5", "0.1234567890123456789"} {
+		if _, e := NewDecimal(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+	if _, e := NewDecimal("9223372036854775808"); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := DecimalFromZ(OneZ(), MaxDecimalScale+1); e == nil {
+		t.Errorf("expected error for too big scale")
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	d, e := dec(t, "1.10").Add(dec(t, "2.205"))
+	checkDecimal(t, "1.10 + 2.205", d, e, "3.305")
+	d, e = dec(t, "1.10").Subtract(dec(t, "2.205"))
+	checkDecimal(t, "1.10 - 2.205", d, e, "-1.105")
+	d, e = dec(t, "1.10").Multiply(dec(t, "-2.5"))
+	checkDecimal(t, "1.10 * -2.5", d, e, "-2.750")
+	d, e = dec(t, "0.1").Add(dec(t, "0.2"))
+	checkDecimal(t, "0.1 + 0.2", d, e, "0.3")
+
+	if _, e = dec(t, "9223372036854775807").Add(dec(t, "1")); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e = dec(t, "0.000000001").Multiply(dec(t, "0.0000000001")); e != ErrOverflow {
+		t.Errorf("expected overflow of scale, got %v", e)
+	}
+}
+
+func TestDecimalDivide(t *testing.T) {
+	for _, c := range []struct {
+		a, b  string
+		scale int
+		mode  RoundingMode
+		exp   string
+	}{
+		{"10", "3", 2, RoundHalfUp, "3.33"},
+		{"20", "3", 2, RoundHalfUp, "6.67"},
+		{"20", "3", 2, RoundDown, "6.66"},
+		{"-20", "3", 2, RoundFloor, "-6.67"},
+		{"-20", "3", 2, RoundCeiling, "-6.66"},
+		{"2.5", "1", 0, RoundHalfEven, "2"},
+		{"3.5", "1", 0, RoundHalfEven, "4"},
+		{"2.5", "-1", 0, RoundHalfDown, "-2"},
+		{"1", "8", 3, RoundUp, "0.125"},
+		{"1.00", "0.25", 1, RoundDown, "4.0"},
+	} {
+		d, e := dec(t, c.a).Divide(dec(t, c.b), c.scale, c.mode)
+		checkDecimal(t, c.a+" / "+c.b+" "+c.mode.String(), d, e, c.exp)
+	}
+	if _, e := dec(t, "1").Divide(dec(t, "0.00"), 2, RoundHalfUp); e == nil {
+		t.Errorf("expected error when dividing by ZERO")
+	}
+	if _, e := dec(t, "1").Divide(dec(t, "3"), -1, RoundHalfUp); e == nil {
+		t.Errorf("expected error for negative scale")
+	}
+	if _, e := dec(t, "9223372036854775807").Divide(dec(t, "0.1"), 0, RoundHalfUp); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+
+	d, e := dec(t, "2.345").Rescale(2, RoundHalfEven)
+	checkDecimal(t, "rescale 2.345", d, e, "2.34")
+	d, e = dec(t, "2.3").Rescale(3, RoundHalfEven)
+	checkDecimal(t, "rescale 2.3", d, e, "2.300")
+}
+
+func TestDecimalConversions(t *testing.T) {
+	checkQ(t, "-12.340", dec(t, "-12.340").Q(), -617, 50)
+	if dec(t, "1.50").Compare(dec(t, "1.5")) != 0 {
+		t.Errorf("1.50 should be equal to 1.5")
+	}
+	if dec(t, "-1.51").Compare(dec(t, "-1.5")) != -1 || dec(t, "2").Compare(dec(t, "1.99")) != 1 {
+		t.Errorf("wrong order")
+	}
+	if s := dec(t, "-0.05").String(); s != "-0.05" {
+		t.Errorf("expected -0.05, got %s", s)
+	}
+}
+
+func TestImmutabilityDecimal(t *testing.T) {
+	a, b := dec(t, "1.5"), dec(t, "2.25")
+	_, _ = a.Add(b)
+	_, _ = a.Multiply(b)
+	_, _ = a.Divide(b, 4, RoundHalfUp)
+	if a.String() != "1.5" || b.String() != "2.25" {
+		t.Errorf("arguments changed: %s, %s", a, b)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"iter"
+	"math/bits"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// Digits returns lazy iterator over decimal digits of |q| after the decimal point - 1/7 is 1, 4, 2, 8, 5, 7, 1, ...
+// The iteration stops when the expansion is finite (1/8 is 1, 2, 5), otherwise it never ends. The state is just the
+// remainder of long division, so any number of digits can be produced.
+func (q *Q) Digits() iter.Seq[int] {
+	a, b := q.Ratio()
+	ua := modarith.AbsUint64(a)
+	ub := uint64(b)
+	return func(yield func(int) bool) {
+		for rem := ua % ub; rem != 0; {
+			hi, lo := bits.Mul64(rem, 10)
+			var d uint64
+			d, rem = bits.Div64(hi, lo, ub)
+			if !yield(int(d)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDigits(t *testing.T) {
+	for q, exp := range map[string]string{
+		"1/8":                   "125",
+		"-1/8":                  "125",
+		"22/7":                  "142857142857",
+		"1/3":                   "333333333333",
+		"5/1":                   "",
+		"0/1":                   "",
+		"1/1024":                "0009765625",
+		"1/9223372036854775807": "000000000000",
+	} {
+		s := ""
+		for d := range NewQ(q).Digits() {
+			s += strconv.Itoa(d)
+			if len(s) == 12 {
+				break
+			}
+		}
+		if s != exp {
+			t.Errorf("%s: expected %s, got %s", q, exp, s)
+		}
+	}
+
+	// many digits without building the whole expansion - period of 1/97 has 96 digits
+	count, sum := 0, 0
+	for d := range NewQ("1/97").Digits() {
+		sum += d
+		if count++; count == 96*1000 {
+			break
+		}
+	}
+	if sum != 432*1000 {
+		t.Errorf("expected sum of digits %d, got %d", 432*1000, sum)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"iter"
+	"math"
+)
+
+// FareySequence returns lazy iterator over Farey sequence of order n - all ℚ in lowest terms from 0/1 to 1/1 with
+// denominator not greater than n, in increasing order.
+//
+// Consecutive A/B, C/D of the sequence are neighbours (BC - AD = 1) and the next one is E/F = (kC - A) / (kD - B) for
+// k = floor((n + B) / D), so each element is created in constant time.
+func FareySequence(n *N) (iter.Seq[*Q], error) {
+	if n.value == 0 {
+		return nil, errors.New("order of Farey sequence has to be positive")
+	}
+	if n.value > math.MaxInt64/2 {
+		// k*D <= n + B
+		return nil, ErrOverflow
+	}
+	order := int64(n.value)
+	return func(yield func(*Q) bool) {
+		var a, b, c, d int64 = 0, 1, 1, order
+		if !yield(&Q{a: a, b: b}) {
+			return
+		}
+		for c <= order {
+			if !yield(&Q{a: c, b: d}) {
+				return
+			}
+			k := (order + b) / d
+			a, b, c, d = c, d, k*c-a, k*d-b
+		}
+	}, nil
+}
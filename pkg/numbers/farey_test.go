@@ -0,0 +1,75 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFareySequence(t *testing.T) {
+	for n, exp := range map[uint64]string{
+		1: "0/1 1/1",
+		2: "0/1 1/2 1/1",
+		5: "0/1 1/5 1/4 1/3 2/5 1/2 3/5 2/3 3/4 4/5 1/1",
+	} {
+		seq, e := FareySequence(NFromUint64(n))
+		if e != nil {
+			t.Fatal(e)
+		}
+		var s []string
+		for q := range seq {
+			s = append(s, q.String())
+		}
+		if strings.Join(s, " ") != exp {
+			t.Errorf("F%d: expected %s, got %s", n, exp, strings.Join(s, " "))
+		}
+	}
+
+	// |F(n)| = 1 + φ(1) + ... + φ(n), |F(100)| = 3045
+	seq, _ := FareySequence(NewN("100"))
+	count := 0
+	var prev *Q
+	for q := range seq {
+		if prev != nil && prev.Compare(q) >= 0 {
+			t.Errorf("%s should be smaller than %s", prev, q)
+		}
+		prev = q
+		count++
+	}
+	if count != 3045 {
+		t.Errorf("expected 3045 elements, got %d", count)
+	}
+
+	// lazy - huge order, but only first elements are created
+	seq, _ = FareySequence(NewN("1000000000000"))
+	for q := range seq {
+		if q.a != 0 {
+			checkQ(t, "second element", q, 1, 1000000000000)
+			break
+		}
+	}
+
+	if _, e := FareySequence(&ZERO); e == nil {
+		t.Errorf("expected error for order 0")
+	}
+	if _, e := FareySequence(NewN("9223372036854775807")); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"iter"
+	"math/big"
+)
+
+// GosperMaxInput is the maximal number of input terms Gosper consumes without producing the next output term. Some
+// results can never be determined from infinite inputs - like √2 * √2, which would have to be compared with 2 exactly.
+const GosperMaxInput = 1000
+
+// All returns the terms of the continued fraction - a0 and then positive a1, ..., an
+func (cf *ContinuedFraction) All() iter.Seq[*Z] {
+	return func(yield func(*Z) bool) {
+		if !yield(cf.a0) {
+			return
+		}
+		for _, t := range cf.terms {
+			if !yield(ZFromInt64(int64(t.value))) {
+				return
+			}
+		}
+	}
+}
+
+// PeriodicContinuedFraction returns infinite terms [a0; p1, ..., pr, p1, ..., pr, ...] - like √d returned by
+// SqrtContinuedFraction
+func PeriodicContinuedFraction(a0 *Z, period []*N) iter.Seq[*Z] {
+	period = append([]*N(nil), period...)
+	return func(yield func(*Z) bool) {
+		if !yield(a0) {
+			return
+		}
+		for i := 0; len(period) > 0; i = (i + 1) % len(period) {
+			if !yield(ZFromInt64(int64(period[i].value))) {
+				return
+			}
+		}
+	}
+}
+
+// AddCF returns lazy continued fraction of x + y
+func AddCF(x, y iter.Seq[*Z]) iter.Seq2[*Z, error] {
+	return Gosper(x, y, [8]int64{0, 1, 1, 0, 0, 0, 0, 1})
+}
+
+// SubtractCF returns lazy continued fraction of x - y
+func SubtractCF(x, y iter.Seq[*Z]) iter.Seq2[*Z, error] {
+	return Gosper(x, y, [8]int64{0, 1, -1, 0, 0, 0, 0, 1})
+}
+
+// MultiplyCF returns lazy continued fraction of x * y
+func MultiplyCF(x, y iter.Seq[*Z]) iter.Seq2[*Z, error] {
+	return Gosper(x, y, [8]int64{1, 0, 0, 0, 0, 0, 0, 1})
+}
+
+// DivideCF returns lazy continued fraction of x / y
+func DivideCF(x, y iter.Seq[*Z]) iter.Seq2[*Z, error] {
+	return Gosper(x, y, [8]int64{0, 1, 0, 0, 0, 0, 1, 0})
+}
+
+// Gosper returns lazy continued fraction of z = (axy + bx + cy + d) / (exy + fx + gy + h), where x and y are given as
+// (possibly infinite) continued fractions and coefficients are {a, b, c, d, e, f, g, h}. The terms of the result are
+// produced one by one, consuming only as many terms of x and y as needed. Iteration stops after the last term or with
+// an error - when the term doesn't fit int64 or can't be determined after GosperMaxInput input terms.
+//
+// Input term p of x = p + 1/x' changes z to ((ap + c)x'y + (bp + d)x' + ay + b) / ((ep + g)x'y + (fp + h)x' + ey + f)
+// (similarly for y) and when x ends, x = ∞ and z = (ay + b) / (ey + f). When x, y >= 1 (after their first terms),
+// z is between a/e, b/f, c/g and d/h (if the denominators have the same sign) and if all these have the same
+// integer part r, it's the next term: z = r + 1/z', z' = (exy + fx + gy + h) / ((a - re)xy + ... + (d - rh)).
+func Gosper(x, y iter.Seq[*Z], coefficients [8]int64) iter.Seq2[*Z, error] {
+	return func(yield func(*Z, error) bool) {
+		nextX, stopX := iter.Pull(x)
+		defer stopX()
+		nextY, stopY := iter.Pull(y)
+		defer stopY()
+
+		var s gosperState
+		for i, c := range coefficients {
+			s.m[i] = big.NewInt(c)
+		}
+		xState, yState := gosperUnstarted, gosperUnstarted
+		input := 0
+		for {
+			if xState != gosperUnstarted && yState != gosperUnstarted {
+				r, done, ok := s.term(xState == gosperActive, yState == gosperActive)
+				if done {
+					return
+				}
+				if ok {
+					if !r.IsInt64() {
+						yield(nil, ErrOverflow)
+						return
+					}
+					if !yield(ZFromInt64(r.Int64()), nil) {
+						return
+					}
+					s.output(r)
+					input = 0
+					continue
+				}
+			}
+
+			if input == GosperMaxInput {
+				yield(nil, fmt.Errorf("next term not determined after %d input terms", GosperMaxInput))
+				return
+			}
+			input++
+			// alternating x and y, unless one of them is ended
+			if xState != gosperEnded && (yState == gosperEnded || input%2 == 1 || xState == gosperUnstarted) {
+				if t, ok := nextX(); ok {
+					s.ingestX(big.NewInt(t.value))
+					xState = gosperActive
+				} else {
+					s.endX()
+					xState = gosperEnded
+				}
+			} else {
+				if t, ok := nextY(); ok {
+					s.ingestY(big.NewInt(t.value))
+					yState = gosperActive
+				} else {
+					s.endY()
+					yState = gosperEnded
+				}
+			}
+		}
+	}
+}
+
+const (
+	gosperUnstarted = iota
+	gosperActive
+	gosperEnded
+)
+
+// gosperState keeps coefficients {a, b, c, d, e, f, g, h} of (axy + bx + cy + d) / (exy + fx + gy + h)
+type gosperState struct {
+	m [8]*big.Int
+}
+
+// ingestX replaces x with p + 1/x
+func (s *gosperState) ingestX(p *big.Int) {
+	for i := 0; i < 8; i += 4 {
+		a, b, c, d := s.m[i], s.m[i+1], s.m[i+2], s.m[i+3]
+		s.m[i] = new(big.Int).Add(new(big.Int).Mul(a, p), c)
+		s.m[i+1] = new(big.Int).Add(new(big.Int).Mul(b, p), d)
+		s.m[i+2], s.m[i+3] = a, b
+	}
+}
+
+// ingestY replaces y with p + 1/y
+func (s *gosperState) ingestY(p *big.Int) {
+	for i := 0; i < 8; i += 4 {
+		a, b, c, d := s.m[i], s.m[i+1], s.m[i+2], s.m[i+3]
+		s.m[i] = new(big.Int).Add(new(big.Int).Mul(a, p), b)
+		s.m[i+1] = a
+		s.m[i+2] = new(big.Int).Add(new(big.Int).Mul(c, p), d)
+		s.m[i+3] = c
+	}
+}
+
+// endX replaces x with ∞
+func (s *gosperState) endX() {
+	for i := 0; i < 8; i += 4 {
+		s.m[i], s.m[i+1], s.m[i+2], s.m[i+3] = new(big.Int), new(big.Int), s.m[i], s.m[i+1]
+	}
+}
+
+// endY replaces y with ∞
+func (s *gosperState) endY() {
+	for i := 0; i < 8; i += 4 {
+		s.m[i], s.m[i+1], s.m[i+2], s.m[i+3] = new(big.Int), s.m[i], new(big.Int), s.m[i+2]
+	}
+}
+
+// term returns the next term if it's already determined. done is true if z = ∞ - there are no more terms.
+func (s *gosperState) term(xActive bool, yActive bool) (r *big.Int, done bool, ok bool) {
+	// corners of the (x, y) region, which still matter: a/e, b/f, c/g, d/h
+	corners := []int{3}
+	if xActive {
+		corners = append(corners, 1)
+	}
+	if yActive {
+		corners = append(corners, 2)
+	}
+	if xActive && yActive {
+		corners = append(corners, 0)
+	}
+	zero, sign := true, 0
+	for _, i := range corners {
+		ds := s.m[i+4].Sign()
+		if ds != 0 {
+			zero = false
+		}
+		if ds == 0 || sign != 0 && ds != sign {
+			sign = 2
+		} else if sign == 0 {
+			sign = ds
+		}
+	}
+	if zero {
+		return nil, true, false
+	}
+	if sign == 2 {
+		// z may be infinite
+		return nil, false, false
+	}
+	for _, i := range corners {
+		q := floorDiv(s.m[i], s.m[i+4])
+		if r == nil {
+			r = q
+		} else if r.Cmp(q) != 0 {
+			return nil, false, false
+		}
+	}
+	return r, false, true
+}
+
+// output replaces z with r + 1/z
+func (s *gosperState) output(r *big.Int) {
+	for i := 0; i < 4; i++ {
+		num, den := s.m[i], s.m[i+4]
+		s.m[i] = den
+		s.m[i+4] = new(big.Int).Sub(num, new(big.Int).Mul(r, den))
+	}
+}
+
+// floorDiv returns floor(n / d) for d != 0
+func floorDiv(n *big.Int, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() != 0 && r.Sign() != d.Sign() {
+		q.Sub(q, big.NewInt(1))
+	}
+	return q
+}
@@ -0,0 +1,105 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"iter"
+	"strings"
+	"testing"
+)
+
+// take returns first n terms of lazy continued fraction (or less if it's finite) as "[a0; a1, ...]"
+func take(t *testing.T, cf iter.Seq2[*Z, error], n int) string {
+	var terms []string
+	for term, e := range cf {
+		if e != nil {
+			t.Errorf("unexpected error: %v", e)
+			break
+		}
+		terms = append(terms, term.String())
+		if len(terms) == n {
+			break
+		}
+	}
+	if len(terms) < 2 {
+		return "[" + strings.Join(terms, "") + "]"
+	}
+	return "[" + terms[0] + "; " + strings.Join(terms[1:], ", ") + "]"
+}
+
+func sqrtCF(t *testing.T, d string) iter.Seq[*Z] {
+	a0, period, e := SqrtContinuedFraction(NewN(d))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return PeriodicContinuedFraction(DefZ(a0, &ZERO), period)
+}
+
+func TestGosperRational(t *testing.T) {
+	for _, c := range []struct {
+		op   func(x, y iter.Seq[*Z]) iter.Seq2[*Z, error]
+		x, y string
+		exp  string
+	}{
+		{AddCF, "1/2", "1/3", "5/6"},
+		{AddCF, "-7/3", "30/13", "-1/39"},
+		{SubtractCF, "1/2", "1/2", "0/1"},
+		{SubtractCF, "1/2", "1/3", "1/6"},
+		{MultiplyCF, "30/13", "2/1", "60/13"},
+		{MultiplyCF, "-3/4", "4/3", "-1/1"},
+		{DivideCF, "30/13", "-3/7", "-70/13"},
+		{DivideCF, "355/113", "1/1", "355/113"},
+	} {
+		exp := NewQ(c.exp).ContinuedFraction().String()
+		got := take(t, c.op(NewQ(c.x).ContinuedFraction().All(), NewQ(c.y).ContinuedFraction().All()), 100)
+		if got != exp {
+			t.Errorf("%s, %s: expected %s, got %s", c.x, c.y, exp, got)
+		}
+	}
+}
+
+func TestGosperInfinite(t *testing.T) {
+	// √2 + √2 = √8 = [2; 1, 4, 1, 4, ...]
+	if s := take(t, AddCF(sqrtCF(t, "2"), sqrtCF(t, "2")), 7); s != "[2; 1, 4, 1, 4, 1, 4]" {
+		t.Errorf("√2 + √2: expected [2; 1, 4, 1, 4, 1, 4], got %s", s)
+	}
+	// √2 + 1 = [2; 2, 2, ...]
+	if s := take(t, AddCF(sqrtCF(t, "2"), OneQ().ContinuedFraction().All()), 5); s != "[2; 2, 2, 2, 2]" {
+		t.Errorf("√2 + 1: expected [2; 2, 2, 2, 2], got %s", s)
+	}
+	// √2 * √3 = √6 = [2; 2, 4, 2, 4, ...]
+	if s := take(t, MultiplyCF(sqrtCF(t, "2"), sqrtCF(t, "3")), 5); s != "[2; 2, 4, 2, 4]" {
+		t.Errorf("√2 * √3: expected [2; 2, 4, 2, 4], got %s", s)
+	}
+	// 1 / √2 = [0; 1, 2, 2, ...]
+	if s := take(t, DivideCF(OneQ().ContinuedFraction().All(), sqrtCF(t, "2")), 4); s != "[0; 1, 2, 2]" {
+		t.Errorf("1 / √2: expected [0; 1, 2, 2], got %s", s)
+	}
+
+	// √2 - √2 = 0 exactly, but it can't be determined from the terms
+	var err error
+	for _, e := range SubtractCF(sqrtCF(t, "2"), sqrtCF(t, "2")) {
+		if e != nil {
+			err = e
+		}
+	}
+	if err == nil {
+		t.Errorf("expected error for √2 - √2")
+	}
+}
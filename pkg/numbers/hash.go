@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// Key is a comparable representation of exact number, so it can be used as a key of Go map. Equal numbers have equal
+// keys, also across ℕ, ℤ and ℚ - NewN("2"), NewZ("2") and NewQ("4/2") all have the same Key.
+type Key struct {
+	neg bool
+	num uint64
+	den uint64
+}
+
+// Key returns comparable representation of n
+func (n *N) Key() Key {
+	return Key{num: n.value, den: 1}
+}
+
+// Key returns comparable representation of z
+func (z *Z) Key() Key {
+	if z.value < 0 {
+		return Key{neg: true, num: modarith.AbsUint64(z.value), den: 1}
+	}
+	return Key{num: uint64(z.value), den: 1}
+}
+
+// Key returns comparable representation of q - q is in lowest terms, so 2/4 and 1/2 have the same key
+func (q *Q) Key() Key {
+	a, b := q.Ratio()
+	if a < 0 {
+		return Key{neg: true, num: modarith.AbsUint64(a), den: uint64(b)}
+	}
+	return Key{num: uint64(a), den: uint64(b)}
+}
+
+// Hash returns 64-bit FNV-1a hash of k. It's stable - doesn't depend on the process or platform.
+func (k Key) Hash() uint64 {
+	var buf [17]byte
+	if k.neg {
+		buf[0] = 1
+	}
+	binary.BigEndian.PutUint64(buf[1:9], k.num)
+	binary.BigEndian.PutUint64(buf[9:], k.den)
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	return h.Sum64()
+}
+
+// Hash returns stable hash of n, equal to the hash of the same value in ℤ or ℚ
+func (n *N) Hash() uint64 {
+	return n.Key().Hash()
+}
+
+// Hash returns stable hash of z, equal to the hash of the same value in ℕ or ℚ
+func (z *Z) Hash() uint64 {
+	return z.Key().Hash()
+}
+
+// Hash returns stable hash of q, calculated from its canonical form
+func (q *Q) Hash() uint64 {
+	return q.Key().Hash()
+}
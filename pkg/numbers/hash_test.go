@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	if NewN("2").Key() != NewZ("2").Key() || NewZ("2").Key() != NewQ("4/2").Key() {
+		t.Errorf("2 should have the same key in ℕ, ℤ and ℚ")
+	}
+	if NewQ("2/4").Key() != NewQ("1/2").Key() {
+		t.Errorf("2/4 and 1/2 should have the same key")
+	}
+	if NewQ("1/-2").Key() != NewQ("-1/2").Key() {
+		t.Errorf("1/-2 and -1/2 should have the same key")
+	}
+	if NewZ("-2").Key() == NewZ("2").Key() || NewQ("1/2").Key() == NewQ("2/1").Key() {
+		t.Errorf("different numbers should have different keys")
+	}
+	if ZFromInt64(math.MinInt64).Key() == ZFromInt64(math.MaxInt64).Key() {
+		t.Errorf("MinInt64 and MaxInt64 should have different keys")
+	}
+
+	m := map[Key]string{}
+	m[NewQ("3/6").Key()] = "half"
+	if m[NewQ("-5/-10").Key()] != "half" {
+		t.Errorf("-5/-10 should be found as 3/6")
+	}
+}
+
+func TestHash(t *testing.T) {
+	if NewQ("2/4").Hash() != NewQ("1/2").Hash() {
+		t.Errorf("2/4 and 1/2 should have the same hash")
+	}
+	if NewN("7").Hash() != NewZ("7").Hash() || NewZ("7").Hash() != NewQ("7/1").Hash() {
+		t.Errorf("7 should have the same hash in ℕ, ℤ and ℚ")
+	}
+	if NewZ("-1").Hash() == NewZ("1").Hash() {
+		t.Errorf("-1 and 1 should have different hashes")
+	}
+	// stable across runs and platforms
+	if h := ZERO.Hash(); h != 5618887047209522668 {
+		t.Errorf("expected 5618887047209522668, got %d", h)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// DefaultHyperLimit is the number of steps Hyper can take
+const DefaultHyperLimit = 1_000_000
+
+// Hyper returns hyperoperation of given level - each level repeats the previous one b times:
+//
+//	level 0 - succession:     H0(a, b) = b + 1
+//	level 1 - addition:       H1(a, b) = a + b
+//	level 2 - multiplication: H2(a, b) = a * b
+//	level 3 - exponentiation: H3(a, b) = a^b
+//	level 4 - tetration:      H4(a, b) = a^a^...^a (b times)
+//	...
+//	H(n, a, b) = H(n - 1, a, H(n, a, b - 1)) and H(n, a, 0) = 1 for n >= 3
+//
+// The results quickly don't fit uint64 (3↑↑3 = 3^27, but 3↑↑4 has 3638334640025 digits), so ErrOverflow is returned.
+// Cases which don't overflow may still need many steps (0 and 1 are fixed points of many levels), so each
+// application of a lower level counts as a step and *LimitError is returned after DefaultHyperLimit steps.
+func Hyper(level *N, a *N, b *N) (*N, error) {
+	steps := 0
+	v, e := hyper(level.value, a.value, b.value, &steps)
+	if e != nil {
+		return nil, e
+	}
+	return NFromUint64(v), nil
+}
+
+// hyper is Hyper counting the steps
+func hyper(level uint64, a uint64, b uint64, steps *int) (uint64, error) {
+	if *steps++; *steps > DefaultHyperLimit {
+		return 0, &LimitError{Limit: DefaultHyperLimit}
+	}
+	switch {
+	case level == 0:
+		return addUint64(b, 1)
+	case level == 1:
+		return addUint64(a, b)
+	case level == 2:
+		return mulUint64(a, b)
+	case b == 0:
+		return 1, nil
+	case b == 1:
+		return a, nil
+	case a == 2 && b == 2:
+		// 2 + 2 = 2 * 2 = 2^2 = 2↑↑2 = ... = 4
+		return 4, nil
+	}
+	r := uint64(1)
+	for i := uint64(0); i < b; i++ {
+		next, e := hyper(level-1, a, r, steps)
+		if e != nil {
+			return 0, e
+		}
+		if next == r {
+			// a fixed point - like 1^1 = 1, it won't change anymore
+			break
+		}
+		r = next
+	}
+	return r, nil
+}
+
+// UpArrow is Knuth's up-arrow notation: a↑b = a^b, a↑↑b = a↑(a↑(...↑a)) (b times), a↑↑↑b = a↑↑(a↑↑(...↑↑a)), ...
+// k arrows mean hyperoperation of level k + 2.
+type UpArrow struct {
+	A      *N
+	Arrows int
+	B      *N
+}
+
+// ParseUpArrow parses Knuth's up-arrow notation "a↑↑b" - the arrows can also be written as "^" ("3^^4").
+func ParseUpArrow(v string) (*UpArrow, error) {
+	s := strings.ReplaceAll(strings.TrimSpace(v), "↑", "^")
+	i := strings.Index(s, "^")
+	j := strings.LastIndex(s, "^")
+	if i <= 0 || strings.Trim(s[i:j+1], "^") != "" {
+		return nil, fmt.Errorf("invalid up-arrow notation %q", v)
+	}
+	a, e := parseNatural(s[:i])
+	if e != nil {
+		return nil, fmt.Errorf("invalid up-arrow notation %q: %w", v, e)
+	}
+	b, e := parseNatural(s[j+1:])
+	if e != nil {
+		return nil, fmt.Errorf("invalid up-arrow notation %q: %w", v, e)
+	}
+	return &UpArrow{A: NFromUint64(a), Arrows: j - i + 1, B: NFromUint64(b)}, nil
+}
+
+// String returns the notation with "↑" arrows, like "3↑↑4"
+func (u *UpArrow) String() string {
+	return fmt.Sprintf("%s%s%s", u.A, strings.Repeat("↑", u.Arrows), u.B)
+}
+
+// Value returns a↑...↑b - Hyper of level Arrows + 2
+func (u *UpArrow) Value() (*N, error) {
+	if u.Arrows < 1 {
+		return nil, errors.New("up-arrow notation needs at least one arrow")
+	}
+	if uint64(u.Arrows) > math.MaxUint64-2 {
+		return nil, ErrOverflow
+	}
+	return Hyper(NFromUint64(uint64(u.Arrows)+2), u.A, u.B)
+}
@@ -0,0 +1,75 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHyper(t *testing.T) {
+	for _, c := range [][4]uint64{
+		{0, 3, 4, 5}, {1, 3, 4, 7}, {2, 3, 4, 12}, {3, 3, 4, 81}, {3, 2, 63, 1 << 63}, {4, 3, 3, 7625597484987},
+		{4, 2, 4, 65536}, {5, 2, 3, 65536}, {6, 2, 2, 4}, {1000, 2, 2, 4}, {4, 3, 0, 1}, {4, 3, 1, 3},
+		{3, 0, 0, 1}, {3, 0, 5, 0}, {4, 0, 1, 0}, {4, 0, 2, 1}, {4, 0, 3, 0},
+		{4, 1, 18446744073709551615, 1}, {1000000, 1, 1000, 1}, {3, 18446744073709551615, 1, 18446744073709551615},
+	} {
+		h, e := Hyper(NFromUint64(c[0]), NFromUint64(c[1]), NFromUint64(c[2]))
+		if e != nil || h.value != c[3] {
+			t.Errorf("H%d(%d, %d): expected %d, got %v, %v", c[0], c[1], c[2], c[3], h, e)
+		}
+	}
+	for _, c := range [][3]uint64{{3, 2, 64}, {4, 3, 4}, {5, 3, 3}, {4, 2, 5}, {2, 1 << 32, 1 << 32}} {
+		if _, e := Hyper(NFromUint64(c[0]), NFromUint64(c[1]), NFromUint64(c[2])); e != ErrOverflow {
+			t.Errorf("H%d(%d, %d): expected overflow, got %v", c[0], c[1], c[2], e)
+		}
+	}
+	// 0↑↑↑b alternates slowly
+	_, e := Hyper(NFromUint64(5), NFromUint64(0), NFromUint64(10000000))
+	var le *LimitError
+	if !errors.As(e, &le) {
+		t.Errorf("expected limit error, got %v", e)
+	}
+}
+
+func TestUpArrow(t *testing.T) {
+	for s, exp := range map[string]uint64{"3↑↑3": 7625597484987, "2↑4": 16, "2^^4": 65536, " 2↑↑↑3 ": 65536} {
+		u, e := ParseUpArrow(s)
+		if e != nil {
+			t.Errorf("%q: unexpected error %v", s, e)
+			continue
+		}
+		v, e := u.Value()
+		if e != nil || v.value != exp {
+			t.Errorf("%q: expected %d, got %v, %v", s, exp, v, e)
+		}
+	}
+	u, _ := ParseUpArrow("3^^^4")
+	if u.String() != "3↑↑↑4" || u.Arrows != 3 {
+		t.Errorf("unexpected %s", u)
+	}
+	for _, s := range []string{"", "3", "↑3", "3↑", "3↑x↑4", "a↑↑3", "3↑↑-1"} {
+		if _, e := ParseUpArrow(s); e == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+	if _, e := (&UpArrow{A: TwoN(), B: TwoN()}).Value(); e == nil {
+		t.Errorf("expected error for no arrows")
+	}
+}
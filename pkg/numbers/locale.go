@@ -0,0 +1,146 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// Locale describes how numbers are presented to end users: Grouping separates groups of 3 digits in integer part
+// (no grouping if empty) and Decimal separates integer and fractional part
+type Locale struct {
+	Grouping string
+	Decimal  string
+}
+
+var (
+	// LocaleEN formats numbers as 1,234,567.89
+	LocaleEN = Locale{Grouping: ",", Decimal: "."}
+	// LocalePL formats numbers as 1 234 567,89
+	LocalePL = Locale{Grouping: " ", Decimal: ","}
+	// LocaleDE formats numbers as 1.234.567,89
+	LocaleDE = Locale{Grouping: ".", Decimal: ","}
+)
+
+// FormatN formats n with grouped digits
+func (l Locale) FormatN(n *N) string {
+	return l.group(strconv.FormatUint(n.value, 10))
+}
+
+// FormatZ formats z with grouped digits
+func (l Locale) FormatZ(z *Z) string {
+	v := z.value
+	if v < 0 {
+		return "-" + l.group(strconv.FormatUint(modarith.AbsUint64(v), 10))
+	}
+	return l.group(strconv.FormatUint(uint64(v), 10))
+}
+
+// FormatQ formats decimal expansion of q with grouped integer digits and given number of fractional digits. The last
+// digit is rounded to nearest, halves away from zero (1/8 with 2 digits is "0.13") - see FormatQWith.
+func (l Locale) FormatQ(q *Q, digits int) (string, error) {
+	return l.FormatQWith(q, digits, RoundHalfUp)
+}
+
+// FormatQWith is FormatQ rounding the last digit using given mode (1/8 with 2 digits and RoundHalfEven is "0.12")
+func (l Locale) FormatQWith(q *Q, digits int, mode RoundingMode) (string, error) {
+	if digits < 0 {
+		return "", errors.New("number of digits can't be negative")
+	}
+	neg, integer, frac := decimalExpansion(q, digits, mode)
+	res := l.group(integer)
+	if digits > 0 {
+		res += l.Decimal + frac
+	}
+	if neg {
+		res = "-" + res
+	}
+	return res, nil
+}
+
+// group inserts l.Grouping between every 3 digits of s, counting from the right
+func (l Locale) group(s string) string {
+	if l.Grouping == "" || len(s) <= 3 {
+		return s
+	}
+	var sb strings.Builder
+	first := len(s) % 3
+	if first == 0 {
+		first = 3
+	}
+	sb.WriteString(s[:first])
+	for i := first; i < len(s); i += 3 {
+		sb.WriteString(l.Grouping)
+		sb.WriteString(s[i : i+3])
+	}
+	return sb.String()
+}
+
+// decimalExpansion divides |A| by B digit after digit (like at school) and rounds the result to given number of
+// fractional digits using given mode. neg is false when the rounded result is zero.
+func decimalExpansion(q *Q, digits int, mode RoundingMode) (neg bool, integer string, frac string) {
+	a, b := q.Ratio()
+	ua := modarith.AbsUint64(a)
+	ub := uint64(b)
+
+	// integer part may need a carry after rounding, so it's kept as decimal digits
+	intDigits := []byte(strconv.FormatUint(ua/ub, 10))
+	rem := ua % ub
+	fracDigits := make([]byte, digits)
+	for i := range digits {
+		// rem < B < 2^63, so 10*rem fits 128 bits and the quotient is a single digit
+		hi, lo := bits.Mul64(rem, 10)
+		d, r := bits.Div64(hi, lo, ub)
+		fracDigits[i] = byte('0' + d)
+		rem = r
+	}
+	last := intDigits[len(intDigits)-1]
+	if digits > 0 {
+		last = fracDigits[digits-1]
+	}
+	if rem != 0 && roundsAway(mode, a < 0, compareHalf(rem, ub), (last-'0')%2 == 1) {
+		carry := true
+		for i := len(fracDigits) - 1; carry && i >= 0; i-- {
+			fracDigits[i], carry = incDigit(fracDigits[i])
+		}
+		for i := len(intDigits) - 1; carry && i >= 0; i-- {
+			intDigits[i], carry = incDigit(intDigits[i])
+		}
+		if carry {
+			intDigits = append([]byte{'1'}, intDigits...)
+		}
+	}
+
+	integer, frac = string(intDigits), string(fracDigits)
+	neg = a < 0 && strings.Trim(integer+frac, "0") != ""
+	return
+}
+
+// incDigit adds 1 to decimal digit d, reporting carry
+func incDigit(d byte) (byte, bool) {
+	if d == '9' {
+		return '0', true
+	}
+	return d + 1, false
+}
@@ -0,0 +1,83 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLocaleN(t *testing.T) {
+	for _, c := range []struct {
+		l   Locale
+		v   uint64
+		exp string
+	}{
+		{LocaleEN, 0, "0"},
+		{LocaleEN, 999, "999"},
+		{LocaleEN, 1000, "1,000"},
+		{LocalePL, 1234567, "1 234 567"},
+		{LocaleDE, 1234567, "1.234.567"},
+		{Locale{Decimal: "."}, 1234567, "1234567"},
+		{LocaleEN, math.MaxUint64, "18,446,744,073,709,551,615"},
+	} {
+		if s := c.l.FormatN(NFromUint64(c.v)); s != c.exp {
+			t.Errorf("%d: expected %q, got %q", c.v, c.exp, s)
+		}
+	}
+}
+
+func TestLocaleZ(t *testing.T) {
+	if s := LocalePL.FormatZ(NewZ("-1234567")); s != "-1 234 567" {
+		t.Errorf("expected \"-1 234 567\", got %q", s)
+	}
+	if s := LocaleEN.FormatZ(ZFromInt64(math.MinInt64)); s != "-9,223,372,036,854,775,808" {
+		t.Errorf("expected \"-9,223,372,036,854,775,808\", got %q", s)
+	}
+}
+
+func TestLocaleQ(t *testing.T) {
+	for _, c := range []struct {
+		l      Locale
+		q      string
+		digits int
+		exp    string
+	}{
+		{LocaleDE, "123456789/100", 2, "1.234.567,89"},
+		{LocalePL, "123456789/100", 2, "1 234 567,89"},
+		{LocaleEN, "1/3", 5, "0.33333"},
+		{LocaleEN, "2/3", 5, "0.66667"},
+		{LocaleEN, "1/8", 2, "0.13"},
+		{LocaleEN, "-1/8", 2, "-0.13"},
+		{LocaleEN, "-1/3", 0, "0"},
+		{LocaleEN, "-1/1000", 2, "0.00"},
+		{LocaleEN, "9995/10", 0, "1,000"},
+		{LocaleEN, "99999/100000", 3, "1.000"},
+		{LocaleEN, "7/1", 3, "7.000"},
+		{LocaleEN, "9223372036854775806/9223372036854775807", 3, "1.000"},
+	} {
+		s, e := c.l.FormatQ(NewQ(c.q), c.digits)
+		if e != nil || s != c.exp {
+			t.Errorf("%s (%d): expected %q, got %q, %v", c.q, c.digits, c.exp, s, e)
+		}
+	}
+	if _, e := LocaleEN.FormatQ(NewQ("1/2"), -1); e == nil {
+		t.Errorf("expected error for negative number of digits")
+	}
+}
@@ -16,6 +16,12 @@
  * specific language governing permissions and limitations
  * under the License.
  */
+
+// Package numbers builds ℕ, ℤ and ℚ from the definitions and implements exact arithmetic on them.
+//
+// Values of ℕ, ℤ and ℚ are immutable, so all of them, including the shared ones (ZeroN(), OneQ() or small interned
+// numbers), and all functions and methods of this package are safe for concurrent use by multiple goroutines. The
+// package keeps no mutable state - it has only read-only tables filled during initialization.
 package numbers
 
 /*
@@ -30,16 +36,43 @@ package numbers
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 )
 
 var (
-	// this is the only Integer number we know initially
+	// ZERO is ℕ 0 - this is the only Integer number we know initially.
+	//
+	// Deprecated: ZERO is a variable, so it can be overwritten (numbers.ZERO = *numbers.NewN("5")) by any code, also
+	// while other goroutines read it. The package doesn't use it and its values never share memory with it - use
+	// ZeroN instead.
 	ZERO = N{value: 0}
+
+	// well known numbers - shared, because values of ℕ are immutable
+	nZero = N{value: 0}
+	nOne = N{value: 1}
+	nTwo = N{value: 2}
+	nTen = N{value: 10}
+
+	// smallN are interned values 0..maxInterned - addOne-based algorithms go through them again and again, so sharing
+	// them (like Java's Integer cache) saves allocation of new ℕ for every step
+	smallN = func() (res [maxInterned + 1]*N) {
+		for i := range res {
+			res[i] = &N{value: uint64(i)}
+		}
+		res[0], res[1], res[2], res[10] = &nZero, &nOne, &nTwo, &nTen
+		return
+	}()
+)
+
+const (
+	// the largest interned ℕ and ℤ
+	maxInterned = 256
 )
 
 // Natural numbers including ZERO
 // We suppose that we already know what integers are, what zero is, and what it means to increase a number by one unit.
+// (Peano shows this construction as data: 2 is the term S(S(0)).)
 //
 // Basic Rules for addition, multiplication and raising to a power (steming from definition):
 //  - (a) a+b = b+a
@@ -53,6 +86,10 @@ var (
 //  - (i) a+0 = a
 //  - (j) a*1 = a
 //  - (k) a^1 = a
+//
+// Values of ℕ are immutable: no operation ever changes its receiver, its arguments or any value it returned before.
+// Operations may return one of their arguments (e.g. a+0 = a) or a shared value (like ZeroN()) as the result, which is
+// safe also when other goroutines use the same value.
 type N struct {
 	value uint64
 
@@ -74,14 +111,41 @@ type NOperations interface {
 	Logarithm(*N) (*N, error)
 }
 
+// ZeroN returns ℕ 0 - the only Integer number we know initially
+func ZeroN() *N {
+	return &nZero
+}
+
+// OneN returns ℕ 1 - ZERO increased by one unit
+func OneN() *N {
+	return &nOne
+}
+
+// TwoN returns ℕ 2
+func TwoN() *N {
+	return &nTwo
+}
+
+// TenN returns ℕ 10 - the base of decimal system
+func TenN() *N {
+	return &nTen
+}
+
 // NewN Creates new ℕ from string
 func NewN(v string) *N {
-	value, _ := strconv.Atoi(v)
-	res := &ZERO
-	for i := 0; i < value; i++ {
-		res = res.addOne()
+	value, _ := strconv.ParseUint(v, 10, 64)
+	return NFromUint64(value)
+}
+
+// NFromUint64 creates new ℕ from machine integer.
+//
+// By definition, v is ZERO increased by one unit v times, but repeating addOne v times makes large numbers
+// unreachable, so we take the machine representation as it is. Values up to 256 are shared (interned).
+func NFromUint64(v uint64) *N {
+	if v <= maxInterned {
+		return smallN[v]
 	}
-	return res
+	return &N{value: v}
 }
 
 // Override promoted methods, by default it's just n.String() -> n.Stringer.String() and is causing NPE
@@ -89,28 +153,44 @@ func (n *N) String() string {
 	return fmt.Sprintf("%d", n.value)
 }
 
+// Clone returns a copy of n. Values of ℕ are immutable, so it's needed only when a distinct pointer is required.
+func (n *N) Clone() *N {
+	return &N{value: n.value}
+}
+
+// Uint64 returns n as machine integer. ℕ is kept in 64 bits, so the conversion is always exact.
+func (n *N) Uint64() uint64 {
+	return n.value
+}
+
+// Int64 returns n as signed machine integer, exact is false if n doesn't fit int64 (and the value is then meaningless)
+func (n *N) Int64() (v int64, exact bool) {
+	if n.value > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(n.value), true
+}
+
 // "Addition": Start with integer A and increase it by 1, B times to get "A + B"
 func (n *N) Add(arg *N) *N {
-	res := n
-	for i := uint64(0); i < arg.value; i++ {
-		res = res.addOne()
-	}
-	return res
+	res := accumulator{value: n.value}
+	res.add(arg)
+	return res.n()
 }
 
 // "Multiplication": (requires definition of "Addition") Start with ZERO and add A to it B times to get "A * B"
 func (n *N) Multiply(arg *N) *N {
-	res := &ZERO
+	res := accumulator{}
 	for i := uint64(0); i < arg.value; i++ {
-		res = res.Add(n)
+		res.add(n)
 	}
-	return res
+	return res.n()
 }
 
 // "Raising to power": (requires definition of "Multiplication") Start with ONE and multiply it by A, B times
 // to get "A^B"
 func (n *N) Power(arg *N) *N {
-	res := NewN("1")
+	res := OneN()
 	for i := uint64(0); i < arg.value; i++ {
 		res = res.Multiply(n)
 	}
@@ -124,15 +204,14 @@ func (n *N) Subtract(arg *N) (*N, *Z) {
 		return nil, DefZ(n, arg)
 	}
 
-	res := &ZERO
-	for {
-		if arg.Add(res).value == n.value {
-			return res, nil
-		}
-		res = res.addOne()
+	// A + B, increased by 1 together with B, instead of calculating A + B from scratch for every candidate B
+	sum := accumulator{value: arg.value}
+	res := accumulator{}
+	for sum.value != n.value {
+		sum.addOne()
+		res.addOne()
 	}
-
-	return res, nil
+	return res.n(), nil
 }
 
 // "Division": Assuming A and C are given, we want to find B that "A * B = C". Then B is defined as "C / A"
@@ -141,15 +220,9 @@ func (n *N) Divide(arg *N) (*N, *Q, error) {
 		return nil, nil, errors.New("can't divide by ZERO")
 	}
 
-	res := &ZERO
-	for {
-		if arg.Multiply(res).value == n.value {
-			return res, nil, nil
-		}
-		if arg.Multiply(res).value > n.value {
-			break
-		}
-		res = res.addOne()
+	res, rem := n.divideR(arg)
+	if rem.value == 0 {
+		return res.n(), nil, nil
 	}
 
 	// immediately delegate to ℚ
@@ -162,20 +235,23 @@ func (n *N) DivideR(arg *N) (*N, *N, error) {
 		return nil, nil, errors.New("can't divide by ZERO")
 	}
 
-	res := &ZERO
-	for {
-		if arg.Multiply(res).value == n.value {
-			return res, &ZERO, nil
-		}
-		if arg.Multiply(res).value > n.value {
-			break
+	res, rem := n.divideR(arg)
+	return res.n(), rem.n(), nil
+}
+
+// divideR finds B and R such that "A * B + R = C" (0 <= R < A) without trying candidates B one by one (which would
+// mean calculating "A * B" for each of them). C units are instead put into groups of A units one by one - B is the
+// number of complete groups and R is the number of units in the last incomplete group. Each unit is looked at once.
+func (n *N) divideR(arg *N) (res accumulator, rem accumulator) {
+	for i := uint64(0); i < n.value; i++ {
+		rem.addOne()
+		if rem.value == arg.value {
+			// complete group
+			res.addOne()
+			rem = accumulator{}
 		}
-		res = res.addOne()
 	}
-
-	res1, _ := res.Subtract(NewN("1"))
-	rem, _ := n.Subtract(res1.Multiply(arg))
-	return res1, rem, nil
+	return res, rem
 }
 
 // "Root": Assuming A and C are given, we want to find B that "B ^ A = C", Then B is defined as "Ath√C".
@@ -185,7 +261,7 @@ func (n *N) Root(arg *N) (*N, error) {
 		return nil, errors.New("can't take ZEROth root")
 	}
 
-	res := NewN("0")
+	res := ZeroN()
 	for {
 		if res.Power(n).value == arg.value {
 			return res, nil
@@ -212,7 +288,7 @@ func (n *N) Logarithm(arg *N) (*N, error) {
 		return nil, errors.New("can't take logarithm with base ONE")
 	}
 
-	res := NewN("0")
+	res := ZeroN()
 	for {
 		if n.Power(res).value == arg.value {
 			return res, nil
@@ -228,9 +304,30 @@ func (n *N) Logarithm(arg *N) (*N, error) {
 
 // and we only know how to "add 1" - find "next" number
 func (n *N) addOne() *N {
-	res := &N{value: n.value}
-	res.value++
-	return res
+	return NFromUint64(n.value + 1)
+}
+
+// accumulator is a mutable ℕ used internally by the algorithms built from addOne. Each step of such algorithm would
+// allocate new ℕ (values are immutable), so the steps are done in place and only the final result is allocated.
+type accumulator struct {
+	value uint64
+}
+
+// addOne increases the accumulator by one unit in place
+func (acc *accumulator) addOne() {
+	acc.value++
+}
+
+// add increases the accumulator by one unit, arg times - in place version of N.Add
+func (acc *accumulator) add(arg *N) {
+	for i := uint64(0); i < arg.value; i++ {
+		acc.addOne()
+	}
+}
+
+// n returns the accumulated value as immutable ℕ
+func (acc *accumulator) n() *N {
+	return NFromUint64(acc.value)
 }
 
 // validation of interface implementation
@@ -20,6 +20,8 @@ package numbers
 
 import (
 	"fmt"
+	"math"
+	"sync"
 	"testing"
 )
 
@@ -259,3 +261,192 @@ func divideR(a string, b string) {
 		fmt.Printf("%s/%s: %s\n", a, b, fmt.Errorf("%s", e))
 	}
 }
+
+func TestImmutabilityN(t *testing.T) {
+	a := NewN("12")
+	b := NewN("5")
+	a.Add(b)
+	a.Multiply(b)
+	a.Power(NewN("2"))
+	a.Subtract(b)
+	b.Subtract(a)
+	a.Divide(b)
+	a.DivideR(b)
+	NewN("3").DivideR(NewN("3"))
+	if a.value != 12 || b.value != 5 || ZERO.value != 0 {
+		t.Errorf("operations changed their arguments: %s, %s, %s", a, b, &ZERO)
+	}
+	c := a.Clone()
+	if c == a || c.value != a.value {
+		t.Errorf("clone of %s: got %s", a, c)
+	}
+}
+
+func TestNativeN(t *testing.T) {
+	n := &N{value: math.MaxUint64}
+	if v := n.Uint64(); v != math.MaxUint64 {
+		t.Errorf("Uint64: expected %d, got %d", uint64(math.MaxUint64), v)
+	}
+	if _, exact := n.Int64(); exact {
+		t.Errorf("Int64 of %s: expected inexact", n)
+	}
+	if v, exact := NewN("42").Int64(); !exact || v != 42 {
+		t.Errorf("Int64 of 42: got %d, %t", v, exact)
+	}
+}
+
+func TestNFromUint64(t *testing.T) {
+	if n := NFromUint64(math.MaxUint64); n.value != math.MaxUint64 {
+		t.Errorf("expected %d, got %s", uint64(math.MaxUint64), n)
+	}
+	if n := NewN("18446744073709551615"); n.value != math.MaxUint64 {
+		t.Errorf("expected %d, got %s", uint64(math.MaxUint64), n)
+	}
+	if n := NewN("1000000000000"); n.value != 1000000000000 {
+		t.Errorf("expected 1000000000000, got %s", n)
+	}
+}
+
+func TestConstantsN(t *testing.T) {
+	if OneN().value != 1 || TwoN().value != 2 || TenN().value != 10 {
+		t.Errorf("expected 1, 2, 10, got %s, %s, %s", OneN(), TwoN(), TenN())
+	}
+	OneN().Add(TenN()).Multiply(TwoN())
+	TenN().Power(&ZERO)
+	if OneN().value != 1 || TwoN().value != 2 || TenN().value != 10 {
+		t.Errorf("constants changed by operations: %s, %s, %s", OneN(), TwoN(), TenN())
+	}
+}
+
+func TestInterningN(t *testing.T) {
+	if NewN("0") != ZeroN() || NewN("1") != OneN() || NFromUint64(256) != NFromUint64(256) {
+		t.Errorf("small values are not interned")
+	}
+	if NFromUint64(257) == NFromUint64(257) {
+		t.Errorf("large values shouldn't be interned")
+	}
+	a, b := NewN("200"), NewN("56")
+	if allocs := testing.AllocsPerRun(10, func() { a.Add(b) }); allocs != 0 {
+		t.Errorf("200+56: expected no allocations, got %v", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { NewN("16").Multiply(NewN("16")) }); allocs != 0 {
+		t.Errorf("16*16: expected no allocations, got %v", allocs)
+	}
+}
+
+func TestAllocationsN(t *testing.T) {
+	a, b := NewN("100000"), NewN("1000")
+	if allocs := testing.AllocsPerRun(10, func() { a.Add(b) }); allocs > 1 {
+		t.Errorf("100000+1000: expected single allocation, got %v", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { a.Multiply(b) }); allocs > 1 {
+		t.Errorf("100000*1000: expected single allocation, got %v", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { a.Subtract(b) }); allocs > 1 {
+		t.Errorf("100000-1000: expected single allocation, got %v", allocs)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	x, y := NewN("100000"), NewN("100000")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Add(y)
+	}
+}
+
+func BenchmarkMultiply(b *testing.B) {
+	x, y := NewN("1000"), NewN("1000")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Multiply(y)
+	}
+}
+
+func BenchmarkSubtract(b *testing.B) {
+	x, y := NewN("2000"), NewN("1000")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Subtract(y)
+	}
+}
+
+func BenchmarkDivide(b *testing.B) {
+	x, y := NewN("100000"), NewN("7")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.Divide(y)
+	}
+}
+
+func BenchmarkDivideR(b *testing.B) {
+	x, y := NewN("100000"), NewN("7")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.DivideR(y)
+	}
+}
+
+func TestDivideRValues(t *testing.T) {
+	for _, c := range [][2]uint64{{140, 10}, {140, 11}, {42, 42}, {0, 42}, {3, 10}, {10, 3}, {100000, 7}, {1, 1}} {
+		q, r, e := NFromUint64(c[0]).DivideR(NFromUint64(c[1]))
+		if e != nil || q.value != c[0]/c[1] || r.value != c[0]%c[1] {
+			t.Errorf("%d/%d: expected %d r %d, got %v r %v (%v)", c[0], c[1], c[0]/c[1], c[0]%c[1], q, r, e)
+		}
+		n, f, e := NFromUint64(c[0]).Divide(NFromUint64(c[1]))
+		if c[0]%c[1] == 0 && (e != nil || n == nil || n.value != c[0]/c[1]) {
+			t.Errorf("%d/%d: expected %d, got %v (%v)", c[0], c[1], c[0]/c[1], n, e)
+		}
+		if c[0]%c[1] != 0 && (e != nil || f == nil || f.a*int64(c[1]) != f.b*int64(c[0])) {
+			t.Errorf("%d/%d: expected fraction, got %v (%v)", c[0], c[1], f, e)
+		}
+	}
+}
+
+func TestZeroNIsNotAffectedByZERO(t *testing.T) {
+	saved := ZERO
+	defer func() { ZERO = saved }()
+	ZERO = *NewN("5")
+	if z := ZeroN(); z.value != 0 {
+		t.Errorf("ZeroN() = %s after ZERO was overwritten", z)
+	}
+	if z := NFromUint64(0); z.value != 0 {
+		t.Errorf("NFromUint64(0) = %s after ZERO was overwritten", z)
+	}
+	if r, e := TwoN().Root(NFromUint64(0)); e != nil || r.value != 0 {
+		t.Errorf("2nd root of 0 = %v, %v after ZERO was overwritten", r, e)
+	}
+}
+
+// TestConcurrentOperations shares interned and well known values between goroutines - run with -race
+func TestConcurrentOperations(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range uint64(300) {
+				a, b := NFromUint64(i), NFromUint64(uint64(g))
+				if s := a.Add(b); s.value != i+uint64(g) {
+					t.Errorf("%s + %s = %s", a, b, s)
+				}
+				if p := a.Multiply(OneN()); p.value != a.value {
+					t.Errorf("%s * 1 = %s", a, p)
+				}
+				z := ZFromInt64(int64(i) - 150)
+				if d := z.Add(ZeroZ()).Subtract(z); d.Int64() != 0 {
+					t.Errorf("%s + 0 - %s = %s", z, z, d)
+				}
+				q, _ := QFromInts(int64(i), int64(g)+1)
+				if r := q.Multiply(OneQ()).Add(ZeroQ()); r.Compare(q) != 0 {
+					t.Errorf("%s * 1 + 0 = %s", q, r)
+				}
+				_ = ZeroN().String() + a.Text(16) + q.String()
+			}
+		}()
+	}
+	wg.Wait()
+	if ZeroN().value != 0 || OneN().value != 1 || NFromUint64(42).value != 42 {
+		t.Error("shared values were changed")
+	}
+}
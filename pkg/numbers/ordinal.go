@@ -0,0 +1,127 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+var (
+	smallWords = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+	// irregular ordinals - all the other are created by adding "th"
+	ordinalWords = map[string]string{"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth"}
+)
+
+// Ordinal returns n with English ordinal suffix: "1st", "42nd", "103rd", "111th"
+func (n *N) Ordinal() string {
+	return strconv.FormatUint(n.value, 10) + ordinalSuffix(n.value)
+}
+
+// Ordinal returns z with English ordinal suffix: "1st", "-2nd"
+func (z *Z) Ordinal() string {
+	v := z.value
+	if v < 0 {
+		return "-" + (&N{value: modarith.AbsUint64(v)}).Ordinal()
+	}
+	return (&N{value: uint64(v)}).Ordinal()
+}
+
+// SpelledOrdinal returns n as English ordinal word: "first", "forty-second", "one hundred third"
+func (n *N) SpelledOrdinal() string {
+	words := spell(n.value)
+	// only the last word (or the part after hyphen) changes
+	i := strings.LastIndexAny(words, " -") + 1
+	last := words[i:]
+	if o, ok := ordinalWords[last]; ok {
+		return words[:i] + o
+	}
+	if strings.HasSuffix(last, "y") {
+		return words[:i] + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return words + "th"
+}
+
+// SpelledOrdinal returns z as English ordinal word, negative values start with "minus "
+func (z *Z) SpelledOrdinal() string {
+	v := z.value
+	if v < 0 {
+		return "minus " + (&N{value: modarith.AbsUint64(v)}).SpelledOrdinal()
+	}
+	return (&N{value: uint64(v)}).SpelledOrdinal()
+}
+
+// ordinalSuffix is "st", "nd" and "rd" for numbers ending with 1, 2 and 3 - except 11, 12 and 13
+func ordinalSuffix(v uint64) string {
+	if v%100 >= 11 && v%100 <= 13 {
+		return "th"
+	}
+	switch v % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// spell returns English cardinal words for v, without "and": "one hundred twenty-three"
+func spell(v uint64) string {
+	if v == 0 {
+		return smallWords[0]
+	}
+	var groups []string
+	for scale := 0; v > 0; scale, v = scale+1, v/1000 {
+		if g := v % 1000; g > 0 {
+			s := spellHundreds(g)
+			if scaleWords[scale] != "" {
+				s += " " + scaleWords[scale]
+			}
+			groups = append([]string{s}, groups...)
+		}
+	}
+	return strings.Join(groups, " ")
+}
+
+// spellHundreds spells 0 < v < 1000
+func spellHundreds(v uint64) string {
+	var parts []string
+	if v >= 100 {
+		parts = append(parts, smallWords[v/100]+" hundred")
+		v %= 100
+	}
+	switch {
+	case v >= 20 && v%10 > 0:
+		parts = append(parts, tensWords[v/10]+"-"+smallWords[v%10])
+	case v >= 20:
+		parts = append(parts, tensWords[v/10])
+	case v > 0:
+		parts = append(parts, smallWords[v])
+	}
+	return strings.Join(parts, " ")
+}
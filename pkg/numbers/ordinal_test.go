@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOrdinal(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		0: "0th", 1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st",
+		42: "42nd", 103: "103rd", 111: "111th", 112: "112th", 1001: "1001st",
+	} {
+		if s := NFromUint64(v).Ordinal(); s != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, s)
+		}
+	}
+	if s := NewZ("-22").Ordinal(); s != "-22nd" {
+		t.Errorf("expected \"-22nd\", got %q", s)
+	}
+	if s := ZFromInt64(math.MinInt64).Ordinal(); s != "-9223372036854775808th" {
+		t.Errorf("expected \"-9223372036854775808th\", got %q", s)
+	}
+}
+
+func TestSpelledOrdinal(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		0:       "zeroth",
+		1:       "first",
+		2:       "second",
+		3:       "third",
+		5:       "fifth",
+		8:       "eighth",
+		9:       "ninth",
+		11:      "eleventh",
+		12:      "twelfth",
+		20:      "twentieth",
+		42:      "forty-second",
+		99:      "ninety-ninth",
+		100:     "one hundredth",
+		103:     "one hundred third",
+		1000:    "one thousandth",
+		1000001: "one million first",
+		2019:    "two thousand nineteenth",
+		math.MaxUint64: "eighteen quintillion four hundred forty-six quadrillion seven hundred forty-four trillion " +
+			"seventy-three billion seven hundred nine million five hundred fifty-one thousand six hundred fifteenth",
+	} {
+		if s := NFromUint64(v).SpelledOrdinal(); s != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, s)
+		}
+	}
+	if s := NewZ("-3").SpelledOrdinal(); s != "minus third" {
+		t.Errorf("expected \"minus third\", got %q", s)
+	}
+}
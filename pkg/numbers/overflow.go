@@ -0,0 +1,89 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrOverflow is returned when the result of an operation doesn't fit the underlying machine integer
+	ErrOverflow = errors.New("result doesn't fit 64 bits")
+)
+
+// addInt64 adds two machine integers, reporting overflow instead of wrapping around
+func addInt64(a int64, b int64) (int64, error) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// mulInt64 multiplies two machine integers, reporting overflow instead of wrapping around
+func mulInt64(a int64, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || c/b != a {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// addUint64 adds two unsigned machine integers, reporting overflow instead of wrapping around
+func addUint64(a uint64, b uint64) (uint64, error) {
+	c, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// mulUint64 multiplies two unsigned machine integers, reporting overflow instead of wrapping around
+func mulUint64(a uint64, b uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return 0, ErrOverflow
+	}
+	return lo, nil
+}
+
+// lcmInt64 returns the least common multiple of two positive machine integers: a*b = gcd(a, b) * lcm(a, b)
+func lcmInt64(a int64, b int64) (int64, error) {
+	return mulInt64(a/gcdInt64(a, b), b)
+}
+
+// gcdInt64 is Euclid's algorithm on machine integers - the same algorithm as Q.GCD, but without going through
+// Z.DivideR, which finds the quotient by repeated addition
+func gcdInt64(a int64, b int64) int64 {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
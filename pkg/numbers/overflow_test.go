@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddInt64(t *testing.T) {
+	if c, e := addInt64(40, 2); e != nil || c != 42 {
+		t.Errorf("40+2: expected 42, got %d (%v)", c, e)
+	}
+	if c, e := addInt64(-40, -2); e != nil || c != -42 {
+		t.Errorf("-40+-2: expected -42, got %d (%v)", c, e)
+	}
+	if _, e := addInt64(math.MaxInt64, 1); e != ErrOverflow {
+		t.Errorf("MaxInt64+1: expected overflow")
+	}
+	if _, e := addInt64(math.MinInt64, -1); e != ErrOverflow {
+		t.Errorf("MinInt64-1: expected overflow")
+	}
+}
+
+func TestMulInt64(t *testing.T) {
+	if c, e := mulInt64(-6, 7); e != nil || c != -42 {
+		t.Errorf("-6*7: expected -42, got %d (%v)", c, e)
+	}
+	if _, e := mulInt64(math.MaxInt64/2+1, 2); e != ErrOverflow {
+		t.Errorf("(MaxInt64/2+1)*2: expected overflow")
+	}
+	if _, e := mulInt64(math.MinInt64, -1); e != ErrOverflow {
+		t.Errorf("MinInt64*-1: expected overflow")
+	}
+}
+
+func TestGcdInt64(t *testing.T) {
+	if g := gcdInt64(-12, 18); g != 6 {
+		t.Errorf("gcd(-12, 18): expected 6, got %d", g)
+	}
+	if g := gcdInt64(0, 5); g != 5 {
+		t.Errorf("gcd(0, 5): expected 5, got %d", g)
+	}
+}
+
+func TestUint64(t *testing.T) {
+	if c, e := addUint64(math.MaxUint64-1, 1); e != nil || c != math.MaxUint64 {
+		t.Errorf("(MaxUint64-1)+1: got %d (%v)", c, e)
+	}
+	if _, e := addUint64(math.MaxUint64, 1); e != ErrOverflow {
+		t.Errorf("MaxUint64+1: expected overflow")
+	}
+	if c, e := mulUint64(1<<32, 1<<31); e != nil || c != 1<<63 {
+		t.Errorf("2^32*2^31: got %d (%v)", c, e)
+	}
+	if _, e := mulUint64(1<<32, 1<<32); e != ErrOverflow {
+		t.Errorf("2^32*2^32: expected overflow")
+	}
+}
+
+func TestLcmInt64(t *testing.T) {
+	if l, e := lcmInt64(4, 6); e != nil || l != 12 {
+		t.Errorf("lcm(4, 6): expected 12, got %d (%v)", l, e)
+	}
+	if _, e := lcmInt64(math.MaxInt64, math.MaxInt64-1); e != ErrOverflow {
+		t.Errorf("lcm(MaxInt64, MaxInt64-1): expected overflow")
+	}
+}
@@ -0,0 +1,275 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// superscript digits used for exponents: "2⁵"
+const superscripts = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+
+// ParseN strictly parses ℕ: Go-style integer literal ("1_000_000", "0b1010", "0o17", "0xFF"), optionally followed by
+// superscript exponent ("2⁵" is 32). Unlike NewN, it reports invalid input and values which don't fit uint64.
+func ParseN(v string) (*N, error) {
+	n, e := parseNatural(v)
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℕ %q: %w", v, e)
+	}
+	return NFromUint64(n), nil
+}
+
+// ParseZ strictly parses ℤ: optional sign ("+", "-" or Unicode minus "−") and ℕ accepted by ParseN ("−2⁵" is -32)
+func ParseZ(v string) (*Z, error) {
+	z, e := parseInteger(v)
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℤ %q: %w", v, e)
+	}
+	return ZFromInt64(z), nil
+}
+
+// ParseQ strictly parses ℚ: ℤ accepted by ParseZ, optionally followed by "/" or Unicode fraction slash "⁄" and another
+// ℤ ("−3⁄4" is -3/4). The result is in canonical form.
+func ParseQ(v string) (*Q, error) {
+	num, den, found := strings.Cut(strings.Replace(v, "⁄", "/", 1), "/")
+	a, e := parseInteger(num)
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℚ %q: %w", v, e)
+	}
+	var b int64 = 1
+	if found {
+		if b, e = parseInteger(den); e != nil {
+			return nil, fmt.Errorf("invalid ℚ %q: %w", v, e)
+		}
+	}
+	q, e := QFromInts(a, b)
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℚ %q: %w", v, e)
+	}
+	return q, nil
+}
+
+// parseInteger parses optional sign and natural number
+func parseInteger(s string) (int64, error) {
+	p := newIntegerParser()
+	for _, r := range s {
+		p.feed(r)
+	}
+	return p.result()
+}
+
+// parseNatural parses decimal digits with optional superscript exponent
+func parseNatural(s string) (uint64, error) {
+	p := newNaturalParser()
+	for _, r := range s {
+		p.feed(r)
+	}
+	return p.result()
+}
+
+// integerParser parses optional sign ("+", "-" or "−") and natural number rune by rune
+type integerParser struct {
+	neg     bool
+	started bool
+	natural *naturalParser
+}
+
+func newIntegerParser() *integerParser {
+	return &integerParser{natural: newNaturalParser()}
+}
+
+// feed parses next rune
+func (p *integerParser) feed(r rune) {
+	first := !p.started
+	p.started = true
+	if first && (r == '-' || r == '−' || r == '+') {
+		p.neg = r != '+'
+		return
+	}
+	p.natural.feed(r)
+}
+
+// result returns the parsed integer or the first error
+func (p *integerParser) result() (int64, error) {
+	n, e := p.natural.result()
+	if e != nil {
+		return 0, e
+	}
+	if p.neg {
+		if n > math.MaxInt64+1 {
+			return 0, ErrOverflow
+		}
+		return -int64(n-1) - 1, nil
+	}
+	if n > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(n), nil
+}
+
+// naturalParser parses Go-style integer literal rune by rune: non-empty sequence of digits with optional "0b", "0o" or
+// "0x" prefix and underscores between digits ("1_000_000", "0x_FF"), optionally followed by superscript exponent.
+// Leading zero alone doesn't mean octal number ("007" is 7). Runes after the first error are ignored.
+type naturalParser struct {
+	base uint64
+	n    uint64
+	exp  uint64
+	// number of runes before the exponent
+	runes int
+	// what was before the current character: 0 - nothing, 'p' - prefix, 'd' - digit, '_' - underscore,
+	// 's' - superscript
+	prev byte
+	e    error
+}
+
+func newNaturalParser() *naturalParser {
+	return &naturalParser{base: 10}
+}
+
+// feed parses next rune
+func (p *naturalParser) feed(r rune) {
+	if p.e == nil {
+		p.e = p.next(r)
+	}
+}
+
+func (p *naturalParser) next(r rune) error {
+	if d := strings.IndexRune(superscripts, r); d >= 0 {
+		if p.prev != 's' {
+			if e := p.digitsDone(); e != nil {
+				return e
+			}
+			p.prev = 's'
+		}
+		var e error
+		if p.exp, e = mulUint64(p.exp, 10); e != nil {
+			return e
+		}
+		// superscripts are 2 or 3 bytes long in UTF-8, so the index doesn't tell the digit directly
+		p.exp, e = addUint64(p.exp, uint64(utf8.RuneCountInString(superscripts[:d])))
+		return e
+	}
+	if p.prev == 's' {
+		return fmt.Errorf("unexpected %q in exponent", r)
+	}
+	p.runes++
+	if p.runes == 2 && p.prev == 'd' && p.n == 0 {
+		// the first rune was 0
+		switch r {
+		case 'b', 'B':
+			p.base, p.prev = 2, 'p'
+		case 'o', 'O':
+			p.base, p.prev = 8, 'p'
+		case 'x', 'X':
+			p.base, p.prev = 16, 'p'
+		}
+		if p.prev == 'p' {
+			return nil
+		}
+	}
+	if r == '_' {
+		if p.prev != 'd' && p.prev != 'p' {
+			return fmt.Errorf("'_' must separate successive digits")
+		}
+		p.prev = '_'
+		return nil
+	}
+	d := digitValue(r)
+	if d >= p.base {
+		if d < 16 {
+			return fmt.Errorf("invalid digit %q in base %d", r, p.base)
+		}
+		return fmt.Errorf("unexpected %q", r)
+	}
+	var e error
+	if p.n, e = mulUint64(p.n, p.base); e != nil {
+		return e
+	}
+	if p.n, e = addUint64(p.n, d); e != nil {
+		return e
+	}
+	p.prev = 'd'
+	return nil
+}
+
+// digitsDone checks if the digits before the exponent (or the end) are complete
+func (p *naturalParser) digitsDone() error {
+	switch p.prev {
+	case 0, 'p':
+		return fmt.Errorf("missing digits")
+	case '_':
+		return fmt.Errorf("'_' must separate successive digits")
+	}
+	return nil
+}
+
+// result returns the parsed number or the first error
+func (p *naturalParser) result() (uint64, error) {
+	if p.e != nil {
+		return 0, p.e
+	}
+	if p.prev == 's' {
+		return powUint64(p.n, p.exp)
+	}
+	if e := p.digitsDone(); e != nil {
+		return 0, e
+	}
+	return p.n, nil
+}
+
+// digitValue returns value of hexadecimal digit r or 16 if r is not a digit
+func digitValue(r rune) uint64 {
+	switch {
+	case r >= '0' && r <= '9':
+		return uint64(r - '0')
+	case r >= 'a' && r <= 'f':
+		return uint64(r-'a') + 10
+	case r >= 'A' && r <= 'F':
+		return uint64(r-'A') + 10
+	}
+	return 16
+}
+
+// powUint64 returns base^exp (0^0 = 1) by repeated squaring, reporting overflow
+func powUint64(base uint64, exp uint64) (uint64, error) {
+	if exp == 0 {
+		return 1, nil
+	}
+	if base <= 1 {
+		return base, nil
+	}
+	res := uint64(1)
+	for {
+		var e error
+		if exp&1 == 1 {
+			if res, e = mulUint64(res, base); e != nil {
+				return 0, e
+			}
+		}
+		if exp >>= 1; exp == 0 {
+			return res, nil
+		}
+		if base, e = mulUint64(base, base); e != nil {
+			return 0, e
+		}
+	}
+}
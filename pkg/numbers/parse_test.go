@@ -0,0 +1,119 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseN(t *testing.T) {
+	for v, exp := range map[string]uint64{
+		"0":                    0,
+		"42":                   42,
+		"007":                  7,
+		"2⁵":                   32,
+		"10¹⁸":                 1000000000000000000,
+		"2⁶³":                  1 << 63,
+		"0⁰":                   1,
+		"1¹²³⁴⁵⁶⁷⁸⁹⁰":          1,
+		"18446744073709551615": 18446744073709551615,
+		"1_000_000":            1000000,
+		"0b1010":               10,
+		"0B_1010_1010":         170,
+		"0o17":                 15,
+		"0O777":                511,
+		"0xFF":                 255,
+		"0x_dead_BEEF":         0xdeadbeef,
+		"0xFFFFFFFFFFFFFFFF":   18446744073709551615,
+		"0x10²":                256,
+	} {
+		n, e := ParseN(v)
+		if e != nil || n.value != exp {
+			t.Errorf("%s: expected %d, got %v, %v", v, exp, n, e)
+		}
+	}
+	for _, v := range []string{"", "-1", "+1", "1.5", " 1", "1 ", "²", "2²a", "2a²", "x", "_1", "1_", "1__0", "0x",
+		"0x_", "0b102", "0o8", "0xG", "ff", "0_x1", "1_²", "0d10"} {
+		if _, e := ParseN(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+	for _, v := range []string{"18446744073709551616", "2⁶⁴", "10²⁰"} {
+		if _, e := ParseN(v); !errors.Is(e, ErrOverflow) {
+			t.Errorf("%q: expected overflow, got %v", v, e)
+		}
+	}
+}
+
+func TestParseZ(t *testing.T) {
+	for v, exp := range map[string]int64{
+		"42":                   42,
+		"-42":                  -42,
+		"−42":                  -42,
+		"+42":                  42,
+		"−2⁵":                  -32,
+		"-9223372036854775808": -9223372036854775808,
+		"−2⁶³":                 -9223372036854775808,
+		"9223372036854775807":  9223372036854775807,
+		"-1_000":               -1000,
+		"−0x80":                -128,
+		"-0x8000000000000000":  -9223372036854775808,
+	} {
+		z, e := ParseZ(v)
+		if e != nil || z.value != exp {
+			t.Errorf("%s: expected %d, got %v, %v", v, exp, z, e)
+		}
+	}
+	for _, v := range []string{"", "-", "−", "--1", "−-1", "1−"} {
+		if _, e := ParseZ(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+	for _, v := range []string{"9223372036854775808", "2⁶³", "-9223372036854775809", "0x8000000000000000"} {
+		if _, e := ParseZ(v); !errors.Is(e, ErrOverflow) {
+			t.Errorf("%q: expected overflow, got %v", v, e)
+		}
+	}
+}
+
+func TestParseQ(t *testing.T) {
+	for v, exp := range map[string]string{
+		"3/4":        "3/4",
+		"−3⁄4":       "-3/4",
+		"6⁄8":        "3/4",
+		"1/-2":       "-1/2",
+		"−1/−2":      "1/2",
+		"7":          "7/1",
+		"1⁄2⁵":       "1/32",
+		"2³/3²":      "8/9",
+		"+10/10":     "1/1",
+		"0x10/0b100": "4/1",
+	} {
+		q, e := ParseQ(v)
+		if e != nil || q.String() != exp {
+			t.Errorf("%s: expected %s, got %v, %v", v, exp, q, e)
+		}
+	}
+	for _, v := range []string{"", "/", "1/", "/2", "1/0", "1/2/3", "1⁄2⁄3", "1 / 2", "1÷2"} {
+		if _, e := ParseQ(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
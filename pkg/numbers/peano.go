@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxPeano is the biggest number represented as Peano term - every successor is a separate value in memory
+const MaxPeano = 1 << 16
+
+// Peano is ℕ written as a term of Peano arithmetic: either 0, or the successor S(n) of another term n. 3 is
+// S(S(S(0))). It's the construction of ℕ (where we only know ZERO and how to add one unit) shown as data - N keeps
+// the machine representation instead, but its algorithms are loops of the same steps.
+//
+// Like N, values of Peano are immutable, so terms share their predecessors: S(S(0)) and S(S(S(0))) built from it use
+// the same S(S(0)).
+type Peano struct {
+	// pred is the predecessor of S(pred) or nil for 0
+	pred *Peano
+
+	fmt.Stringer
+}
+
+// peanoZero is the only 0 term
+var peanoZero = &Peano{}
+
+// PeanoZero returns the term 0
+func PeanoZero() *Peano {
+	return peanoZero
+}
+
+// PeanoFromN returns the term of n - 0 followed by n successors. Error is returned for n > MaxPeano.
+func PeanoFromN(n *N) (*Peano, error) {
+	if n.value > MaxPeano {
+		return nil, fmt.Errorf("%s is bigger than %d", n, MaxPeano)
+	}
+	res := PeanoZero()
+	for i := uint64(0); i < n.value; i++ {
+		res = res.Succ()
+	}
+	return res, nil
+}
+
+// ParsePeano parses the term written as by Peano.String: "0", "S(0)", "S(S(0))", ...
+func ParsePeano(v string) (*Peano, error) {
+	s := strings.TrimLeft(v, "S(")
+	k := len(v) - len(s)
+	if k%2 != 0 || strings.Count(v[:k], "S(") != k/2 || s != "0"+strings.Repeat(")", k/2) {
+		return nil, fmt.Errorf("invalid Peano term %q", v)
+	}
+	if k/2 > MaxPeano {
+		return nil, fmt.Errorf("Peano term %q is bigger than %d", v, MaxPeano)
+	}
+	return PeanoFromN(NFromUint64(uint64(k / 2)))
+}
+
+// Succ returns the successor S(p)
+func (p *Peano) Succ() *Peano {
+	return &Peano{pred: p}
+}
+
+// Pred returns the predecessor of p = S(pred). 0 is not a successor of any term, so it has no predecessor.
+func (p *Peano) Pred() (*Peano, error) {
+	if p.IsZero() {
+		return nil, errors.New("0 has no predecessor")
+	}
+	return p.pred, nil
+}
+
+// IsZero checks if p is 0
+func (p *Peano) IsZero() bool {
+	return p.pred == nil
+}
+
+// N returns ℕ of p by counting its successors - adding one unit for each of them
+func (p *Peano) N() *N {
+	res := accumulator{}
+	for t := p; !t.IsZero(); t = t.pred {
+		res.addOne()
+	}
+	return res.n()
+}
+
+// Add returns p + arg by the recursive definition: a + 0 = a and a + S(b) = S(a + b). Error is returned if the sum is
+// bigger than MaxPeano.
+func (p *Peano) Add(arg *Peano) (*Peano, error) {
+	if p.N().value+arg.N().value > MaxPeano {
+		return nil, fmt.Errorf("%s + %s is bigger than %d", p.N(), arg.N(), MaxPeano)
+	}
+	return p.add(arg), nil
+}
+
+func (p *Peano) add(arg *Peano) *Peano {
+	if arg.IsZero() {
+		return p
+	}
+	return p.add(arg.pred).Succ()
+}
+
+// Multiply returns p · arg by the recursive definition: a · 0 = 0 and a · S(b) = a · b + a. Error is returned if the
+// product is bigger than MaxPeano.
+func (p *Peano) Multiply(arg *Peano) (*Peano, error) {
+	a, b := p.N().value, arg.N().value
+	if b != 0 && a > MaxPeano/b {
+		return nil, fmt.Errorf("%d · %d is bigger than %d", a, b, MaxPeano)
+	}
+	return p.multiply(arg), nil
+}
+
+func (p *Peano) multiply(arg *Peano) *Peano {
+	if arg.IsZero() {
+		return PeanoZero()
+	}
+	return p.multiply(arg.pred).add(p)
+}
+
+// Compare returns -1, 0 or 1 if p is less than, equal to or greater than arg - the predecessors are removed from both
+// terms until one of them is 0
+func (p *Peano) Compare(arg *Peano) int {
+	a, b := p, arg
+	for !a.IsZero() && !b.IsZero() {
+		a, b = a.pred, b.pred
+	}
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return -1
+	}
+	return 1
+}
+
+// String formats p as "0", "S(0)", "S(S(0))", ...
+func (p *Peano) String() string {
+	k := int(p.N().value)
+	return strings.Repeat("S(", k) + "0" + strings.Repeat(")", k)
+}
+
+var _ = fmt.Stringer(&Peano{})
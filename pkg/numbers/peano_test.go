@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import "testing"
+
+func TestPeanoTerms(t *testing.T) {
+	zero := PeanoZero()
+	two := zero.Succ().Succ()
+	if !zero.IsZero() || two.IsZero() || two.String() != "S(S(0))" || zero.String() != "0" {
+		t.Errorf("unexpected %s, %s", zero, two)
+	}
+	if two.N().Uint64() != 2 {
+		t.Errorf("expected 2, got %s", two.N())
+	}
+	one, e := two.Pred()
+	if e != nil || one.String() != "S(0)" {
+		t.Errorf("unexpected %v (%v)", one, e)
+	}
+	if _, e := zero.Pred(); e == nil {
+		t.Error("expected error for predecessor of 0")
+	}
+	// terms share their predecessors
+	if three := two.Succ(); three.pred != two {
+		t.Error("expected shared predecessor")
+	}
+}
+
+func TestPeanoFromN(t *testing.T) {
+	for _, v := range []uint64{0, 1, 7, 300, MaxPeano} {
+		p, e := PeanoFromN(NFromUint64(v))
+		if e != nil || p.N().Uint64() != v {
+			t.Errorf("%d: got %v (%v)", v, p, e)
+		}
+	}
+	if _, e := PeanoFromN(NFromUint64(MaxPeano + 1)); e == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestParsePeano(t *testing.T) {
+	for v, expected := range map[string]uint64{"0": 0, "S(0)": 1, "S(S(S(0)))": 3} {
+		p, e := ParsePeano(v)
+		if e != nil || p.N().Uint64() != expected || p.String() != v {
+			t.Errorf("%s: got %v (%v)", v, p, e)
+		}
+	}
+	for _, v := range []string{"", "S", "S()", "S(0", "S(0))", "S(S0))", "(S0)", "S(1)", "s(0)", "SS((0))", "0)"} {
+		if _, e := ParsePeano(v); e == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+func TestPeanoArithmetic(t *testing.T) {
+	p := func(v uint64) *Peano {
+		res, _ := PeanoFromN(NFromUint64(v))
+		return res
+	}
+	for _, c := range [][2]uint64{{0, 0}, {0, 3}, {3, 0}, {2, 3}, {7, 11}, {255, 256}} {
+		sum, e := p(c[0]).Add(p(c[1]))
+		if e != nil || sum.N().Uint64() != c[0]+c[1] {
+			t.Errorf("%d + %d: got %v (%v)", c[0], c[1], sum, e)
+		}
+		product, e := p(c[0]).Multiply(p(c[1]))
+		if e != nil || product.N().Uint64() != c[0]*c[1] {
+			t.Errorf("%d · %d: got %v (%v)", c[0], c[1], product, e)
+		}
+		expected := 0
+		if c[0] < c[1] {
+			expected = -1
+		} else if c[0] > c[1] {
+			expected = 1
+		}
+		if r := p(c[0]).Compare(p(c[1])); r != expected {
+			t.Errorf("%d <=> %d: expected %d, got %d", c[0], c[1], expected, r)
+		}
+	}
+	if _, e := p(MaxPeano).Add(p(1)); e == nil {
+		t.Error("expected error for too big sum")
+	}
+	if _, e := p(257).Multiply(p(256)); e == nil {
+		t.Error("expected error for too big product")
+	}
+}
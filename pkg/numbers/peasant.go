@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math"
+)
+
+// PeasantStep is one row of Russian peasant multiplication table: the left column is halved (dropping the
+// remainder), the right column is doubled and the rows with odd left value are added to the result
+type PeasantStep struct {
+	Half   *N
+	Double *N
+	Added  bool
+}
+
+func (s PeasantStep) String() string {
+	if s.Added {
+		return fmt.Sprintf("%s\t%s\t+", s.Half, s.Double)
+	}
+	return fmt.Sprintf("%s\t%s", s.Half, s.Double)
+}
+
+// MultiplyPeasant is "Multiplication" by doubling and halving (Russian peasant or Egyptian multiplication), an
+// alternative to N.Multiply: A * B = (A / 2) * 2B for even A and (A - 1) / 2 * 2B + B for odd A. Halving and doubling
+// are single steps (shifting the binary representation), so it needs log2(A) steps instead of B additions of A.
+//
+// Panics with ErrOverflow if the result doesn't fit uint64.
+func (n *N) MultiplyPeasant(arg *N) *N {
+	var res uint64
+	for _, s := range peasant(n.value, arg.value) {
+		if s.added {
+			var e error
+			if res, e = addUint64(res, s.double); e != nil {
+				panic(e)
+			}
+		}
+	}
+	return NFromUint64(res)
+}
+
+// PeasantSteps returns the rows of Russian peasant multiplication table of A * B (A in the left column) - to see how
+// MultiplyPeasant works. The result is the sum of Double in the rows marked as Added. Doubling stops when the left
+// column reaches 1. Panics with ErrOverflow if A * B doesn't fit uint64.
+func PeasantSteps(a *N, b *N) []PeasantStep {
+	steps := peasant(a.value, b.value)
+	res := make([]PeasantStep, len(steps))
+	for i, s := range steps {
+		res[i] = PeasantStep{Half: NFromUint64(s.half), Double: NFromUint64(s.double), Added: s.added}
+	}
+	return res
+}
+
+type peasantStep struct {
+	half, double uint64
+	added        bool
+}
+
+// peasant creates the rows of the table, panics with ErrOverflow if a * b doesn't fit uint64
+func peasant(a uint64, b uint64) []peasantStep {
+	res := make([]peasantStep, 0, 64)
+	for ; a > 0; a >>= 1 {
+		res = append(res, peasantStep{half: a, double: b, added: a&1 == 1})
+		if a > 1 {
+			if b > math.MaxUint64/2 {
+				// the last row is always added and its value would be even bigger
+				panic(ErrOverflow)
+			}
+			b <<= 1
+		}
+	}
+	return res
+}
@@ -0,0 +1,77 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMultiplyPeasant(t *testing.T) {
+	for _, c := range [][3]uint64{
+		{0, 5, 0}, {5, 0, 0}, {1, 7, 7}, {7, 1, 7}, {13, 238, 3094}, {238, 13, 3094}, {1 << 32, 1<<32 - 1, 1<<64 - 1<<32},
+		{math.MaxUint64, 1, math.MaxUint64}, {1, math.MaxUint64, math.MaxUint64},
+	} {
+		if r := NFromUint64(c[0]).MultiplyPeasant(NFromUint64(c[1])); r.value != c[2] {
+			t.Errorf("%d * %d: expected %d, got %s", c[0], c[1], c[2], r)
+		}
+	}
+	// the same as N.Multiply
+	for a := uint64(0); a < 30; a++ {
+		for b := uint64(0); b < 30; b++ {
+			if p, m := NFromUint64(a).MultiplyPeasant(NFromUint64(b)), NFromUint64(a).Multiply(NFromUint64(b)); p.value != m.value {
+				t.Errorf("%d * %d: expected %s, got %s", a, b, m, p)
+			}
+		}
+	}
+	for _, c := range [][2]uint64{{1 << 32, 1 << 32}, {3, math.MaxUint64 / 2}, {math.MaxUint64, 2}} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrOverflow {
+					t.Errorf("%d * %d: expected overflow, got %v", c[0], c[1], r)
+				}
+			}()
+			NFromUint64(c[0]).MultiplyPeasant(NFromUint64(c[1]))
+		}()
+	}
+}
+
+func TestPeasantSteps(t *testing.T) {
+	// 13 * 238: 13 238 +, 6 476, 3 952 +, 1 1904 + -> 238 + 952 + 1904 = 3094
+	steps := PeasantSteps(NewN("13"), NewN("238"))
+	exp := []string{"13\t238\t+", "6\t476", "3\t952\t+", "1\t1904\t+"}
+	if len(steps) != len(exp) {
+		t.Fatalf("expected %d steps, got %d", len(exp), len(steps))
+	}
+	for i, s := range steps {
+		if s.String() != exp[i] {
+			t.Errorf("step %d: expected %q, got %q", i, exp[i], s)
+		}
+	}
+	if steps := PeasantSteps(&ZERO, NewN("5")); len(steps) != 0 {
+		t.Errorf("expected no steps for 0 * 5, got %v", steps)
+	}
+}
+
+func BenchmarkMultiplyPeasant(b *testing.B) {
+	x, y := NewN("1000"), NewN("1000")
+	for i := 0; i < b.N; i++ {
+		x.MultiplyPeasant(y)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math"
+)
+
+// SqrtContinuedFraction returns continued fraction of √d for non-square d: √d = [a0; a1, a2, ..., ar, a1, a2, ...],
+// where the period (a1, ..., ar) repeats forever and always ends with ar = 2*a0.
+//
+// For x = (√d + m) / den we have a = floor(x) = floor((a0 + m) / den) and the next complete quotient is
+// 1 / (x - a) = (√d + m') / den', where m' = den*a - m and den' = (d - m'^2) / den (the division is always exact).
+func SqrtContinuedFraction(d *N) (*N, []*N, error) {
+	if d.value > math.MaxInt64 {
+		return nil, nil, ErrOverflow
+	}
+	a0 := isqrt(d.value)
+	if a0*a0 == d.value {
+		return nil, nil, errors.New("square root of a square number is not periodic")
+	}
+	period := make([]*N, 0)
+	dd := int64(d.value)
+	var m, den, a int64 = 0, 1, int64(a0)
+	for a != 2*int64(a0) {
+		// m <= a0 and den*a <= a0 + m keep the products below d <= math.MaxInt64, but they're checked anyway
+		da, e := mulInt64(den, a)
+		if e != nil {
+			return nil, nil, e
+		}
+		m = da - m
+		mm, e := mulInt64(m, m)
+		if e != nil {
+			return nil, nil, e
+		}
+		den = (dd - mm) / den
+		a = (int64(a0) + m) / den
+		period = append(period, &N{value: uint64(a)})
+	}
+	return &N{value: a0}, period, nil
+}
+
+// Pell returns fundamental (smallest positive) solution of Pell's equation x^2 - d*y^2 = 1 for non-square d.
+//
+// It's one of the convergents h/k of √d: if the period of the continued fraction has length r, it's the convergent
+// with index r-1 when r is even and 2r-1 when r is odd. Convergents are calculated with
+// h(n) = a(n)*h(n-1) + h(n-2) and k(n) = a(n)*k(n-1) + k(n-2), starting from h(-1)/k(-1) = 1/0 and h(-2)/k(-2) = 0/1.
+func Pell(d *N) (*Z, *Z, error) {
+	a0, period, e := SqrtContinuedFraction(d)
+	if e != nil {
+		return nil, nil, e
+	}
+	r := len(period)
+	last := r - 1
+	if r%2 == 1 {
+		last = 2*r - 1
+	}
+	var h1, h2 int64 = 1, 0
+	var k1, k2 int64 = 0, 1
+	for n := 0; n <= last; n++ {
+		a := int64(a0.value)
+		if n > 0 {
+			a = int64(period[(n-1)%r].value)
+		}
+		h, e := mulInt64(a, h1)
+		if e != nil {
+			return nil, nil, e
+		}
+		if h, e = addInt64(h, h2); e != nil {
+			return nil, nil, e
+		}
+		k, e := mulInt64(a, k1)
+		if e != nil {
+			return nil, nil, e
+		}
+		if k, e = addInt64(k, k2); e != nil {
+			return nil, nil, e
+		}
+		h1, h2 = h, h1
+		k1, k2 = k, k1
+	}
+	return &Z{value: h1}, &Z{value: k1}, nil
+}
+
+// isqrt returns floor(√n)
+func isqrt(n uint64) uint64 {
+	r := uint64(math.Sqrt(float64(n)))
+	// float64 has only 53 bits of precision - correct the estimate
+	for r > 0 && (r > math.MaxUint32 || r*r > n) {
+		r--
+	}
+	for r+1 <= math.MaxUint32 && (r+1)*(r+1) <= n {
+		r++
+	}
+	return r
+}
@@ -0,0 +1,95 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func TestSqrtContinuedFraction(t *testing.T) {
+	fractions := []struct {
+		d      string
+		a0     uint64
+		period []uint64
+	}{
+		{"2", 1, []uint64{2}},
+		{"3", 1, []uint64{1, 2}},
+		{"7", 2, []uint64{1, 1, 1, 4}},
+		{"13", 3, []uint64{1, 1, 1, 1, 6}},
+		{"14", 3, []uint64{1, 2, 1, 6}},
+		{"114", 10, []uint64{1, 2, 10, 2, 1, 20}},
+		{"9223372030926249002", 3037000499, []uint64{6074000998}},
+		{"9223372030926249000", 3037000498, []uint64{1, 6074000996}},
+	}
+	for _, f := range fractions {
+		a0, period, e := SqrtContinuedFraction(NewN(f.d))
+		if e != nil {
+			t.Errorf("√%s: %s", f.d, e)
+			continue
+		}
+		if a0.value != f.a0 || len(period) != len(f.period) {
+			t.Errorf("√%s: expected %d and period %v, got %s and %v", f.d, f.a0, f.period, a0, period)
+			continue
+		}
+		for i := range period {
+			if period[i].value != f.period[i] {
+				t.Errorf("√%s: expected period %v, got %v", f.d, f.period, period)
+				break
+			}
+		}
+	}
+	if _, _, e := SqrtContinuedFraction(NewN("16")); e == nil {
+		t.Errorf("√16: expected error")
+	}
+}
+
+func TestPell(t *testing.T) {
+	solutions := []struct {
+		d    string
+		x, y int64
+	}{
+		{"2", 3, 2},
+		{"3", 2, 1},
+		{"5", 9, 4},
+		{"13", 649, 180},
+		{"61", 1766319049, 226153980},
+		{"109", 158070671986249, 15140424455100},
+	}
+	for _, s := range solutions {
+		x, y, e := Pell(NewN(s.d))
+		if e != nil || x.value != s.x || y.value != s.y {
+			t.Errorf("x^2 - %s*y^2 = 1: expected (%d, %d), got (%v, %v) (%v)", s.d, s.x, s.y, x, y, e)
+		}
+	}
+	if _, _, e := Pell(NewN("9")); e == nil {
+		t.Errorf("x^2 - 9*y^2 = 1: expected error")
+	}
+	if _, _, e := Pell(NewN("661")); e != ErrOverflow {
+		t.Errorf("x^2 - 661*y^2 = 1: expected overflow, got %v", e)
+	}
+}
+
+func TestIsqrt(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 4, 15, 16, 17, 1<<62 - 1, 1 << 62, 1<<64 - 1} {
+		r := isqrt(n)
+		if r*r > n || (r+1 <= 1<<32-1 && (r+1)*(r+1) <= n) {
+			t.Errorf("isqrt(%d): got %d", n, r)
+		}
+	}
+}
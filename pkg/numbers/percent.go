@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// hundred is 100% - shared, because values of ℚ are immutable
+var hundred = Q{a: 100, b: 1}
+
+// Percent formats q as percentage: 3/8 is "37.5%". The result is exact when q*100 has finite decimal expansion
+// (its denominator has no other prime factors than 2 and 5), otherwise it's rounded to 2 decimal places (1/3 is
+// "33.33%"), halves away from zero. Panics with ErrOverflow if q*100 doesn't fit int64.
+func (q *Q) Percent() string {
+	return q.PercentWith(RoundHalfUp)
+}
+
+// PercentWith is Percent rounding to 2 decimal places using given mode (2/3 is "66.66%" with RoundDown)
+func (q *Q) PercentWith(mode RoundingMode) string {
+	p := mustQ(q.multiply(&hundred))
+	digits := terminatingDigits(p.b)
+	if digits < 0 {
+		digits = 2
+	}
+	s, _ := Locale{Decimal: "."}.FormatQWith(p, digits, mode)
+	return s + "%"
+}
+
+// ParsePercent creates ℚ from percentage: "12.5%" is 1/8. The number is parsed with ParseScientific.
+func ParsePercent(v string) (*Q, error) {
+	s, ok := strings.CutSuffix(strings.TrimSpace(v), "%")
+	if !ok {
+		return nil, fmt.Errorf("missing %% in %q", v)
+	}
+	p, e := ParseScientific(strings.TrimSpace(s))
+	if e != nil {
+		return nil, e
+	}
+	return p.divide(&hundred)
+}
+
+// PercentOf calculates percent % of whole: 12.5% of 16 is 2
+func PercentOf(percent *Q, whole *Q) (*Q, error) {
+	p, e := percent.divide(&hundred)
+	if e != nil {
+		return nil, e
+	}
+	return p.multiply(whole)
+}
+
+// PercentChange calculates relative change from "from" to "to" in percents: (to - from) / from * 100. from can't be
+// ZERO.
+func PercentChange(from *Q, to *Q) (*Q, error) {
+	if from.a == 0 {
+		return nil, errors.New("can't calculate change from ZERO")
+	}
+	d, e := to.subtract(from)
+	if e != nil {
+		return nil, e
+	}
+	if d, e = d.divide(from); e != nil {
+		return nil, e
+	}
+	return d.multiply(&hundred)
+}
+
+// terminatingDigits returns the number of fractional digits of decimal expansion of 1/b or -1 if the expansion is
+// infinite. 1/b is finite if b = 2^x * 5^y and it needs max(x, y) digits.
+func terminatingDigits(b int64) int {
+	twos, fives := 0, 0
+	for ; b%2 == 0; b /= 2 {
+		twos++
+	}
+	for ; b%5 == 0; b /= 5 {
+		fives++
+	}
+	if b != 1 {
+		return -1
+	}
+	return max(twos, fives)
+}
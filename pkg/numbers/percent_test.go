@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func TestPercent(t *testing.T) {
+	for q, exp := range map[string]string{
+		"3/8":   "37.5%",
+		"1/2":   "50%",
+		"-1/8":  "-12.5%",
+		"0/1":   "0%",
+		"3/1":   "300%",
+		"1/3":   "33.33%",
+		"2/3":   "66.67%",
+		"1/400": "0.25%",
+		"1/64":  "1.5625%",
+	} {
+		if s := NewQ(q).Percent(); s != exp {
+			t.Errorf("%s: expected %q, got %q", q, exp, s)
+		}
+	}
+}
+
+func TestParsePercent(t *testing.T) {
+	for s, exp := range map[string]string{
+		"12.5%":  "1/8",
+		"50 %":   "1/2",
+		"-200%":  "-2/1",
+		"0.25%":  "1/400",
+		" 100% ": "1/1",
+
+		"50.0000000000000000000000%": "1/2",
+	} {
+		q, e := ParsePercent(s)
+		if e != nil || q.String() != exp {
+			t.Errorf("%q: expected %s, got %v, %v", s, exp, q, e)
+		}
+	}
+	for _, s := range []string{"12.5", "%", "x%", "1%%"} {
+		if _, e := ParsePercent(s); e == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	q, e := PercentOf(NewQ("25/2"), NewQ("16/1"))
+	checkQ(t, "12.5% of 16", q, 2, 1)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, _ = PercentOf(NewQ("1/3"), NewQ("3/1"))
+	checkQ(t, "1/3% of 3", q, 1, 100)
+}
+
+func TestPercentChange(t *testing.T) {
+	q, e := PercentChange(NewQ("80/1"), NewQ("100/1"))
+	checkQ(t, "80 -> 100", q, 25, 1)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, _ = PercentChange(NewQ("100/1"), NewQ("80/1"))
+	checkQ(t, "100 -> 80", q, -20, 1)
+	q, _ = PercentChange(NewQ("-2/1"), NewQ("-1/1"))
+	checkQ(t, "-2 -> -1", q, -50, 1)
+	if _, e := PercentChange(ZeroQ(), OneQ()); e == nil {
+		t.Errorf("expected error for change from ZERO")
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// IrrationalError is returned when the exact result exists, but it's not in ℚ - like 2^(1/2)
+type IrrationalError struct {
+	Base     *Q
+	Exponent *Q
+}
+
+func (e *IrrationalError) Error() string {
+	return fmt.Sprintf("(%s)^(%s) is irrational", e.Base, e.Exponent)
+}
+
+// PowerQ returns q^exp when the result is in ℚ: (4/9)^(1/2) = 2/3, 8^(2/3) = 4, (1/4)^(-1/2) = 2.
+//
+// For exp = P/R (in lowest terms), q^exp = (R-th root of q)^P and R-th root of A/B is in ℚ only when both A and B are
+// R-th powers of integers (A/B is in lowest terms). Returns *IrrationalError if it's not the case, an error when
+// the result is not a real number (even root of negative number) or ZERO is raised to negative power and ErrOverflow
+// when it doesn't fit int64.
+func (q *Q) PowerQ(exp *Q) (*Q, error) {
+	p, r := exp.Ratio()
+	a, b := q.Ratio()
+	if a < 0 && r%2 == 0 {
+		return nil, fmt.Errorf("(%s)^(%s) is not a real number", q, exp)
+	}
+	ra, ok := iroot(modarith.AbsUint64(a), uint64(r))
+	if !ok {
+		return nil, &IrrationalError{Base: q, Exponent: exp}
+	}
+	rb, ok := iroot(uint64(b), uint64(r))
+	if !ok {
+		return nil, &IrrationalError{Base: q, Exponent: exp}
+	}
+	if ra > math.MaxInt64 {
+		// only (-2^63)^(1/1)
+		return q.powerZ(p)
+	}
+	root := &Q{a: int64(ra), b: int64(rb)}
+	if a < 0 {
+		root.a = -root.a
+	}
+	return root.powerZ(p)
+}
+
+// powerZ returns q^e for integer e by repeated squaring
+func (q *Q) powerZ(e int64) (*Q, error) {
+	base := q
+	if e < 0 {
+		if q.a == 0 {
+			return nil, errors.New("can't raise ZERO to negative power")
+		}
+		var err error
+		if base, err = OneQ().divide(q); err != nil {
+			return nil, err
+		}
+	}
+	res := OneQ()
+	for n := modarith.AbsUint64(e); n > 0; n >>= 1 {
+		var err error
+		if n&1 == 1 {
+			if res, err = res.multiply(base); err != nil {
+				return nil, err
+			}
+		}
+		if n > 1 {
+			if base, err = base.multiply(base); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// iroot returns k-th root of n (k > 0), exact is true if n is k-th power of the result
+func iroot(n uint64, k uint64) (root uint64, exact bool) {
+	if n <= 1 || k == 1 {
+		return n, true
+	}
+	// n < 2^64, so the root is smaller than 2^ceil(64/k)
+	lo, hi := uint64(1), uint64(1)<<min((bits.Len64(n)+int(k)-1)/int(k), 63)
+	for lo < hi {
+		// the biggest m with m^k <= n
+		m := lo + (hi-lo+1)/2
+		if v, e := powUint64(m, k); e != nil || v > n {
+			hi = m - 1
+		} else {
+			lo = m
+		}
+	}
+	v, _ := powUint64(lo, k)
+	return lo, v == n
+}
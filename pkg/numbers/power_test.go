@@ -0,0 +1,93 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPowerQ(t *testing.T) {
+	for _, c := range []struct {
+		q, exp, res string
+	}{
+		{"4/9", "1/2", "2/3"},
+		{"8/1", "2/3", "4/1"},
+		{"1/4", "-1/2", "2/1"},
+		{"-8/27", "1/3", "-2/3"},
+		{"-8/27", "2/3", "4/9"},
+		{"-8/27", "-1/3", "-3/2"},
+		{"2/3", "3/1", "8/27"},
+		{"2/3", "-2/1", "9/4"},
+		{"5/7", "0/1", "1/1"},
+		{"0/1", "0/1", "1/1"},
+		{"0/1", "1/2", "0/1"},
+		{"1/1", "1/1000000", "1/1"},
+		{"-9223372036854775807/1", "1/1", "-9223372036854775807/1"},
+		{"1/3", "39/1", "1/4052555153018976267"},
+		{"2/1", "62/1", "4611686018427387904/1"},
+	} {
+		r, e := NewQ(c.q).PowerQ(NewQ(c.exp))
+		if e != nil || r.String() != c.res {
+			t.Errorf("(%s)^(%s): expected %s, got %v, %v", c.q, c.exp, c.res, r, e)
+		}
+	}
+	r, e := (&Q{a: math.MinInt64, b: 1}).PowerQ(OneQ())
+	checkQ(t, "MinInt64^1", r, math.MinInt64, 1)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	r, _ = NewQ("4611686018427387904/1").PowerQ(NewQ("1/62"))
+	checkQ(t, "2^62^(1/62)", r, 2, 1)
+}
+
+func TestPowerQErrors(t *testing.T) {
+	for _, c := range [][2]string{{"2/1", "1/2"}, {"4/3", "1/2"}, {"3/4", "1/2"}, {"9223372036854775807/1", "1/3"}} {
+		_, e := NewQ(c[0]).PowerQ(NewQ(c[1]))
+		var ie *IrrationalError
+		if !errors.As(e, &ie) || ie.Base.String() != NewQ(c[0]).String() {
+			t.Errorf("(%s)^(%s): expected irrational result, got %v", c[0], c[1], e)
+		}
+	}
+	if _, e := NewQ("-4/1").PowerQ(NewQ("1/2")); e == nil {
+		t.Errorf("expected error for square root of negative number")
+	}
+	if _, e := ZeroQ().PowerQ(NewQ("-1/1")); e == nil {
+		t.Errorf("expected error for 0^-1")
+	}
+	if _, e := NewQ("2/1").PowerQ(NewQ("63/1")); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestIroot(t *testing.T) {
+	for _, c := range []struct {
+		n, k, root uint64
+		exact      bool
+	}{
+		{0, 5, 0, true}, {1, 5, 1, true}, {16, 2, 4, true}, {17, 2, 4, false}, {27, 3, 3, true}, {26, 3, 2, false},
+		{math.MaxUint64, 2, math.MaxUint32, false}, {1 << 63, 63, 2, true}, {math.MaxUint64, 64, 1, false},
+		{math.MaxUint64, 1000, 1, false}, {4294967296, 1, 4294967296, true},
+	} {
+		if r, exact := iroot(c.n, c.k); r != c.root || exact != c.exact {
+			t.Errorf("%d-th root of %d: expected %d (%v), got %d (%v)", c.k, c.n, c.root, c.exact, r, exact)
+		}
+	}
+}
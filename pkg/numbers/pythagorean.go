@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// PythagoreanTriple is a solution of A^2 + B^2 = C^2 in ℕ with A < B
+type PythagoreanTriple struct {
+	A, B, C *N
+}
+
+func (p *PythagoreanTriple) String() string {
+	return fmt.Sprintf("(%s, %s, %s)", p.A, p.B, p.C)
+}
+
+// PythagoreanTriples enumerates primitive Pythagorean triples (A, B and C without common divisor) using Euclid's
+// formula: for coprime m > n > 0 of opposite parity, (m^2 - n^2, 2mn, m^2 + n^2) is primitive and every primitive
+// triple is created this way exactly once.
+//
+// maxHypotenuse limits C and maxPerimeter limits A + B + C, nil means no limit, but at least one limit is required.
+// Triples are ordered by C, then by A.
+func PythagoreanTriples(maxHypotenuse *N, maxPerimeter *N) ([]*PythagoreanTriple, error) {
+	if maxHypotenuse == nil && maxPerimeter == nil {
+		return nil, errors.New("there are infinitely many Pythagorean triples, a limit is required")
+	}
+	res := make([]*PythagoreanTriple, 0)
+	// c = m^2 + n^2 > m^2 and a + b + c = 2m(m + n) > 2m^2, so m is bounded by any of the limits
+	for m := uint64(2); ; m++ {
+		if maxHypotenuse != nil && m*m >= maxHypotenuse.value {
+			break
+		}
+		if maxPerimeter != nil && 2*m*m >= maxPerimeter.value {
+			break
+		}
+		for n := m%2 + 1; n < m; n += 2 {
+			if gcdInt64(int64(m), int64(n)) != 1 {
+				continue
+			}
+			a, b, c := m*m-n*n, 2*m*n, m*m+n*n
+			if maxHypotenuse != nil && c > maxHypotenuse.value {
+				continue
+			}
+			if maxPerimeter != nil && a+b+c > maxPerimeter.value {
+				continue
+			}
+			if a > b {
+				a, b = b, a
+			}
+			res = append(res, &PythagoreanTriple{A: &N{value: a}, B: &N{value: b}, C: &N{value: c}})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].C.value != res[j].C.value {
+			return res[i].C.value < res[j].C.value
+		}
+		return res[i].A.value < res[j].A.value
+	})
+	return res, nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPythagoreanTriplesByHypotenuse(t *testing.T) {
+	triples, e := PythagoreanTriples(NewN("65"), nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	expected := "[(3, 4, 5) (5, 12, 13) (8, 15, 17) (7, 24, 25) (20, 21, 29) (12, 35, 37) (9, 40, 41) (28, 45, 53) " +
+		"(11, 60, 61) (16, 63, 65) (33, 56, 65)]"
+	if s := fmt.Sprintf("%s", triples); s != expected {
+		t.Errorf("expected %s, got %s", expected, s)
+	}
+	for _, p := range triples {
+		if p.A.value*p.A.value+p.B.value*p.B.value != p.C.value*p.C.value {
+			t.Errorf("%s is not a Pythagorean triple", p)
+		}
+	}
+}
+
+func TestPythagoreanTriplesByPerimeter(t *testing.T) {
+	triples, e := PythagoreanTriples(nil, NewN("60"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if s := fmt.Sprintf("%s", triples); s != "[(3, 4, 5) (5, 12, 13) (8, 15, 17) (7, 24, 25)]" {
+		t.Errorf("expected [(3, 4, 5) (5, 12, 13) (8, 15, 17) (7, 24, 25)], got %s", s)
+	}
+	triples, e = PythagoreanTriples(NewN("25"), NewN("39"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if s := fmt.Sprintf("%s", triples); s != "[(3, 4, 5) (5, 12, 13)]" {
+		t.Errorf("expected [(3, 4, 5) (5, 12, 13)], got %s", s)
+	}
+}
+
+func TestPythagoreanTriplesWithoutLimit(t *testing.T) {
+	if _, e := PythagoreanTriples(nil, nil); e == nil {
+		t.Errorf("expected error")
+	}
+}
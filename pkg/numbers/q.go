@@ -19,10 +19,20 @@
 package numbers
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
 )
 
 // Rational numbers ℚ - needed to define negative power or division in ℤ
+//
+// Values of ℚ are immutable, just as values of ℕ. Every ℚ is created in canonical form: nominator and denominator
+// have no common divisor and denominator is always positive (the sign is kept in nominator), so equal numbers have
+// equal representation (2/4 and 1/-2 are created as 1/2 and -1/2) and operations can rely on it. The zero value Q{}
+// is not a valid number - use ZeroQ().
 type Q struct {
 	a int64
 	b int64
@@ -30,26 +40,96 @@ type Q struct {
 	fmt.Stringer
 }
 
+var (
+	// well known numbers - shared, because values of ℚ are immutable
+	qZero = Q{a: 0, b: 1}
+	qOne  = Q{a: 1, b: 1}
+)
+
+// ZeroQ returns ℚ 0/1
+func ZeroQ() *Q {
+	return &qZero
+}
+
+// OneQ returns ℚ 1/1
+func OneQ() *Q {
+	return &qOne
+}
+
 // NewQ Creates new ℚ from string
 func NewQ(v string) *Q {
 	var a, b int64
 	_, e := fmt.Sscanf(v, "%d/%d", &a, &b)
 	if e == nil {
-		q, _ := (&Q{a: int64(a), b: int64(b)}).GCD()
-		return q
+		var q *Q
+		if q, e = QFromInts(a, b); e == nil {
+			return q
+		}
 	}
 
 	panic(fmt.Errorf("%s", e))
 }
 
+// QFromInts creates new ℚ num/den from machine integers, reduced to lowest terms with the sign in nominator
+func QFromInts(num int64, den int64) (*Q, error) {
+	if den == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	return reduceQ(num, den)
+}
+
+// GCDStrategy finds the greatest common divisor of two ℕ - it's used to reduce ℚ to lowest terms
+type GCDStrategy func(a *N, b *N) *N
+
+// Euclid is the default GCDStrategy: gcd(A, B) = gcd(B, A mod B) and gcd(A, 0) = A
+func Euclid(a *N, b *N) *N {
+	x, y := a.value, b.value
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return NFromUint64(x)
+}
+
+// QFromIntsWith is QFromInts using given strategy to reduce num/den to lowest terms - num and den are divided by the
+// number the strategy returns. It has to be the greatest common divisor: an error is returned if it doesn't divide
+// both numbers or if the results still have a common divisor.
+func QFromIntsWith(num int64, den int64, gcd GCDStrategy) (*Q, error) {
+	if den == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	g := gcd(NFromUint64(modarith.AbsUint64(num)), NFromUint64(modarith.AbsUint64(den))).value
+	if g == 0 || modarith.AbsUint64(num)%g != 0 || modarith.AbsUint64(den)%g != 0 {
+		return nil, fmt.Errorf("%d is not a common divisor of %d and %d", g, num, den)
+	}
+	if g == 1<<63 {
+		// MinInt64 / MinInt64 is 1/1 and 0 / MinInt64 is 0/1 - g doesn't fit int64
+		return signedQ(num/math.MinInt64, 1)
+	}
+	a, b := num/int64(g), den/int64(g)
+	if c := gcdInt64(a, b); c != 1 && c != -1 {
+		return nil, fmt.Errorf("%d is not the greatest common divisor of %d and %d", g, num, den)
+	}
+	return signedQ(a, b)
+}
+
 // DefQ creates new ℚ as a result of dividing two ℤs - definition of ℚ.
 //
 // if A < B, B is decreased (using division by A) to 1 and resulting (A / B) is called "rational number"
+//
+// b must be different than ZERO and the result is kept in canonical form, DefQ panics if it's not possible.
 func DefQ(a *Z, b *Z) *Q {
-	return &Q{a: a.value, b: b.value} // definition - by division of integer numbers
+	res, e := QFromInts(a.value, b.value) // definition - by division of integer numbers
+	if e != nil {
+		panic(e)
+	}
+	return res
 }
 
 type QOperations interface {
+	Add(*Q) *Q
+	Multiply(*Q) *Q
+	Subtract(*Q) *Q
+	Divide(*Q) (*Q, error)
 }
 
 // Trim tries to minimize nominator and denominator
@@ -89,15 +169,225 @@ func (q *Q) GCD() (*Q, error) {
 	az, _, _ := (&Z{value: q.a}).Divide(gcdz)
 	bz, _, _ := (&Z{value: q.b}).Divide(gcdz)
 	if az.value < 0 && bz.value < 0 {
-		az.value = -az.value
-		bz.value = -bz.value
+		return &Q{a: -az.value, b: -bz.value}, nil
 	}
 	return &Q{a: az.value, b: bz.value}, nil
 }
 
+// A/B + C/D: (A/B + C/D) * BD = AD + CB -> A/B + C/D = (AD + CB) / BD
+//
+// panics with ErrOverflow if the result in lowest terms doesn't fit int64 - use AddChecked to get an error instead
+func (q *Q) Add(arg *Q) *Q {
+	return mustQ(q.add(arg))
+}
+
+// A/B * C/D: (A/B * C/D) * BD = (A/B * B) * (C/D * D) = AC -> A/B * C/D = AC / BD
+//
+// panics with ErrOverflow if the result in lowest terms doesn't fit int64 - use MultiplyChecked to get an error
+// instead
+func (q *Q) Multiply(arg *Q) *Q {
+	return mustQ(q.multiply(arg))
+}
+
+// A/B - C/D = x -> A/B = x + C/D -> x = A/B + (-C)/D
+//
+// panics with ErrOverflow if the result in lowest terms doesn't fit int64 - use SubtractChecked to get an error
+// instead
+func (q *Q) Subtract(arg *Q) *Q {
+	return mustQ(q.subtract(arg))
+}
+
+// A/B / C/D = x -> A/B = x * C/D -> x = A/B * D/C (C != 0)
+//
+// returns ErrOverflow if the result in lowest terms doesn't fit int64
+func (q *Q) Divide(arg *Q) (*Q, error) {
+	if arg.a == 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	return q.divide(arg)
+}
+
+// AddChecked is Q.Add returning ErrOverflow instead of panic
+func (q *Q) AddChecked(arg *Q) (*Q, error) {
+	return q.add(arg)
+}
+
+// SubtractChecked is Q.Subtract returning ErrOverflow instead of panic
+func (q *Q) SubtractChecked(arg *Q) (*Q, error) {
+	return q.subtract(arg)
+}
+
+// Abs returns |q|. It panics for -2^63/b, whose absolute value doesn't fit int64 - see AbsChecked.
+func (q *Q) Abs() *Q {
+	return mustQ(q.AbsChecked())
+}
+
+// AbsChecked is Q.Abs returning ErrOverflow instead of panic
+func (q *Q) AbsChecked() (*Q, error) {
+	switch {
+	case q.a >= 0:
+		return q, nil
+	case q.a == math.MinInt64:
+		return nil, ErrOverflow
+	}
+	return &Q{a: -q.a, b: q.b}, nil
+}
+
+// MultiplyChecked is Q.Multiply returning ErrOverflow instead of panic
+func (q *Q) MultiplyChecked(arg *Q) (*Q, error) {
+	return q.multiply(arg)
+}
+
+// add is Q.Add reporting overflow as an error. Intermediate values may overflow even if the result fits, then it's
+// calculated again with math/big.
+func (q *Q) add(arg *Q) (*Q, error) {
+	if r, e := q.add64(arg); e == nil {
+		return r, nil
+	}
+	return bigFallback((*big.Rat).Add, q, arg)
+}
+
+// add64 adds using only int64 values
+func (q *Q) add64(arg *Q) (*Q, error) {
+	// dividing by gcd(B, D) first keeps the intermediate values as small as possible
+	g := gcdInt64(q.b, arg.b)
+	ad, e := mulInt64(q.a, arg.b/g)
+	if e != nil {
+		return nil, e
+	}
+	cb, e := mulInt64(arg.a, q.b/g)
+	if e != nil {
+		return nil, e
+	}
+	a, e := addInt64(ad, cb)
+	if e != nil {
+		return nil, e
+	}
+	b, e := mulInt64(q.b/g, arg.b)
+	if e != nil {
+		return nil, e
+	}
+	return reduceQ(a, b)
+}
+
+// subtract is Q.Subtract reporting overflow as an error
+func (q *Q) subtract(arg *Q) (*Q, error) {
+	c, e := mulInt64(arg.a, -1)
+	if e != nil {
+		// -MinInt64
+		return bigFallback((*big.Rat).Sub, q, arg)
+	}
+	return q.add(&Q{a: c, b: arg.b}) // -C/D is canonical when C/D is
+}
+
+// multiply is Q.Multiply reporting overflow as an error
+func (q *Q) multiply(arg *Q) (*Q, error) {
+	if r, e := q.multiply64(arg); e == nil {
+		return r, nil
+	}
+	return bigFallback((*big.Rat).Mul, q, arg)
+}
+
+// multiply64 multiplies using only int64 values
+func (q *Q) multiply64(arg *Q) (*Q, error) {
+	// cross-reducing A/D and C/B first keeps the intermediate values as small as possible
+	g1 := gcdInt64(q.a, arg.b)
+	g2 := gcdInt64(arg.a, q.b)
+	a, e := mulInt64(q.a/g1, arg.a/g2)
+	if e != nil {
+		return nil, e
+	}
+	b, e := mulInt64(q.b/g2, arg.b/g1)
+	if e != nil {
+		return nil, e
+	}
+	return reduceQ(a, b)
+}
+
+// divide is Q.Divide reporting overflow as an error, arg has to be different than ZERO
+func (q *Q) divide(arg *Q) (*Q, error) {
+	switch {
+	case arg.a == math.MinInt64:
+		// the reciprocal doesn't fit int64
+		return bigFallback((*big.Rat).Quo, q, arg)
+	case arg.a < 0:
+		// keep the sign in nominator of the reciprocal
+		return q.multiply(&Q{a: -arg.b, b: -arg.a})
+	}
+	return q.multiply(&Q{a: arg.b, b: arg.a})
+}
+
+// bigFallback calculates op(q, arg) with math/big - the big-number backend of ℚ, used when int64 isn't enough for
+// intermediate values. Returns ErrOverflow if the result in lowest terms still doesn't fit int64.
+func bigFallback(op func(z, x, y *big.Rat) *big.Rat, q *Q, arg *Q) (*Q, error) {
+	return QFromRat(op(new(big.Rat), q.Rat(), arg.Rat()))
+}
+
+// Rat returns q as big.Rat - for calculations whose intermediate values don't fit int64
+func (q *Q) Rat() *big.Rat {
+	return new(big.Rat).SetFrac64(q.a, q.b)
+}
+
+// QFromRat creates ℚ from big.Rat, or returns ErrOverflow if its nominator or denominator doesn't fit int64
+func QFromRat(r *big.Rat) (*Q, error) {
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return nil, ErrOverflow
+	}
+	// big.Rat is always in lowest terms with positive denominator
+	return &Q{a: r.Num().Int64(), b: r.Denom().Int64()}, nil
+}
+
+// reduceQ creates ℚ in canonical form - in lowest terms with the sign kept in nominator, b has to be different than
+// ZERO
+func reduceQ(a int64, b int64) (*Q, error) {
+	g := gcdInt64(a, b)
+	if g < 0 {
+		// gcd of math.MinInt64 and its multiple
+		g = -g
+	}
+	return signedQ(a/g, b/g)
+}
+
+// signedQ returns a/b, already in lowest terms, with the sign moved to the numerator
+func signedQ(a int64, b int64) (*Q, error) {
+	if b < 0 {
+		var e error
+		if a, e = mulInt64(a, -1); e != nil {
+			return nil, e
+		}
+		if b, e = mulInt64(b, -1); e != nil {
+			return nil, e
+		}
+	}
+	return &Q{a: a, b: b}, nil
+}
+
+// mustQ turns an overflow reported by checked operation into panic
+func mustQ(q *Q, e error) *Q {
+	if e != nil {
+		panic(e)
+	}
+	return q
+}
+
+// String formats q as "A/B" - q is already in lowest terms, so there's nothing to calculate
 func (q *Q) String() string {
-	_q, _ := q.GCD()
-	return fmt.Sprintf("%d/%d", _q.a, _q.b)
+	return fmt.Sprintf("%d/%d", q.a, q.b)
+}
+
+// Ratio returns nominator and denominator of q in lowest terms, with denominator always positive
+func (q *Q) Ratio() (a int64, b int64) {
+	return q.a, q.b
+}
+
+// Float64 returns the nearest float64 value for q, exact is true if it represents q exactly (like 3/8, but not 1/3)
+func (q *Q) Float64() (v float64, exact bool) {
+	return q.Rat().Float64()
+}
+
+// Clone returns a copy of q
+func (q *Q) Clone() *Q {
+	return &Q{a: q.a, b: q.b}
 }
 
 var _ = fmt.Stringer(&Q{})
@@ -19,7 +19,10 @@
 package numbers
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -28,3 +31,254 @@ func TestStringerInQ(t *testing.T) {
 	fmt.Printf("%s\n", NewQ("-12/15"))
 	fmt.Printf("%s\n", NewQ("-12/-16"))
 }
+
+func TestAddQ(t *testing.T) {
+	checkQ(t, "1/2 + 1/3", NewQ("1/2").Add(NewQ("1/3")), 5, 6)
+	checkQ(t, "1/2 + 1/-3", NewQ("1/2").Add(NewQ("1/-3")), 1, 6)
+	checkQ(t, "-1/2 + -1/2", NewQ("-1/2").Add(NewQ("-1/2")), -1, 1)
+	checkQ(t, "1/6 + 1/3", NewQ("1/6").Add(NewQ("1/3")), 1, 2)
+	checkQ(t, "3/4 + -3/4", NewQ("3/4").Add(NewQ("-3/4")), 0, 1)
+}
+
+func TestSubtractQ(t *testing.T) {
+	checkQ(t, "1/2 - 1/3", NewQ("1/2").Subtract(NewQ("1/3")), 1, 6)
+	checkQ(t, "1/3 - 1/2", NewQ("1/3").Subtract(NewQ("1/2")), -1, 6)
+	checkQ(t, "1/2 - 1/-2", NewQ("1/2").Subtract(NewQ("1/-2")), 1, 1)
+	checkQ(t, "5/6 - 1/3", NewQ("5/6").Subtract(NewQ("1/3")), 1, 2)
+}
+
+func TestMultiplyQ(t *testing.T) {
+	checkQ(t, "2/3 * 3/4", NewQ("2/3").Multiply(NewQ("3/4")), 1, 2)
+	checkQ(t, "-2/3 * 3/4", NewQ("-2/3").Multiply(NewQ("3/4")), -1, 2)
+	checkQ(t, "2/3 * 3/-4", NewQ("2/3").Multiply(NewQ("3/-4")), -1, 2)
+	checkQ(t, "-2/3 * -3/4", NewQ("-2/3").Multiply(NewQ("-3/4")), 1, 2)
+	checkQ(t, "0/5 * 3/4", NewQ("0/5").Multiply(NewQ("3/4")), 0, 1)
+}
+
+func TestDivideQ(t *testing.T) {
+	q, e := NewQ("1/2").Divide(NewQ("3/4"))
+	if e != nil {
+		t.Fatalf("1/2 / 3/4: %s", e)
+	}
+	checkQ(t, "1/2 / 3/4", q, 2, 3)
+	q, e = NewQ("1/2").Divide(NewQ("-3/4"))
+	if e != nil {
+		t.Fatalf("1/2 / -3/4: %s", e)
+	}
+	checkQ(t, "1/2 / -3/4", q, -2, 3)
+	q, e = NewQ("-1/2").Divide(NewQ("3/-4"))
+	if e != nil {
+		t.Fatalf("-1/2 / 3/-4: %s", e)
+	}
+	checkQ(t, "-1/2 / 3/-4", q, 2, 3)
+	if _, e = NewQ("1/2").Divide(NewQ("0/3")); e == nil {
+		t.Errorf("1/2 / 0/3: expected error")
+	}
+}
+
+func TestOverflowQ(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrOverflow {
+			t.Errorf("expected overflow, got %v", r)
+		}
+	}()
+	big := &Q{a: 1 << 62, b: 1}
+	big.Multiply(&Q{a: 4, b: 1})
+}
+
+func TestCheckedQ(t *testing.T) {
+	// AD + CB overflows, but the result fits
+	q, e := NewQ("9223372036854775807/1").AddChecked(NewQ("-9223372036854775807/2"))
+	checkQ(t, "MaxInt64 - MaxInt64/2", q, math.MaxInt64, 2)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, e = NewQ("-1/1").SubtractChecked(&Q{a: math.MinInt64, b: 1})
+	checkQ(t, "-1 - MinInt64", q, math.MaxInt64, 1)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, e = (&Q{a: math.MinInt64, b: 1}).Divide(&Q{a: math.MinInt64, b: 1})
+	checkQ(t, "MinInt64 / MinInt64", q, 1, 1)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, e = NewQ("3/2").MultiplyChecked(NewQ("4/9"))
+	checkQ(t, "3/2 * 4/9", q, 2, 3)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+
+	// the result doesn't fit
+	if _, e = NewQ("9223372036854775807/1").AddChecked(OneQ()); e != ErrOverflow {
+		t.Errorf("MaxInt64 + 1: expected overflow, got %v", e)
+	}
+	if _, e = ZeroQ().SubtractChecked(&Q{a: math.MinInt64, b: 1}); e != ErrOverflow {
+		t.Errorf("0 - MinInt64: expected overflow, got %v", e)
+	}
+	if _, e = NewQ("4294967296/1").MultiplyChecked(NewQ("4294967296/1")); e != ErrOverflow {
+		t.Errorf("2^32 * 2^32: expected overflow, got %v", e)
+	}
+	if _, e = NewQ("2/1").Divide(NewQ("1/9223372036854775807")); e != ErrOverflow {
+		t.Errorf("2 / (1/MaxInt64): expected overflow, got %v", e)
+	}
+}
+
+func checkQ(t *testing.T, label string, q *Q, a int64, b int64) {
+	if q.a != a || q.b != b {
+		t.Errorf("%s: expected %d/%d, got %d/%d", label, a, b, q.a, q.b)
+	}
+}
+
+func TestImmutabilityQ(t *testing.T) {
+	a := NewQ("-1/2")
+	b := NewQ("3/-4")
+	a.Add(b)
+	a.Subtract(b)
+	a.Multiply(b)
+	a.Divide(b)
+	a.GCD()
+	checkQ(t, "-1/2 after operations", a, -1, 2)
+	checkQ(t, "3/-4 after operations", b, -3, 4)
+	if c := a.Clone(); c == a || c.a != a.a || c.b != a.b {
+		t.Errorf("clone of %s: got %s", a, c)
+	}
+}
+
+func TestNativeQ(t *testing.T) {
+	if a, b := NewQ("6/-8").Ratio(); a != -3 || b != 4 {
+		t.Errorf("Ratio of 6/-8: expected -3/4, got %d/%d", a, b)
+	}
+	if v, exact := NewQ("3/8").Float64(); !exact || v != 0.375 {
+		t.Errorf("Float64 of 3/8: got %v, %t", v, exact)
+	}
+	if v, exact := NewQ("-1/3").Float64(); exact || v != -1.0/3 {
+		t.Errorf("Float64 of -1/3: got %v, %t", v, exact)
+	}
+}
+
+func TestQFromInts(t *testing.T) {
+	q, e := QFromInts(6, -8)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkQ(t, "6/-8", q, -3, 4)
+	if _, e = QFromInts(1, 0); e == nil {
+		t.Errorf("1/0: expected error")
+	}
+}
+
+func TestConstantsQ(t *testing.T) {
+	OneQ().Add(ZeroQ()).Multiply(NewQ("1/2"))
+	checkQ(t, "ZeroQ", ZeroQ(), 0, 1)
+	checkQ(t, "OneQ", OneQ(), 1, 1)
+}
+
+func TestCanonicalQ(t *testing.T) {
+	checkQ(t, "1/-2", NewQ("1/-2"), -1, 2)
+	checkQ(t, "-6/-8", NewQ("-6/-8"), 3, 4)
+	checkQ(t, "0/-5", NewQ("0/-5"), 0, 1)
+	checkQ(t, "4 / -6", DefQ(NewZ("4"), NewZ("-6")), -2, 3)
+	checkQ(t, "MinInt64 / -2", DefQ(ZFromInt64(math.MinInt64), NewZ("-2")), 1<<62, 1)
+	_, q, _ := NewZ("-2").Divide(NewZ("-4"))
+	checkQ(t, "-2 / -4", q, 1, 2)
+	for v, expected := range map[string]string{"1/0": "can't divide by ZERO", "x/2": "expected integer"} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s: expected panic", v)
+				} else if e, ok := r.(error); !ok || e.Error() != expected {
+					t.Errorf("%s: expected panic with %q, got %v", v, expected, r)
+				}
+			}()
+			NewQ(v)
+		}()
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("DefQ(1, 0): expected panic")
+			}
+		}()
+		DefQ(OneZ(), ZeroZ())
+	}()
+}
+
+func TestStringQ(t *testing.T) {
+	// reducing this with Euclid's algorithm built on Z.DivideR would take ages
+	if s := NewQ("9223372036854775806/4").String(); s != "4611686018427387903/2" {
+		t.Errorf("expected 4611686018427387903/2, got %s", s)
+	}
+	if s := NewQ("-174611/330").String(); s != "-174611/330" {
+		t.Errorf("expected -174611/330, got %s", s)
+	}
+}
+
+func TestQFromIntsWith(t *testing.T) {
+	q, e := QFromIntsWith(12, -18, Euclid)
+	checkQ(t, "12/-18", q, -2, 3)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, _ = QFromIntsWith(math.MinInt64, math.MinInt64, Euclid)
+	checkQ(t, "MinInt64/MinInt64", q, 1, 1)
+	q, _ = QFromIntsWith(0, -5, Euclid)
+	checkQ(t, "0/-5", q, 0, 1)
+
+	if _, e = QFromIntsWith(1, 0, Euclid); e == nil {
+		t.Errorf("expected error for ZERO denominator")
+	}
+	wrong := func(a *N, b *N) *N { return NewN("5") }
+	if _, e = QFromIntsWith(12, 18, wrong); e == nil {
+		t.Errorf("expected error for wrong gcd")
+	}
+	// the strategy decides, so one not returning the greatest common divisor is caught
+	one := func(a *N, b *N) *N { return OneN() }
+	if _, e = QFromIntsWith(12, 18, one); e == nil {
+		t.Errorf("expected error for common divisor which is not the greatest")
+	}
+	q, e = QFromIntsWith(7, -9, one)
+	checkQ(t, "7/-9", q, -7, 9)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	calls := 0
+	counting := func(a *N, b *N) *N {
+		calls++
+		return Euclid(a, b)
+	}
+	q, _ = QFromIntsWith(math.MinInt64, 6, counting)
+	checkQ(t, "MinInt64/6", q, math.MinInt64/2, 3)
+	if calls != 1 {
+		t.Errorf("expected the strategy to be used once, got %d calls", calls)
+	}
+	if g := Euclid(NewN("0"), NewN("0")); g.value != 0 {
+		t.Errorf("gcd(0, 0): expected 0, got %s", g)
+	}
+}
+
+func TestRatQ(t *testing.T) {
+	for _, v := range []string{"0/1", "-3/4", "9223372036854775807/2", "-9223372036854775808/1"} {
+		q := NewQ(v)
+		if r, e := QFromRat(q.Rat()); e != nil || r.Compare(q) != 0 {
+			t.Errorf("%s: unexpected %v (%v)", v, r, e)
+		}
+	}
+	if _, e := QFromRat(big.NewRat(math.MaxInt64, 1).Add(big.NewRat(math.MaxInt64, 1), big.NewRat(1, 1))); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := QFromRat(big.NewRat(1, math.MaxInt64).Mul(big.NewRat(1, math.MaxInt64), big.NewRat(1, 2))); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestAbsQ(t *testing.T) {
+	for v, expected := range map[string]string{"0/1": "0/1", "3/4": "3/4", "-3/4": "3/4", "-9223372036854775807/2": "9223372036854775807/2"} {
+		if r := NewQ(v).Abs(); r.String() != expected {
+			t.Errorf("|%s|: expected %s, got %s", v, expected, r)
+		}
+	}
+	if _, e := NewQ("-9223372036854775808/1").AbsChecked(); !errors.Is(e, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// Generate implements quick.Generator, so testing/quick can create random ℕ for properties taking *N. The values are
+// at most size - arithmetic of ℕ is repeated addition, so big values would make the properties too slow to check.
+// Use CorpusN to check the edge cases. n is not used and may be nil.
+func (n *N) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(NFromUint64(uint64(r.Intn(size + 1))))
+}
+
+// Generate implements quick.Generator for *Z - see N.Generate. The values are between -size and size.
+func (z *Z) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(ZFromInt64(int64(r.Intn(2*size+1) - size)))
+}
+
+// Generate implements quick.Generator for *Q - see N.Generate. Nominator is between -size and size and denominator
+// between 1 and size, before reducing to lowest terms.
+func (q *Q) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(mustQ(QFromInts(int64(r.Intn(2*size+1)-size), int64(r.Intn(max(size, 1))+1))))
+}
+
+// CorpusN returns edge cases of ℕ - ZERO, ONE, the end of interned values and the limits of machine integers. Fuzz
+// tests can be seeded with f.Add(v) for each value and create the ℕ with NFromUint64. Operations of ℕ defined by
+// repeated addition are too slow for the biggest values.
+func CorpusN() []uint64 {
+	return []uint64{0, 1, 2, 10, maxInterned, maxInterned + 1, math.MaxInt64, math.MaxInt64 + 1, math.MaxUint64}
+}
+
+// CorpusZ returns edge cases of ℤ for ZFromInt64 - see CorpusN
+func CorpusZ() []int64 {
+	return []int64{0, 1, -1, 2, -2, maxInterned, maxInterned + 1, -maxInterned - 1, math.MaxInt64, math.MinInt64,
+		math.MinInt64 + 1}
+}
+
+// CorpusQ returns edge cases of ℚ as nominator and denominator for QFromInts - see CorpusN. All of them are valid,
+// but not all are in lowest terms.
+func CorpusQ() [][2]int64 {
+	return [][2]int64{
+		{0, 1}, {1, 1}, {-1, 1}, {1, 2}, {-1, 2}, {2, 4}, {1, -3}, {22, 7}, {1, math.MaxInt64},
+		{math.MaxInt64, 1}, {math.MinInt64, 1}, {-1, math.MaxInt64}, {math.MaxInt64, math.MaxInt64 - 1},
+	}
+}
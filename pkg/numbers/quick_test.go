@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerate(t *testing.T) {
+	if e := quick.Check(func(a *N, b *N) bool {
+		return a.value <= 50 && a.Add(b).value == b.Add(a).value
+	}, nil); e != nil {
+		t.Error(e)
+	}
+	if e := quick.Check(func(a *Z, b *Z) bool {
+		return a.value >= -50 && a.value <= 50 && a.Subtract(b).Add(b).value == a.value
+	}, nil); e != nil {
+		t.Error(e)
+	}
+	if e := quick.Check(func(a *Q, b *Q) bool {
+		return b.b >= 1 && b.b <= 50 && a.Multiply(b).Compare(b.Multiply(a)) == 0
+	}, nil); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	for _, v := range CorpusN() {
+		if NFromUint64(v).value != v {
+			t.Errorf("%d is not ℕ", v)
+		}
+	}
+	for _, v := range CorpusZ() {
+		if ZFromInt64(v).value != v {
+			t.Errorf("%d is not ℤ", v)
+		}
+	}
+	for _, v := range CorpusQ() {
+		if _, e := QFromInts(v[0], v[1]); e != nil {
+			t.Errorf("%d/%d is not ℚ: %v", v[0], v[1], e)
+		}
+	}
+}
+
+func FuzzQAddSubtract(f *testing.F) {
+	for _, v := range CorpusQ() {
+		f.Add(v[0], v[1])
+	}
+	f.Fuzz(func(t *testing.T, a int64, b int64) {
+		q, e := QFromInts(a, b)
+		if e != nil {
+			return
+		}
+		s, e := q.AddChecked(OneQ())
+		if e != nil {
+			return
+		}
+		if d, e := s.SubtractChecked(OneQ()); e != nil || d.Compare(q) != 0 {
+			t.Errorf("%s + 1 - 1 = %v, %v", q, d, e)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxQuotedToken is how many runes of an invalid token are quoted in errors of ReadN, ReadZ and ReadQ
+const maxQuotedToken = 32
+
+// ReadN reads ℕ accepted by ParseN from r. Whitespace is skipped, then the token is read up to the next whitespace
+// (which is consumed too) or the end of input. Runes are parsed as they're read, so even a very long token is never
+// kept in memory. io.EOF is returned if there's no token left. After an error the rest of the token is skipped, so
+// reading can continue with the next one. r is read one byte at a time unless it's io.RuneReader (like bufio.Reader),
+// so nothing after the token is consumed.
+func ReadN(r io.Reader) (*N, error) {
+	p := newNaturalParser()
+	token, e := readToken(r, p.feed)
+	if e != nil {
+		return nil, e
+	}
+	n, e := p.result()
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℕ %q: %w", token, e)
+	}
+	return NFromUint64(n), nil
+}
+
+// ReadZ reads ℤ accepted by ParseZ from r like ReadN does
+func ReadZ(r io.Reader) (*Z, error) {
+	p := newIntegerParser()
+	token, e := readToken(r, p.feed)
+	if e != nil {
+		return nil, e
+	}
+	z, e := p.result()
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℤ %q: %w", token, e)
+	}
+	return ZFromInt64(z), nil
+}
+
+// ReadQ reads ℚ accepted by ParseQ from r like ReadN does
+func ReadQ(r io.Reader) (*Q, error) {
+	num, den := newIntegerParser(), newIntegerParser()
+	var fraction bool
+	token, e := readToken(r, func(c rune) {
+		switch {
+		case fraction:
+			den.feed(c)
+		case c == '/' || c == '⁄':
+			fraction = true
+		default:
+			num.feed(c)
+		}
+	})
+	if e != nil {
+		return nil, e
+	}
+	a, e := num.result()
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℚ %q: %w", token, e)
+	}
+	var b int64 = 1
+	if fraction {
+		if b, e = den.result(); e != nil {
+			return nil, fmt.Errorf("invalid ℚ %q: %w", token, e)
+		}
+	}
+	q, e := QFromInts(a, b)
+	if e != nil {
+		return nil, fmt.Errorf("invalid ℚ %q: %w", token, e)
+	}
+	return q, nil
+}
+
+// readToken skips whitespace and passes the runes of the next token to feed. It returns the beginning of the token
+// for error messages ("…" marks the rest) or io.EOF if there's no token.
+func readToken(r io.Reader, feed func(rune)) (string, error) {
+	rr, ok := r.(io.RuneReader)
+	if !ok {
+		rr = &byteRuneReader{r: r}
+	}
+	var quoted strings.Builder
+	runes := 0
+	for {
+		c, _, e := rr.ReadRune()
+		if e == io.EOF {
+			if runes == 0 {
+				return "", io.EOF
+			}
+			break
+		}
+		if e != nil {
+			return "", e
+		}
+		if unicode.IsSpace(c) {
+			if runes == 0 {
+				continue
+			}
+			break
+		}
+		switch runes++; {
+		case runes <= maxQuotedToken:
+			quoted.WriteRune(c)
+		case runes == maxQuotedToken+1:
+			quoted.WriteRune('…')
+		}
+		feed(c)
+	}
+	return quoted.String(), nil
+}
+
+// byteRuneReader reads UTF-8 runes one byte at a time, so it never reads past the rune
+type byteRuneReader struct {
+	r io.Reader
+}
+
+func (b *byteRuneReader) ReadRune() (rune, int, error) {
+	var buf [utf8.UTFMax]byte
+	n := 0
+	for n == 0 || n < utf8.UTFMax && !utf8.FullRune(buf[:n]) {
+		if _, e := io.ReadFull(b.r, buf[n:n+1]); e != nil {
+			if n > 0 && e == io.EOF {
+				// truncated rune
+				break
+			}
+			return 0, 0, e
+		}
+		n++
+	}
+	c, _ := utf8.DecodeRune(buf[:n])
+	return c, n, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadN(t *testing.T) {
+	r := strings.NewReader("  42\n0x_FF\t2⁵ 1_000")
+	for _, exp := range []uint64{42, 255, 32, 1000} {
+		n, e := ReadN(r)
+		if e != nil || n.Uint64() != exp {
+			t.Errorf("expected %d, got %v, %v", exp, n, e)
+		}
+	}
+	if _, e := ReadN(r); e != io.EOF {
+		t.Errorf("expected EOF, got %v", e)
+	}
+}
+
+func TestReadNAgreesWithParseN(t *testing.T) {
+	for _, v := range []string{"0", "007", "2⁶³", "0B_1010_1010", "0x10²", "18446744073709551616", "2⁶⁴", "-1",
+		"1.5", "²", "2²a", "_1", "1_", "0x", "0b102", "0_x1", "1_²", "0d10"} {
+		expected, pe := ParseN(v)
+		n, e := ReadN(strings.NewReader(v))
+		if (pe == nil) != (e == nil) || pe != nil && pe.Error() != e.Error() ||
+			pe == nil && n.Uint64() != expected.Uint64() {
+			t.Errorf("%q: ParseN gives %v, %v, ReadN gives %v, %v", v, expected, pe, n, e)
+		}
+	}
+}
+
+func TestReadNLongTokens(t *testing.T) {
+	// a million leading zeros - the token isn't kept in memory
+	zeros := io.MultiReader(strings.NewReader(strings.Repeat("0", 1_000_000)), strings.NewReader("123 7"))
+	n, e := ReadN(zeros)
+	if e != nil || n.Uint64() != 123 {
+		t.Errorf("expected 123, got %v, %v", n, e)
+	}
+	// too long number is skipped completely, so reading continues with the next token
+	r := strings.NewReader(strings.Repeat("9", 100) + " 7")
+	if _, e := ReadN(r); !errors.Is(e, ErrOverflow) || !strings.Contains(e.Error(), `"`+strings.Repeat("9", 32)+`…"`) {
+		t.Errorf("expected overflow with shortened token, got %v", e)
+	}
+	if n, e := ReadN(r); e != nil || n.Uint64() != 7 {
+		t.Errorf("expected 7, got %v, %v", n, e)
+	}
+}
+
+func TestReadNDoesNotReadAhead(t *testing.T) {
+	r := strings.NewReader("12 34")
+	if _, e := ReadN(iotest.OneByteReader(r)); e != nil {
+		t.Fatal(e)
+	}
+	if rest, _ := io.ReadAll(r); string(rest) != "34" {
+		t.Errorf("expected the rest to stay in reader, got %q", rest)
+	}
+	// bufio.Reader is io.RuneReader, so it's used directly
+	b := bufio.NewReader(strings.NewReader("5²\n6"))
+	if n, e := ReadN(b); e != nil || n.Uint64() != 25 {
+		t.Errorf("expected 25, got %v, %v", n, e)
+	}
+	if n, e := ReadN(b); e != nil || n.Uint64() != 6 {
+		t.Errorf("expected 6, got %v, %v", n, e)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	broken := errors.New("broken")
+	if _, e := ReadN(iotest.ErrReader(broken)); e != broken {
+		t.Errorf("expected read error, got %v", e)
+	}
+	if _, e := ReadZ(iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("12")))); e != iotest.ErrTimeout {
+		t.Errorf("expected timeout, got %v", e)
+	}
+	if _, e := ReadN(strings.NewReader("1\xff")); e == nil {
+		t.Error("expected error for invalid UTF-8")
+	}
+}
+
+func TestReadZ(t *testing.T) {
+	r := strings.NewReader("-42 +7 −2⁵ 9223372036854775807 -9223372036854775808")
+	for _, exp := range []int64{-42, 7, -32, 9223372036854775807, -9223372036854775808} {
+		z, e := ReadZ(r)
+		if e != nil || z.Int64() != exp {
+			t.Errorf("expected %d, got %v, %v", exp, z, e)
+		}
+	}
+	for _, v := range []string{"-", "--1", "1-", "9223372036854775808"} {
+		_, pe := ParseZ(v)
+		if _, e := ReadZ(strings.NewReader(v)); e == nil || e.Error() != pe.Error() {
+			t.Errorf("%q: expected %v, got %v", v, pe, e)
+		}
+	}
+}
+
+func TestReadQ(t *testing.T) {
+	r := strings.NewReader("3/4 −6⁄8 5 10/-4")
+	for _, exp := range []string{"3/4", "-3/4", "5/1", "-5/2"} {
+		q, e := ReadQ(r)
+		if e != nil || q.String() != exp {
+			t.Errorf("expected %s, got %v, %v", exp, q, e)
+		}
+	}
+	for _, v := range []string{"1/0", "3/", "/3", "1/2/3", "x/2", "1/x"} {
+		_, pe := ParseQ(v)
+		if _, e := ReadQ(strings.NewReader(v)); e == nil || e.Error() != pe.Error() {
+			t.Errorf("%q: expected %v, got %v", v, pe, e)
+		}
+	}
+}
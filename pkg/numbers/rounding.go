@@ -0,0 +1,134 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// RoundingMode decides what to do with the digits that don't fit the result
+type RoundingMode int
+
+const (
+	// RoundDown rounds towards zero (truncation)
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundHalfUp rounds to nearest, halves away from zero (like at school)
+	RoundHalfUp
+	// RoundHalfDown rounds to nearest, halves towards zero
+	RoundHalfDown
+	// RoundHalfEven rounds to nearest, halves to even neighbour (banker's rounding)
+	RoundHalfEven
+	// RoundCeiling rounds towards +∞
+	RoundCeiling
+	// RoundFloor rounds towards -∞
+	RoundFloor
+)
+
+var roundingModeNames = []string{"Down", "Up", "HalfUp", "HalfDown", "HalfEven", "Ceiling", "Floor"}
+
+func (m RoundingMode) String() string {
+	if m < 0 || int(m) >= len(roundingModeNames) {
+		return "RoundingMode(?)"
+	}
+	return roundingModeNames[m]
+}
+
+// ParseRoundingMode returns the mode with given name, like "HalfEven" - see RoundingMode.String. Case is ignored.
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for m, n := range roundingModeNames {
+		if strings.EqualFold(n, name) {
+			return RoundingMode(m), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown rounding mode %q, expected one of %s", name, strings.Join(roundingModeNames, ", "))
+}
+
+// Round returns q rounded to integer using given mode: 5/2 is 3 with RoundHalfUp and 2 with RoundHalfEven
+func (q *Q) Round(mode RoundingMode) *Z {
+	t, r := q.a/q.b, q.a%q.b
+	if r == 0 {
+		return ZFromInt64(t)
+	}
+	// B > 1, so t + 1 and t - 1 fit int64
+	if roundsAway(mode, q.a < 0, compareHalf(modarith.AbsUint64(r), uint64(q.b)), t%2 != 0) {
+		if q.a < 0 {
+			return ZFromInt64(t - 1)
+		}
+		return ZFromInt64(t + 1)
+	}
+	return ZFromInt64(t)
+}
+
+// DecimalFromQ returns q as Decimal with given scale, rounded using given mode if needed: 2/3 with scale 2 is 0.67
+// with RoundHalfUp and 0.66 with RoundDown
+func DecimalFromQ(q *Q, scale int, mode RoundingMode) (*Decimal, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return nil, fmt.Errorf("scale has to be between 0 and %d", MaxDecimalScale)
+	}
+	n := new(big.Int).Mul(big.NewInt(q.a), pow10(scale))
+	return decimalFromBig(roundDiv(n, big.NewInt(q.b), mode), scale)
+}
+
+// roundsAway decides if truncated result has to be moved to the next integer away from zero. neg is the sign of the
+// exact result, half compares the dropped (non-zero) part with one half and odd tells if the truncated result is odd.
+func roundsAway(mode RoundingMode, neg bool, half int, odd bool) bool {
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return !neg
+	case RoundFloor:
+		return neg
+	case RoundHalfUp, RoundHalfDown, RoundHalfEven:
+		return half > 0 || half == 0 && (mode == RoundHalfUp || mode == RoundHalfEven && odd)
+	}
+	return false
+}
+
+// compareHalf compares r/d with 1/2, 0 <= r < d
+func compareHalf(r uint64, d uint64) int {
+	// d - r > 0, so r < d - r iff r/d < 1/2 - and there's no overflow of 2*r
+	switch {
+	case r < d-r:
+		return -1
+	case r > d-r:
+		return 1
+	}
+	return 0
+}
+
+// roundDiv returns n / d rounded to integer using given mode, d has to be positive
+func roundDiv(n *big.Int, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// q is truncated, so the only other candidate is the next integer away from zero
+	sign := n.Sign()
+	if roundsAway(mode, sign < 0, new(big.Int).Lsh(r.Abs(r), 1).Cmp(d), q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+func TestRoundDiv(t *testing.T) {
+	// n/2 for n = -5..5 - halves everywhere except the even n
+	exp := map[RoundingMode][]int64{
+		RoundDown:     {-2, -2, -1, -1, 0, 0, 0, 1, 1, 2, 2},
+		RoundUp:       {-3, -2, -2, -1, -1, 0, 1, 1, 2, 2, 3},
+		RoundHalfUp:   {-3, -2, -2, -1, -1, 0, 1, 1, 2, 2, 3},
+		RoundHalfDown: {-2, -2, -1, -1, 0, 0, 0, 1, 1, 2, 2},
+		RoundHalfEven: {-2, -2, -2, -1, 0, 0, 0, 1, 2, 2, 2},
+		RoundCeiling:  {-2, -2, -1, -1, 0, 0, 1, 1, 2, 2, 3},
+		RoundFloor:    {-3, -2, -2, -1, -1, 0, 0, 1, 1, 2, 2},
+	}
+	for mode, values := range exp {
+		for i, v := range values {
+			n := int64(i - 5)
+			if r := roundDiv(big.NewInt(n), big.NewInt(2), mode); r.Int64() != v {
+				t.Errorf("%s: %d/2 expected %d, got %d", mode, n, v, r)
+			}
+		}
+	}
+	// not a half
+	for mode, v := range map[RoundingMode]int64{RoundHalfUp: -2, RoundHalfDown: -2, RoundHalfEven: -2} {
+		if r := roundDiv(big.NewInt(-7), big.NewInt(4), mode); r.Int64() != v {
+			t.Errorf("%s: -7/4 expected %d, got %d", mode, v, r)
+		}
+	}
+	if s := RoundHalfEven.String(); s != "HalfEven" {
+		t.Errorf("expected HalfEven, got %s", s)
+	}
+}
+
+func TestRoundingIsConsistent(t *testing.T) {
+	// every API rounding ℚ has to agree with roundDiv: n/2 -> Round, n/20 -> 1 fractional digit, ...
+	for m := RoundDown; m <= RoundFloor; m++ {
+		for n := int64(-7); n <= 7; n++ {
+			exp := roundDiv(big.NewInt(n), big.NewInt(2), m).Int64()
+			if r := NewQ(fmt.Sprintf("%d/2", n)).Round(m); r.value != exp {
+				t.Errorf("%s: %d/2 rounded to %d, expected %d", m, n, r.value, exp)
+			}
+			frac := fmt.Sprintf("%d.%d", exp/10, modarith.AbsUint64(exp%10))
+			if exp < 0 && exp > -10 {
+				frac = "-" + frac
+			}
+			if s, _ := (Locale{Decimal: "."}).FormatQWith(NewQ(fmt.Sprintf("%d/20", n)), 1, m); s != frac {
+				t.Errorf("%s: %d/20 formatted as %s, expected %s", m, n, s, frac)
+			}
+			if d, e := DecimalFromQ(NewQ(fmt.Sprintf("%d/20", n)), 1, m); e != nil || d.String() != frac {
+				t.Errorf("%s: %d/20 as Decimal %v (%v), expected %s", m, n, d, e, frac)
+			}
+			if n%2 != 0 && (exp < -1 || exp > 1) {
+				// n/2 with 1 significant digit, when it's not rounded to 0 or 10
+				sci := fmt.Sprintf("%de0", exp)
+				if s, _ := NewQ(fmt.Sprintf("%d/2", n)).ScientificWith(1, m); s != sci {
+					t.Errorf("%s: %d/2 in scientific notation %s, expected %s", m, n, s, sci)
+				}
+			}
+		}
+	}
+	if s := NewQ("2/3").PercentWith(RoundDown); s != "66.66%" {
+		t.Errorf("expected 66.66%%, got %s", s)
+	}
+	if s := NewQ("2/3").Percent(); s != "66.67%" {
+		t.Errorf("expected 66.67%%, got %s", s)
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	for m := RoundDown; m <= RoundFloor; m++ {
+		if p, e := ParseRoundingMode(m.String()); e != nil || p != m {
+			t.Errorf("%s parsed as %s (%v)", m, p, e)
+		}
+	}
+	if m, e := ParseRoundingMode("halfeven"); e != nil || m != RoundHalfEven {
+		t.Errorf("halfeven parsed as %s (%v)", m, e)
+	}
+	if _, e := ParseRoundingMode("nearest"); e == nil {
+		t.Error("nearest: expected error")
+	}
+	if _, e := DecimalFromQ(OneQ(), MaxDecimalScale+1, RoundDown); e == nil {
+		t.Error("too big scale: expected error")
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scientific formats q in scientific notation d.ddd×10^E as "d.ddde±E" with given number of significant digits
+// (3/2000 with 2 digits is "1.5e-3"). The last digit is rounded to nearest, halves away from zero - see
+// ScientificWith.
+func (q *Q) Scientific(digits int) (string, error) {
+	return q.ScientificWith(digits, RoundHalfUp)
+}
+
+// ScientificWith is Scientific rounding the last digit using given mode
+func (q *Q) ScientificWith(digits int, mode RoundingMode) (string, error) {
+	if digits < 1 {
+		return "", errors.New("number of significant digits has to be positive")
+	}
+	a, b := q.Ratio()
+	num := big.NewInt(a)
+	sign := ""
+	if num.Sign() < 0 {
+		sign = "-"
+		num.Neg(num)
+	}
+	den := big.NewInt(b)
+	if num.Sign() == 0 {
+		return scientific("", strings.Repeat("0", digits), 0), nil
+	}
+
+	// exponent estimated from number of digits is correct or too big by 1
+	exp := len(num.String()) - len(den.String())
+	if exp >= 0 && num.Cmp(new(big.Int).Mul(den, pow10(exp))) < 0 ||
+		exp < 0 && new(big.Int).Mul(num, pow10(-exp)).Cmp(den) < 0 {
+		exp--
+	}
+
+	// significand = round(|q| * 10^(digits-1-exp))
+	shift := digits - 1 - exp
+	n, d := new(big.Int).Set(num), new(big.Int).Set(den)
+	if shift >= 0 {
+		n.Mul(n, pow10(shift))
+	} else {
+		d.Mul(d, pow10(-shift))
+	}
+	if sign != "" {
+		// the sign matters for RoundCeiling and RoundFloor
+		n.Neg(n)
+	}
+	s := roundDiv(n, d, mode)
+	significand := s.Abs(s).String()
+	if len(significand) > digits {
+		// rounded up to the next power of 10
+		significand = significand[:digits]
+		exp++
+	}
+	return scientific(sign, significand, exp), nil
+}
+
+// scientific puts decimal point after the first digit of significand
+func scientific(sign string, significand string, exp int) string {
+	res := sign + significand[:1]
+	if len(significand) > 1 {
+		res += "." + significand[1:]
+	}
+	return res + "e" + strconv.Itoa(exp)
+}
+
+// ParseScientific creates exact ℚ from decimal number, optionally in scientific notation: "1.5e-3" is 3/2000.
+// Returns ErrOverflow if the reduced nominator or denominator doesn't fit int64.
+func ParseScientific(v string) (*Q, error) {
+	s := v
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent in %q", v)
+		}
+		exp = e
+		s = s[:i]
+	}
+	integer, frac, _ := strings.Cut(s, ".")
+	if integer+frac == "" || !allDigits(integer) || !allDigits(frac) {
+		return nil, fmt.Errorf("invalid decimal number %q", v)
+	}
+
+	digits := strings.TrimLeft(integer+frac, "0")
+	if digits == "" {
+		return ZeroQ(), nil
+	}
+	exp -= len(frac)
+	// trailing zeros only move the decimal point: 0.500 is 5e-1
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	digits = trimmed
+	if len(digits)+exp > 20 || -exp-len(digits) > 20 {
+		// at least 10^20, or the reduced denominator at least 10^-exp / digits > 10^20, can't be represented - and
+		// we don't want to calculate such powers
+		return nil, ErrOverflow
+	}
+	num, _ := new(big.Int).SetString(digits, 10)
+	den := big.NewInt(1)
+	if exp >= 0 {
+		num.Mul(num, pow10(exp))
+	} else {
+		den = pow10(-exp)
+	}
+	if neg {
+		num.Neg(num)
+	}
+	r := new(big.Rat).SetFrac(num, den)
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return nil, ErrOverflow
+	}
+	return reduceQ(r.Num().Int64(), r.Denom().Int64())
+}
+
+// allDigits checks if s contains only decimal digits
+func allDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// pow10 returns 10^e, e >= 0
+func pow10(e int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e)), nil)
+}
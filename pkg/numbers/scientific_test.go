@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+func TestScientific(t *testing.T) {
+	for _, c := range []struct {
+		q      string
+		digits int
+		exp    string
+	}{
+		{"3/2000", 2, "1.5e-3"},
+		{"3/2000", 4, "1.500e-3"},
+		{"3/2000", 1, "2e-3"},
+		{"-3/2000", 2, "-1.5e-3"},
+		{"0/1", 3, "0.00e0"},
+		{"1/1", 1, "1e0"},
+		{"1/3", 5, "3.3333e-1"},
+		{"2/3", 5, "6.6667e-1"},
+		{"9995/1", 3, "1.00e4"},
+		{"999/1000", 2, "1.0e0"},
+		{"1/10", 1, "1e-1"},
+		{"9223372036854775807/1", 3, "9.22e18"},
+		{"1/9223372036854775807", 3, "1.08e-19"},
+	} {
+		s, e := NewQ(c.q).Scientific(c.digits)
+		if e != nil || s != c.exp {
+			t.Errorf("%s (%d): expected %q, got %q, %v", c.q, c.digits, c.exp, s, e)
+		}
+	}
+	if _, e := NewQ("1/2").Scientific(0); e == nil {
+		t.Errorf("expected error for 0 significant digits")
+	}
+}
+
+func TestParseScientific(t *testing.T) {
+	for s, exp := range map[string]string{
+		"1.5e-3":   "3/2000",
+		"1.5E-3":   "3/2000",
+		"-1.5e-3":  "-3/2000",
+		"+2.5":     "5/2",
+		"0.125":    "1/8",
+		"12e2":     "1200/1",
+		".5":       "1/2",
+		"5.":       "5/1",
+		"0e9999":   "0/1",
+		"00.00":    "0/1",
+		"1e18":     "1000000000000000000/1",
+		"100e-20":  "1/1000000000000000000",
+		"6.02e-10": "301/500000000000",
+
+		"0.500000000000000000000":       "1/2",
+		"1.00000000000000000000000000":  "1/1",
+		"2500000000000000000000e-21":    "5/2",
+		"476837158203125e-21":           "1/2097152",
+		"-0.0000000000000000000001e+22": "-1/1",
+	} {
+		q, e := ParseScientific(s)
+		if e != nil || q.String() != exp {
+			t.Errorf("%s: expected %s, got %v, %v", s, exp, q, e)
+		}
+	}
+	for _, s := range []string{"", "e5", ".", "1e", "1.5.3", "1e+-2", "x", "1,5", "--1"} {
+		if _, e := ParseScientific(s); e == nil || e == ErrOverflow {
+			t.Errorf("%q: expected syntax error, got %v", s, e)
+		}
+	}
+	for _, s := range []string{"1e19", "1e-19", "1e400", "1e-400"} {
+		if _, e := ParseScientific(s); e != ErrOverflow {
+			t.Errorf("%q: expected overflow, got %v", s, e)
+		}
+	}
+
+	// round trip
+	q := NewQ("3/2000")
+	s, _ := q.Scientific(2)
+	if r, _ := ParseScientific(s); r.Compare(q) != 0 {
+		t.Errorf("round trip of %s: got %s", q, r)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math/bits"
+	"slices"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+)
+
+// Compare returns -1 if n < arg, 0 if n = arg and +1 if n > arg
+func (n *N) Compare(arg *N) int {
+	switch {
+	case n.value < arg.value:
+		return -1
+	case n.value > arg.value:
+		return 1
+	}
+	return 0
+}
+
+// Compare returns -1 if z < arg, 0 if z = arg and +1 if z > arg
+func (z *Z) Compare(arg *Z) int {
+	switch {
+	case z.value < arg.value:
+		return -1
+	case z.value > arg.value:
+		return 1
+	}
+	return 0
+}
+
+// Compare returns -1 if q < arg, 0 if q = arg and +1 if q > arg
+//
+// A/B < C/D with B, D > 0 -> A/B * BD < C/D * BD -> AD < CB. AD and CB may not fit int64, so they're compared as
+// 128-bit products - Compare never overflows and doesn't allocate.
+func (q *Q) Compare(arg *Q) int {
+	return compareProducts(q.a, arg.b, arg.a, q.b)
+}
+
+// CompareN compares two ℕ - it can be used with slices.SortFunc, slices.BinarySearchFunc, ...
+func CompareN(a *N, b *N) int {
+	return a.Compare(b)
+}
+
+// CompareZ compares two ℤ - it can be used with slices.SortFunc, slices.BinarySearchFunc, ...
+func CompareZ(a *Z, b *Z) int {
+	return a.Compare(b)
+}
+
+// CompareQ compares two ℚ - it can be used with slices.SortFunc, slices.BinarySearchFunc, ...
+func CompareQ(a *Q, b *Q) int {
+	return a.Compare(b)
+}
+
+// SortN sorts the slice in increasing order
+func SortN(s []*N) {
+	slices.SortStableFunc(s, CompareN)
+}
+
+// SortZ sorts the slice in increasing order
+func SortZ(s []*Z) {
+	slices.SortStableFunc(s, CompareZ)
+}
+
+// SortQ sorts the slice in increasing order
+func SortQ(s []*Q) {
+	slices.SortStableFunc(s, CompareQ)
+}
+
+// MinN returns the smallest element of the slice or nil if it's empty
+func MinN(s []*N) *N {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MinFunc(s, CompareN)
+}
+
+// MaxN returns the largest element of the slice or nil if it's empty
+func MaxN(s []*N) *N {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MaxFunc(s, CompareN)
+}
+
+// MinZ returns the smallest element of the slice or nil if it's empty
+func MinZ(s []*Z) *Z {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MinFunc(s, CompareZ)
+}
+
+// MaxZ returns the largest element of the slice or nil if it's empty
+func MaxZ(s []*Z) *Z {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MaxFunc(s, CompareZ)
+}
+
+// MinQ returns the smallest element of the slice or nil if it's empty
+func MinQ(s []*Q) *Q {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MinFunc(s, CompareQ)
+}
+
+// MaxQ returns the largest element of the slice or nil if it's empty
+func MaxQ(s []*Q) *Q {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.MaxFunc(s, CompareQ)
+}
+
+// compareProducts returns -1 if a*b < c*d, 0 if a*b = c*d and +1 if a*b > c*d, calculating the products exactly in
+// 128 bits
+func compareProducts(a int64, b int64, c int64, d int64) int {
+	neg1, hi1, lo1 := mul128(a, b)
+	neg2, hi2, lo2 := mul128(c, d)
+	switch {
+	case neg1 && !neg2:
+		return -1
+	case !neg1 && neg2:
+		return 1
+	}
+	res := 0
+	switch {
+	case hi1 < hi2 || hi1 == hi2 && lo1 < lo2:
+		res = -1
+	case hi1 > hi2 || hi1 == hi2 && lo1 > lo2:
+		res = 1
+	}
+	if neg1 {
+		// both products are negative - the bigger magnitude is the smaller number
+		return -res
+	}
+	return res
+}
+
+// mul128 returns a*b as a sign and 128-bit magnitude, ZERO is never negative
+func mul128(a int64, b int64) (neg bool, hi uint64, lo uint64) {
+	hi, lo = bits.Mul64(modarith.AbsUint64(a), modarith.AbsUint64(b))
+	return (a < 0) != (b < 0) && hi|lo != 0, hi, lo
+}
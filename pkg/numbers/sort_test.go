@@ -0,0 +1,123 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSortN(t *testing.T) {
+	s := []*N{NewN("42"), NewN("7"), NewN("0"), NewN("7"), NewN("100")}
+	SortN(s)
+	if r := fmt.Sprintf("%s", s); r != "[0 7 7 42 100]" {
+		t.Errorf("expected [0 7 7 42 100], got %s", r)
+	}
+	if i, found := slices.BinarySearchFunc(s, NewN("42"), CompareN); !found || i != 3 {
+		t.Errorf("42: expected at 3, got %d, %t", i, found)
+	}
+	if m := MinN(s); m.value != 0 {
+		t.Errorf("min: expected 0, got %s", m)
+	}
+	if m := MaxN(s); m.value != 100 {
+		t.Errorf("max: expected 100, got %s", m)
+	}
+	if MinN(nil) != nil || MaxN([]*N{}) != nil {
+		t.Errorf("min/max of empty slice: expected nil")
+	}
+}
+
+func TestSortZ(t *testing.T) {
+	s := []*Z{NewZ("-3"), NewZ("10"), NewZ("0"), NewZ("-30")}
+	SortZ(s)
+	if r := fmt.Sprintf("%s", s); r != "[-30 -3 0 10]" {
+		t.Errorf("expected [-30 -3 0 10], got %s", r)
+	}
+	if m := MinZ(s); m.value != -30 {
+		t.Errorf("min: expected -30, got %s", m)
+	}
+	if m := MaxZ(s); m.value != 10 {
+		t.Errorf("max: expected 10, got %s", m)
+	}
+}
+
+func TestSortQ(t *testing.T) {
+	s := []*Q{NewQ("1/2"), NewQ("-1/3"), NewQ("-2/3"), NewQ("1/3"), NewQ("2/4")}
+	SortQ(s)
+	if r := fmt.Sprintf("%s", s); r != "[-2/3 -1/3 1/3 1/2 1/2]" {
+		t.Errorf("expected [-2/3 -1/3 1/3 1/2 1/2], got %s", r)
+	}
+	if c := NewQ("2/-3").Compare(NewQ("-1/3")); c != -1 {
+		t.Errorf("2/-3 <> -1/3: expected -1, got %d", c)
+	}
+	if c := NewQ("2/4").Compare(NewQ("1/2")); c != 0 {
+		t.Errorf("2/4 <> 1/2: expected 0, got %d", c)
+	}
+	if m := MinQ(s); m.String() != "-2/3" {
+		t.Errorf("min: expected -2/3, got %s", m)
+	}
+	if m := MaxQ(s); m.String() != "1/2" {
+		t.Errorf("max: expected 1/2, got %s", m)
+	}
+}
+
+func TestCompareLargeQ(t *testing.T) {
+	// AD and CB don't fit int64: 1 + 1/(MaxInt64-1) < 1 + 1/(MaxInt64-2)
+	a := NewQ("9223372036854775807/9223372036854775806")
+	b := NewQ("9223372036854775806/9223372036854775805")
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a.Clone()) != 0 {
+		t.Errorf("wrong order of %s and %s", a, b)
+	}
+	if c := (&Q{a: math.MinInt64, b: 1}).Compare(NewQ("-9223372036854775807/2")); c != -1 {
+		t.Errorf("MinInt64 should be smaller than -MaxInt64/2, got %d", c)
+	}
+}
+
+func TestCompareProducts(t *testing.T) {
+	for _, c := range []struct {
+		a, b, c, d int64
+		exp        int
+	}{
+		{2, 3, 3, 2, 0},
+		{0, -5, 0, 7, 0},
+		{-1, 1, 0, 1, -1},
+		{math.MinInt64, math.MinInt64, math.MaxInt64, math.MaxInt64, 1},
+		{math.MinInt64, math.MaxInt64, math.MaxInt64, -math.MaxInt64, -1},
+		{math.MinInt64, -1, math.MaxInt64, 1, 1},
+		{math.MinInt64, 2, -math.MaxInt64, 2, -1},
+		{-math.MaxInt64, math.MaxInt64, -math.MaxInt64, math.MaxInt64, 0},
+	} {
+		if r := compareProducts(c.a, c.b, c.c, c.d); r != c.exp {
+			t.Errorf("%d*%d vs %d*%d: expected %d, got %d", c.a, c.b, c.c, c.d, c.exp, r)
+		}
+	}
+}
+
+func TestCompareQDoesNotAllocate(t *testing.T) {
+	a := NewQ("9223372036854775807/9223372036854775806")
+	b := NewQ("-9223372036854775806/9223372036854775805")
+	if allocs := testing.AllocsPerRun(10, func() { a.Compare(b) }); allocs != 0 {
+		t.Errorf("expected no allocations, got %v", allocs)
+	}
+	if MinQ([]*Q{a, b}) != b || MaxQ([]*Q{a, b}) != a {
+		t.Errorf("wrong minimum or maximum of %s and %s", a, b)
+	}
+}
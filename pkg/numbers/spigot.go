@@ -0,0 +1,139 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Spigot algorithms produce digits of a constant one by one using only integer (and so exact) arithmetic on numbers
+// not bigger than a few multiples of 10 * (number of digits). Every digit is final once produced, so the result is
+// an exact decimal prefix (it is never rounded).
+
+// MaxPiDigits is the maximum number of digits of π calculated by PiDigits
+const MaxPiDigits = 100_000
+
+// EDigits returns e = 2.71828... with n digits after the decimal point using Sale's spigot algorithm.
+//
+// e = 2 + 1/2 (1 + 1/3 (1 + 1/4 (1 + ...))) is written in a mixed radix with fractional "digits" a[i] (all equal to 1)
+// in positions 1/2, 1/3, 1/4, ... Multiplying it by 10 and normalizing the carries from the right end gives next
+// decimal digit as the carry leaving position 1/2.
+func EDigits(n int) (string, error) {
+	if n < 0 {
+		return "", errors.New("number of digits can't be negative")
+	}
+	// we need m terms, such that m! > 10^(n+1), so the tail of the series doesn't affect n digits
+	m := 2
+	for log10Factorial := 0.0; log10Factorial <= float64(n+2); m++ {
+		log10Factorial += math.Log10(float64(m))
+	}
+	a := make([]int64, m+1)
+	for i := 2; i <= m; i++ {
+		a[i] = 1
+	}
+	var res strings.Builder
+	res.WriteString("2")
+	if n > 0 {
+		res.WriteString(".")
+	}
+	for d := 0; d < n; d++ {
+		var carry int64
+		for i := m; i >= 2; i-- {
+			x := a[i]*10 + carry
+			a[i] = x % int64(i)
+			carry = x / int64(i)
+		}
+		res.WriteByte(byte('0' + carry))
+	}
+	return res.String(), nil
+}
+
+// PiDigits returns π = 3.14159... with n digits after the decimal point using Rabinowitz–Wagon spigot algorithm.
+//
+// π = 2 + 1/3 (2 + 2/5 (2 + 3/7 (2 + ...))) is written in a mixed radix with "digits" a[i] (all equal to 2) in
+// positions i/(2i+1). Multiplying it by 10 and normalizing the carries from the right end gives next decimal digit.
+// Such digit may be 10 (or a run of 9s may still turn into 0s), so digits are held back until the carry is known.
+//
+// n is limited to MaxPiDigits - the algorithm takes O(n²) steps and its int64 values stay below 10·i^2 in position i,
+// which fits 64 bits only for positions (and so digits) far below 10^9.
+func PiDigits(n int) (string, error) {
+	if n < 0 {
+		return "", errors.New("number of digits can't be negative")
+	}
+	if n > MaxPiDigits {
+		return "", fmt.Errorf("can't calculate more than %d digits of π", MaxPiDigits)
+	}
+	// 3 and n digits after decimal point, plus guard digits, because the last digits may be still held back
+	var digits []byte
+	for guard := 2; len(digits) < n+1; guard *= 2 {
+		digits = piSpigot(n + 1 + guard)
+	}
+	var res strings.Builder
+	res.WriteByte(digits[0])
+	if n > 0 {
+		res.WriteString(".")
+		res.Write(digits[1 : n+1])
+	}
+	return res.String(), nil
+}
+
+// piSpigot runs Rabinowitz–Wagon algorithm for count steps and returns the digits which are already final
+func piSpigot(count int) []byte {
+	size := 10*count/3 + 1
+	a := make([]int64, size)
+	for i := range a {
+		a[i] = 2
+	}
+	digits := make([]byte, 0, count)
+	var nines int
+	var predigit int64 = -1
+	for j := 0; j < count; j++ {
+		var q int64
+		for i := int64(size); i > 0; i-- {
+			x := 10*a[i-1] + q*i
+			a[i-1] = x % (2*i - 1)
+			q = x / (2*i - 1)
+		}
+		a[0] = q % 10
+		q = q / 10
+		switch {
+		case q == 9:
+			nines++
+		case q == 10:
+			// carry into held digits: predigit increases, all 9s become 0s
+			digits = append(digits, byte('0'+predigit+1))
+			for ; nines > 0; nines-- {
+				digits = append(digits, '0')
+			}
+			predigit = 0
+		default:
+			if predigit >= 0 {
+				digits = append(digits, byte('0'+predigit))
+			}
+			for ; nines > 0; nines-- {
+				digits = append(digits, '9')
+			}
+			predigit = q
+		}
+	}
+	return digits
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"testing"
+)
+
+const (
+	pi100 = "3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170679"
+	e100  = "2.7182818284590452353602874713526624977572470936999595749669676277240766303535475945713821785251664274"
+)
+
+func TestPiDigits(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5, 31, 32, 33, 100} {
+		expected := pi100[:n+2]
+		if n == 0 {
+			expected = "3"
+		}
+		if pi, e := PiDigits(n); e != nil || pi != expected {
+			t.Errorf("π to %d digits: expected %s, got %s (%v)", n, expected, pi, e)
+		}
+	}
+	// Feynman point: six 9s at positions 762-767 are held back until the next digit is known
+	if pi, e := PiDigits(767); e != nil || len(pi) != 769 || pi[763:] != "999999" {
+		t.Errorf("π to 767 digits: expected trailing 999999, got %s (%v)", pi[750:], e)
+	}
+	if _, e := PiDigits(MaxPiDigits + 1); e == nil {
+		t.Errorf("π to %d digits: expected error", MaxPiDigits+1)
+	}
+	if _, e := PiDigits(-1); e == nil {
+		t.Errorf("π to -1 digits: expected error")
+	}
+}
+
+func TestEDigits(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5, 50, 100} {
+		expected := e100[:n+2]
+		if n == 0 {
+			expected = "2"
+		}
+		if e, err := EDigits(n); err != nil || e != expected {
+			t.Errorf("e to %d digits: expected %s, got %s (%v)", n, expected, e, err)
+		}
+	}
+	if _, e := EDigits(-1); e == nil {
+		t.Errorf("e to -1 digits: expected error")
+	}
+}
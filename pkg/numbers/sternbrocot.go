@@ -0,0 +1,139 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxSternBrocotPath is the maximal length of a path in Stern–Brocot tree created by SternBrocotPath - 1/N needs
+// N-1 steps
+const MaxSternBrocotPath = 1 << 20
+
+// Mediant returns (A + C) / (B + D) for A/B and C/D - it's always between A/B and C/D
+func Mediant(a *Q, b *Q) (*Q, error) {
+	num, e := addInt64(a.a, b.a)
+	if e != nil {
+		return nil, e
+	}
+	den, e := addInt64(a.b, b.b)
+	if e != nil {
+		return nil, e
+	}
+	return reduceQ(num, den)
+}
+
+// SternBrocotNode is a node of Stern–Brocot tree - binary search tree containing every positive ℚ exactly once. The
+// root is 1/1 = mediant of 0/1 and 1/0 and every node is a mediant of the closest ancestors on its left and right:
+// going left, the node becomes the right bound, going right, the node becomes the left bound. Values of
+// SternBrocotNode are immutable.
+type SternBrocotNode struct {
+	// bounds A/B < node < C/D, where C/D may be 1/0 (+∞)
+	a, b int64
+	c, d int64
+}
+
+// SternBrocotRoot returns the root of Stern–Brocot tree - 1/1
+func SternBrocotRoot() *SternBrocotNode {
+	return &SternBrocotNode{a: 0, b: 1, c: 1, d: 0}
+}
+
+// Q returns the value of the node - the mediant of its bounds, which is always in lowest terms
+func (n *SternBrocotNode) Q() *Q {
+	return &Q{a: n.a + n.c, b: n.b + n.d}
+}
+
+// Left returns the left child of the node - smaller ℚ
+func (n *SternBrocotNode) Left() (*SternBrocotNode, error) {
+	q := n.Q()
+	if _, e := addInt64(n.a, q.a); e != nil {
+		return nil, e
+	}
+	if _, e := addInt64(n.b, q.b); e != nil {
+		return nil, e
+	}
+	return &SternBrocotNode{a: n.a, b: n.b, c: q.a, d: q.b}, nil
+}
+
+// Right returns the right child of the node - bigger ℚ
+func (n *SternBrocotNode) Right() (*SternBrocotNode, error) {
+	q := n.Q()
+	if _, e := addInt64(q.a, n.c); e != nil {
+		return nil, e
+	}
+	if _, e := addInt64(q.b, n.d); e != nil {
+		return nil, e
+	}
+	return &SternBrocotNode{a: q.a, b: q.b, c: n.c, d: n.d}, nil
+}
+
+// SternBrocotPath returns the path from the root of Stern–Brocot tree to positive q as a string of "L" and "R" (1/1 is
+// the empty path). It's read from continued fraction of q: [a0; a1, ..., an] is R^a0 L^a1 R^a2 ... with the last
+// term decreased by 1 (3/7 = [0; 2, 3] is "LLRR").
+func SternBrocotPath(q *Q) (string, error) {
+	if q.a <= 0 {
+		return "", errors.New("only positive ℚ are in Stern–Brocot tree")
+	}
+	a0, terms := q.ContinuedFraction().Terms()
+	runs := make([]uint64, 0, len(terms)+1)
+	runs = append(runs, uint64(a0.value))
+	for _, t := range terms {
+		runs = append(runs, t.value)
+	}
+	runs[len(runs)-1]--
+
+	var total uint64
+	for _, r := range runs {
+		total += r
+		if total > MaxSternBrocotPath {
+			return "", fmt.Errorf("path to %s is longer than %d steps", q, MaxSternBrocotPath)
+		}
+	}
+	var sb strings.Builder
+	for i, r := range runs {
+		step := "R"
+		if i%2 == 1 {
+			step = "L"
+		}
+		sb.WriteString(strings.Repeat(step, int(r)))
+	}
+	return sb.String(), nil
+}
+
+// SternBrocotFromPath returns ℚ at the end of the path of "L" and "R" steps from the root of Stern–Brocot tree
+func SternBrocotFromPath(path string) (*Q, error) {
+	n := SternBrocotRoot()
+	var e error
+	for _, step := range path {
+		switch step {
+		case 'L':
+			n, e = n.Left()
+		case 'R':
+			n, e = n.Right()
+		default:
+			return nil, fmt.Errorf("invalid step %q in path %q", step, path)
+		}
+		if e != nil {
+			return nil, e
+		}
+	}
+	return n.Q(), nil
+}
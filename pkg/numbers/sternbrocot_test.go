@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMediant(t *testing.T) {
+	q, e := Mediant(NewQ("1/2"), NewQ("2/3"))
+	checkQ(t, "mediant of 1/2 and 2/3", q, 3, 5)
+	if e != nil {
+		t.Errorf("unexpected error: %v", e)
+	}
+	q, _ = Mediant(NewQ("1/1"), NewQ("3/5"))
+	checkQ(t, "mediant of 1/1 and 3/5", q, 2, 3)
+	q, _ = Mediant(NewQ("-1/2"), NewQ("1/3"))
+	checkQ(t, "mediant of -1/2 and 1/3", q, 0, 1)
+	if _, e = Mediant(NewQ("9223372036854775807/2"), OneQ()); e != ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestSternBrocotNode(t *testing.T) {
+	root := SternBrocotRoot()
+	checkQ(t, "root", root.Q(), 1, 1)
+	l, _ := root.Left()
+	checkQ(t, "L", l.Q(), 1, 2)
+	r, _ := root.Right()
+	checkQ(t, "R", r.Q(), 2, 1)
+	lr, _ := l.Right()
+	checkQ(t, "LR", lr.Q(), 2, 3)
+	lrl, _ := lr.Left()
+	checkQ(t, "LRL", lrl.Q(), 3, 5)
+	// immutability
+	checkQ(t, "L again", l.Q(), 1, 2)
+}
+
+func TestSternBrocotPath(t *testing.T) {
+	for q, exp := range map[string]string{
+		"1/1":     "",
+		"1/2":     "L",
+		"2/1":     "R",
+		"3/5":     "LRL",
+		"3/7":     "LLRR",
+		"5/2":     "RRL",
+		"355/113": "RRR" + strings.Repeat("L", 7) + strings.Repeat("R", 15),
+		"1/1000":  strings.Repeat("L", 999),
+	} {
+		p, e := SternBrocotPath(NewQ(q))
+		if e != nil || p != exp {
+			t.Errorf("%s: expected %q, got %q, %v", q, exp, p, e)
+		}
+		r, e := SternBrocotFromPath(p)
+		if e != nil || r.String() != NewQ(q).String() {
+			t.Errorf("%q: expected %s, got %v, %v", p, q, r, e)
+		}
+	}
+	for _, q := range []string{"0/1", "-1/2"} {
+		if _, e := SternBrocotPath(NewQ(q)); e == nil {
+			t.Errorf("%s: expected error", q)
+		}
+	}
+	if _, e := SternBrocotPath(NewQ("1/9223372036854775807")); e == nil {
+		t.Errorf("expected error for too long path")
+	}
+	if _, e := SternBrocotFromPath("LXR"); e == nil {
+		t.Errorf("expected error for invalid step")
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+)
+
+// Transcendental functions can't be calculated in ℚ, but their Taylor series are built from +, *, and / only, so every
+// partial sum is an exact ℚ. Each function below returns the partial sum of first n terms of the series together with
+// a bound B of the remainder, so that the real value lies in [sum - B, sum + B].
+//
+// ErrOverflow is returned when a term doesn't fit 64 bits - factorials grow fast, so n stays in tens at most.
+
+// Exp approximates e^x with Σ(k=0..n-1) x^k / k!
+//
+// Lagrange remainder: |R| <= e^max(x, 0) * |x|^n / n! and we use e < 3
+func Exp(x *Q, n int) (*Q, *Q, error) {
+	if n < 1 {
+		return nil, nil, errors.New("at least one term of the series is required")
+	}
+	sum := ZeroQ()
+	term := OneQ()
+	var e error
+	for k := 0; k < n; k++ {
+		if sum, e = sum.add(term); e != nil {
+			return nil, nil, e
+		}
+		// x^(k+1) / (k+1)! = x^k / k! * x / (k+1)
+		if term, e = term.multiply(x); e != nil {
+			return nil, nil, e
+		}
+		if term, e = term.divide(&Q{a: int64(k) + 1, b: 1}); e != nil {
+			return nil, nil, e
+		}
+	}
+	// after the loop term = x^n / n!
+	bound, e := term.AbsChecked()
+	if e != nil {
+		return nil, nil, e
+	}
+	if x.a > 0 {
+		// 3^ceil(x)
+		var three int64 = 1
+		for i := int64(0); i < (x.a+x.b-1)/x.b; i++ {
+			if three, e = mulInt64(three, 3); e != nil {
+				return nil, nil, e
+			}
+		}
+		if bound, e = bound.multiply(&Q{a: three, b: 1}); e != nil {
+			return nil, nil, e
+		}
+	}
+	return sum, bound, nil
+}
+
+// Sin approximates sin(x) with Σ(k=0..n-1) (-1)^k * x^(2k+1) / (2k+1)!
+//
+// Lagrange remainder: |R| <= |x|^(2n+1) / (2n+1)!
+func Sin(x *Q, n int) (*Q, *Q, error) {
+	return alternatingSeries(x, n, &Q{a: x.a, b: x.b}, 1)
+}
+
+// Cos approximates cos(x) with Σ(k=0..n-1) (-1)^k * x^(2k) / (2k)!
+//
+// Lagrange remainder: |R| <= |x|^(2n) / (2n)!
+func Cos(x *Q, n int) (*Q, *Q, error) {
+	return alternatingSeries(x, n, OneQ(), 0)
+}
+
+// Ln approximates ln(x) for x > 0. Taylor series of ln(1+x) converges only for -1 < x <= 1, so we use the series of
+// ln(x) = 2 * artanh(y) = 2 * Σ(k=0..n-1) y^(2k+1) / (2k+1), where y = (x-1)/(x+1) and |y| < 1 for every x > 0
+//
+// Remainder: |R| <= 2 * |y|^(2n+1) / (2n+1) * (1 + y^2 + y^4 + ...) = 2 * |y|^(2n+1) / ((2n+1) * (1 - y^2))
+func Ln(x *Q, n int) (*Q, *Q, error) {
+	if x.a <= 0 {
+		return nil, nil, errors.New("can't take logarithm from non-positive number")
+	}
+	if n < 1 {
+		return nil, nil, errors.New("at least one term of the series is required")
+	}
+	num, e := x.subtract(OneQ())
+	if e != nil {
+		return nil, nil, e
+	}
+	den, e := x.add(OneQ())
+	if e != nil {
+		return nil, nil, e
+	}
+	y, e := num.divide(den)
+	if e != nil {
+		return nil, nil, e
+	}
+	y2, e := y.multiply(y)
+	if e != nil {
+		return nil, nil, e
+	}
+	sum := ZeroQ()
+	power := y // y^(2k+1)
+	for k := 0; k < n; k++ {
+		term, e := power.divide(&Q{a: 2*int64(k) + 1, b: 1})
+		if e != nil {
+			return nil, nil, e
+		}
+		if sum, e = sum.add(term); e != nil {
+			return nil, nil, e
+		}
+		if power, e = power.multiply(y2); e != nil {
+			return nil, nil, e
+		}
+	}
+	two := &Q{a: 2, b: 1}
+	if sum, e = sum.multiply(two); e != nil {
+		return nil, nil, e
+	}
+	// after the loop power = y^(2n+1)
+	rest, e := OneQ().subtract(y2)
+	if e != nil {
+		return nil, nil, e
+	}
+	if rest, e = rest.multiply(&Q{a: 2*int64(n) + 1, b: 1}); e != nil {
+		return nil, nil, e
+	}
+	bound, e := power.AbsChecked()
+	if e == nil {
+		bound, e = bound.multiply(two)
+	}
+	if e != nil {
+		return nil, nil, e
+	}
+	if bound, e = bound.divide(rest); e != nil {
+		return nil, nil, e
+	}
+	return sum, bound, nil
+}
+
+// alternatingSeries sums n terms of series for sin (first = x, k0 = 1) or cos (first = 1, k0 = 0), where every term is
+// previous term multiplied by -x^2 / ((k+1) * (k+2)) and k is the power of x in previous term
+func alternatingSeries(x *Q, n int, first *Q, k0 int64) (*Q, *Q, error) {
+	if n < 1 {
+		return nil, nil, errors.New("at least one term of the series is required")
+	}
+	x2, e := x.multiply(x)
+	if e != nil {
+		return nil, nil, e
+	}
+	minusX2 := &Q{a: -x2.a, b: x2.b}
+	sum := ZeroQ()
+	term := first
+	k := k0
+	for i := 0; i < n; i++ {
+		if sum, e = sum.add(term); e != nil {
+			return nil, nil, e
+		}
+		if term, e = term.multiply(minusX2); e != nil {
+			return nil, nil, e
+		}
+		d, e := mulInt64(k+1, k+2)
+		if e != nil {
+			return nil, nil, e
+		}
+		if term, e = term.divide(&Q{a: d, b: 1}); e != nil {
+			return nil, nil, e
+		}
+		k += 2
+	}
+	// after the loop |term| = |x|^(2n+k0) / (2n+k0)!, which is exactly the Lagrange bound
+	bound, e := term.AbsChecked()
+	if e != nil {
+		return nil, nil, e
+	}
+	return sum, bound, nil
+}
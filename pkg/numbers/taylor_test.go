@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	checkSeries(t, "e^1", Exp, NewQ("1/1"), 15, math.E)
+	checkSeries(t, "e^(1/2)", Exp, NewQ("1/2"), 10, math.Exp(0.5))
+	checkSeries(t, "e^(-2)", Exp, NewQ("-2/1"), 15, math.Exp(-2))
+	checkSeries(t, "e^0", Exp, NewQ("0/1"), 1, 1)
+}
+
+func TestSin(t *testing.T) {
+	checkSeries(t, "sin(1)", Sin, NewQ("1/1"), 8, math.Sin(1))
+	checkSeries(t, "sin(-1/3)", Sin, NewQ("-1/3"), 5, math.Sin(-1.0/3))
+	checkSeries(t, "sin(0)", Sin, NewQ("0/1"), 3, 0)
+}
+
+func TestCos(t *testing.T) {
+	checkSeries(t, "cos(1)", Cos, NewQ("1/1"), 8, math.Cos(1))
+	checkSeries(t, "cos(3/2)", Cos, NewQ("3/2"), 8, math.Cos(1.5))
+}
+
+func TestLn(t *testing.T) {
+	checkSeries(t, "ln(2)", Ln, NewQ("2/1"), 10, math.Ln2)
+	checkSeries(t, "ln(1/2)", Ln, NewQ("1/2"), 10, -math.Ln2)
+	checkSeries(t, "ln(1)", Ln, NewQ("1/1"), 1, 0)
+	if _, _, e := Ln(NewQ("0/1"), 5); e == nil {
+		t.Errorf("ln(0): expected error")
+	}
+	if _, _, e := Ln(NewQ("-1/2"), 5); e == nil {
+		t.Errorf("ln(-1/2): expected error")
+	}
+}
+
+func TestSeriesErrors(t *testing.T) {
+	if _, _, e := Exp(NewQ("1/1"), 0); e == nil {
+		t.Errorf("e^1 with 0 terms: expected error")
+	}
+	if _, _, e := Exp(NewQ("1/1"), 30); e != ErrOverflow {
+		t.Errorf("e^1 with 30 terms: expected overflow, got %v", e)
+	}
+}
+
+// checkSeries verifies that the real value lies within the returned error bound
+func checkSeries(t *testing.T, label string, f func(*Q, int) (*Q, *Q, error), x *Q, n int, expected float64) {
+	sum, bound, e := f(x, n)
+	if e != nil {
+		t.Errorf("%s: %s", label, e)
+		return
+	}
+	s := float64(sum.a) / float64(sum.b)
+	b := float64(bound.a) / float64(bound.b)
+	if b < 0 {
+		t.Errorf("%s: negative error bound %d/%d", label, bound.a, bound.b)
+	}
+	if math.Abs(s-expected) > b+1e-15 {
+		t.Errorf("%s: %d/%d ± %d/%d doesn't contain %v", label, sum.a, sum.b, bound.a, bound.b, expected)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TwosComplement returns z as width-bit pattern in two's complement encoding (1 <= width <= 64), the most significant
+// bit first: 5 in 8 bits is "00000101" and -5 is "11111011". An error is returned if z doesn't fit - width bits
+// encode -2^(width-1) <= z < 2^(width-1).
+//
+// ℤ defines -A as the additive inverse of A: A + (-A) = 0. Hardware adds width-bit numbers modulo 2^width, so the
+// inverse of A is 2^width - A = (2^width - 1 - A) + 1 - all bits of A inverted, plus 1. Adding such patterns needs no
+// special case for the sign: 00000101 + 11111011 = 1|00000000 and the carry out of the top bit is dropped.
+func (z *Z) TwosComplement(width int) (string, error) {
+	if width < 1 || width > 64 {
+		return "", fmt.Errorf("width has to be between 1 and 64, not %d", width)
+	}
+	if width < 64 {
+		if limit := int64(1) << (width - 1); z.value < -limit || z.value >= limit {
+			return "", fmt.Errorf("%d doesn't fit %d bits", z.value, width)
+		}
+	}
+	// conversion to uint64 is already two's complement modulo 2^64
+	v := uint64(z.value)
+	var sb strings.Builder
+	for i := width - 1; i >= 0; i-- {
+		sb.WriteByte(byte('0' + v>>i&1))
+	}
+	return sb.String(), nil
+}
+
+// ParseTwosComplement returns ℤ encoded by bit pattern in two's complement, like the result of Z.TwosComplement.
+// The width is the number of bits (1 to 64) and the most significant one is the sign: "11111011" is -5, but "011" is
+// 3.
+func ParseTwosComplement(pattern string) (*Z, error) {
+	width := len(pattern)
+	if width < 1 || width > 64 {
+		return nil, fmt.Errorf("width has to be between 1 and 64, not %d", width)
+	}
+	v := uint64(0)
+	for _, c := range pattern {
+		if c != '0' && c != '1' {
+			return nil, errors.New("two's complement pattern can contain only 0 and 1")
+		}
+		v = v<<1 | uint64(c-'0')
+	}
+	if pattern[0] == '1' && width < 64 {
+		// extend the sign - the pattern is v - 2^width
+		v |= ^uint64(0) << width
+	}
+	return ZFromInt64(int64(v)), nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestTwosComplement(t *testing.T) {
+	for _, c := range []struct {
+		v     int64
+		width int
+		exp   string
+	}{
+		{5, 8, "00000101"},
+		{-5, 8, "11111011"},
+		{0, 4, "0000"},
+		{-1, 4, "1111"},
+		{-8, 4, "1000"},
+		{7, 4, "0111"},
+		{-1, 1, "1"},
+		{0, 1, "0"},
+		{math.MinInt64, 64, "1" + strings.Repeat("0", 63)},
+		{math.MaxInt64, 64, "0" + strings.Repeat("1", 63)},
+	} {
+		s, e := ZFromInt64(c.v).TwosComplement(c.width)
+		if e != nil || s != c.exp {
+			t.Errorf("%d (%d bits): expected %q, got %q, %v", c.v, c.width, c.exp, s, e)
+		}
+		z, e := ParseTwosComplement(s)
+		if e != nil || z.value != c.v {
+			t.Errorf("%q: expected %d, got %v, %v", s, c.v, z, e)
+		}
+	}
+	for _, c := range [][2]int64{{8, 4}, {-9, 4}, {1, 1}, {0, 0}, {0, 65}} {
+		if _, e := ZFromInt64(c[0]).TwosComplement(int(c[1])); e == nil {
+			t.Errorf("%d (%d bits): expected error", c[0], c[1])
+		}
+	}
+}
+
+func TestParseTwosComplement(t *testing.T) {
+	for s, exp := range map[string]int64{"011": 3, "101": -3, "10000000": -128} {
+		z, e := ParseTwosComplement(s)
+		if e != nil || z.value != exp {
+			t.Errorf("%q: expected %d, got %v, %v", s, exp, z, e)
+		}
+	}
+	for _, s := range []string{"", "102", strings.Repeat("1", 65), "-1"} {
+		if _, e := ParseTwosComplement(s); e == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// RootOfUnity is the k-th of n-th roots of unity: exp(2πi·k/n) = cos(2πk/n) + sin(2πk/n)·i. Only 1, i, -1 and -i
+// are in ℚ(i). Surd writes exactly the roots with angles being multiples of π/12 (15°) - other roots (e.g. of degree 5
+// or 8) can be written with radicals too, but that isn't supported by Surd, so they're only approximated.
+type RootOfUnity struct {
+	k int
+	n int
+
+	fmt.Stringer
+}
+
+// RootsOfUnity returns all n-th roots of unity, counterclockwise starting from 1
+func RootsOfUnity(n int) ([]*RootOfUnity, error) {
+	if n < 1 {
+		return nil, errors.New("there are roots of unity only for positive n")
+	}
+	roots := make([]*RootOfUnity, n)
+	for k := range roots {
+		roots[k] = &RootOfUnity{k: k, n: n}
+	}
+	return roots, nil
+}
+
+// K returns the index of r
+func (r *RootOfUnity) K() int {
+	return r.k
+}
+
+// N returns the degree of r
+func (r *RootOfUnity) N() int {
+	return r.n
+}
+
+// Exact returns r as ℂ over ℚ - possible only for 1, i, -1 and -i (when the angle is a multiple of π/2)
+func (r *RootOfUnity) Exact() (*C, bool) {
+	if 4*r.k%r.n != 0 {
+		return nil, false
+	}
+	switch 4 * r.k / r.n {
+	case 0:
+		return NewC(OneQ(), ZeroQ()), true
+	case 1:
+		return NewC(ZeroQ(), OneQ()), true
+	case 2:
+		return NewC(mustQ(QFromInts(-1, 1)), ZeroQ()), true
+	}
+	return NewC(ZeroQ(), mustQ(QFromInts(-1, 1))), true
+}
+
+// Surd returns r written exactly with square roots - possible when the angle is a multiple of π/12 (15°), for
+// example "-1/2 + (√3/2)i" for the first of 3rd roots of unity
+func (r *RootOfUnity) Surd() (string, bool) {
+	if 24*r.k%r.n != 0 {
+		return "", false
+	}
+	m := 24 * r.k / r.n
+	cs, cm := cos15(m)
+	ss, sm := cos15(m + 18) // sin(x) = cos(x - π/2) = cos(x + 3π/2)
+	re := cm
+	if cs < 0 {
+		re = "-" + cm
+	}
+	if ss == 0 {
+		return re, true
+	}
+	if sm == "1" {
+		sm = ""
+	} else if strings.Contains(sm, "√") {
+		sm = "(" + sm + ")"
+	}
+	if cs == 0 {
+		if ss < 0 {
+			return "-" + sm + "i", true
+		}
+		return sm + "i", true
+	}
+	if ss < 0 {
+		return re + " - " + sm + "i", true
+	}
+	return re + " + " + sm + "i", true
+}
+
+// Quadrant classifies the argument 2πk/n of r - it's exact, even if r itself is not
+func (r *RootOfUnity) Quadrant() Quadrant {
+	if 4*r.k%r.n == 0 {
+		return []Quadrant{PositiveReal, PositiveImaginary, NegativeReal, NegativeImaginary}[4*r.k/r.n]
+	}
+	return []Quadrant{FirstQuadrant, SecondQuadrant, ThirdQuadrant, FourthQuadrant}[4*r.k/r.n]
+}
+
+// Complex128 returns the nearest complex128 value for r
+func (r *RootOfUnity) Complex128() complex128 {
+	if c, ok := r.Exact(); ok {
+		re, _ := c.re.Float64()
+		im, _ := c.im.Float64()
+		return complex(re, im)
+	}
+	angle := 2 * math.Pi * float64(r.k) / float64(r.n)
+	return complex(math.Cos(angle), math.Sin(angle))
+}
+
+// Approximate returns r itself if it's in ℚ(i) or the closest ℂ over ℚ with denominators of both parts not greater
+// than maxDenominator
+func (r *RootOfUnity) Approximate(maxDenominator *N) (*C, error) {
+	if c, ok := r.Exact(); ok {
+		return c, nil
+	}
+	v := r.Complex128()
+	re, e := approximate(real(v), maxDenominator)
+	if e != nil {
+		return nil, e
+	}
+	im, e := approximate(imag(v), maxDenominator)
+	if e != nil {
+		return nil, e
+	}
+	return NewC(re, im), nil
+}
+
+// String formats r as "exp(2πi·k/n)"
+func (r *RootOfUnity) String() string {
+	return fmt.Sprintf("exp(2πi·%d/%d)", r.k, r.n)
+}
+
+// cos15 returns the sign and the absolute value of cos(m·π/12) written with square roots
+func cos15(m int) (int, string) {
+	m %= 24
+	if m > 12 {
+		m = 24 - m
+	}
+	sign := 1
+	if m > 6 {
+		sign, m = -1, 12-m
+	}
+	if m == 6 {
+		return 0, "0"
+	}
+	return sign, []string{"1", "(√6+√2)/4", "√3/2", "√2/2", "1/2", "(√6-√2)/4"}[m]
+}
+
+// approximate returns the closest ℚ to v (|v| <= 1) with denominator not greater than maxDenominator
+func approximate(v float64, maxDenominator *N) (*Q, error) {
+	const scale = 1 << 52
+	q, e := QFromInts(int64(math.Round(v*scale)), scale)
+	if e != nil {
+		return nil, e
+	}
+	return q.LimitDenominator(maxDenominator)
+}
+
+var _ = fmt.Stringer(&RootOfUnity{})
@@ -0,0 +1,121 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numbers
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestRootsOfUnity(t *testing.T) {
+	for n := 1; n <= 30; n++ {
+		roots, e := RootsOfUnity(n)
+		if e != nil || len(roots) != n {
+			t.Fatalf("%d: expected %d roots, got %v, %v", n, n, roots, e)
+		}
+		for k, r := range roots {
+			if r.K() != k || r.N() != n {
+				t.Errorf("%s: wrong index", r)
+			}
+			if v := cmplx.Pow(r.Complex128(), complex(float64(n), 0)); cmplx.Abs(v-1) > 1e-9 {
+				t.Errorf("%s: r^n = %v", r, v)
+			}
+			if q := r.Quadrant(); q != quadrant(sign(real(r.Complex128())), sign(imag(r.Complex128()))) {
+				t.Errorf("%s: wrong quadrant %s", r, q)
+			}
+			if c, ok := r.Exact(); ok {
+				if m, _ := c.ModulusSquared(); m.Compare(OneQ()) != 0 {
+					t.Errorf("%s: |r|^2 = %s", r, m)
+				}
+			}
+		}
+	}
+	if _, e := RootsOfUnity(0); e == nil {
+		t.Errorf("expected error for n = 0")
+	}
+}
+
+func TestRootOfUnityExact(t *testing.T) {
+	roots, _ := RootsOfUnity(8)
+	for k, exp := range map[int]string{0: "1/1 + 0/1i", 2: "0/1 + 1/1i", 4: "-1/1 + 0/1i", 6: "0/1 - 1/1i"} {
+		if c, ok := roots[k].Exact(); !ok || c.String() != exp {
+			t.Errorf("%s: expected %s, got %v", roots[k], exp, c)
+		}
+	}
+	for _, k := range []int{1, 3, 5, 7} {
+		if _, ok := roots[k].Exact(); ok {
+			t.Errorf("%s: expected no exact value", roots[k])
+		}
+	}
+}
+
+func TestRootOfUnitySurd(t *testing.T) {
+	for _, c := range []struct {
+		k, n int
+		exp  string
+	}{
+		{0, 1, "1"},
+		{1, 2, "-1"},
+		{1, 4, "i"},
+		{3, 4, "-i"},
+		{1, 3, "-1/2 + (√3/2)i"},
+		{2, 3, "-1/2 - (√3/2)i"},
+		{1, 6, "1/2 + (√3/2)i"},
+		{3, 8, "-√2/2 + (√2/2)i"},
+		{1, 12, "√3/2 + 1/2i"},
+		{1, 24, "(√6+√2)/4 + ((√6-√2)/4)i"},
+		{13, 24, "-(√6+√2)/4 - ((√6-√2)/4)i"},
+	} {
+		s, ok := (&RootOfUnity{k: c.k, n: c.n}).Surd()
+		if !ok || s != c.exp {
+			t.Errorf("%d/%d: expected %q, got %q", c.k, c.n, c.exp, s)
+		}
+	}
+	for _, n := range []int{5, 7, 9, 10, 16} {
+		if _, ok := (&RootOfUnity{k: 1, n: n}).Surd(); ok {
+			t.Errorf("1/%d: expected no surd form", n)
+		}
+	}
+}
+
+func TestRootOfUnityApproximate(t *testing.T) {
+	r := &RootOfUnity{k: 1, n: 3}
+	c, e := r.Approximate(NFromUint64(1000))
+	if e != nil || c.re.String() != "-1/2" || c.im.String() != "808/933" {
+		t.Errorf("%s: expected -1/2 + 808/933i, got %v, %v", r, c, e)
+	}
+	c, e = (&RootOfUnity{k: 3, n: 4}).Approximate(NFromUint64(1))
+	if e != nil || c.String() != "0/1 - 1/1i" {
+		t.Errorf("expected exact -i, got %v, %v", c, e)
+	}
+	if s := r.String(); s != "exp(2πi·1/3)" {
+		t.Errorf("expected exp(2πi·1/3), got %s", s)
+	}
+}
+
+func sign(v float64) int {
+	switch {
+	case math.Abs(v) < 1e-12:
+		return 0
+	case v < 0:
+		return -1
+	}
+	return 1
+}
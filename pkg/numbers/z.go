@@ -20,6 +20,7 @@ package numbers
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -27,6 +28,8 @@ import (
 // https://en.wikipedia.org/wiki/Additive_inverse
 //
 // integer numbers are introduced from basic rules defined/found from natural numbers.
+//
+// Values of ℤ are immutable, just as values of ℕ.
 type Z struct {
 	value int64
 
@@ -47,34 +50,101 @@ type ZOperations interface {
 	Logarithm(*Z) (*Z, *Q)
 }
 
+var (
+	// well known numbers - shared, because values of ℤ are immutable
+	zZero = Z{value: 0}
+	zOne  = Z{value: 1}
+	zTwo  = Z{value: 2}
+	zTen  = Z{value: 10}
+
+	// smallZ are interned values 0..maxInterned, just as smallN
+	smallZ = func() (res [maxInterned + 1]*Z) {
+		for i := range res {
+			res[i] = &Z{value: int64(i)}
+		}
+		res[0], res[1], res[2], res[10] = &zZero, &zOne, &zTwo, &zTen
+		return
+	}()
+)
+
+// ZeroZ returns ℤ 0
+func ZeroZ() *Z {
+	return &zZero
+}
+
+// OneZ returns ℤ 1
+func OneZ() *Z {
+	return &zOne
+}
+
+// TwoZ returns ℤ 2
+func TwoZ() *Z {
+	return &zTwo
+}
+
+// TenZ returns ℤ 10
+func TenZ() *Z {
+	return &zTen
+}
+
 // NewZ Creates new ℤ from string
 func NewZ(v string) *Z {
 	z, _ := strconv.Atoi(v)
-	res := &Z{value: int64(z)}
+	return ZFromInt64(int64(z))
+}
 
-	return res
+// ZFromInt64 creates new ℤ from machine integer. Values 0..256 are shared (interned).
+func ZFromInt64(v int64) *Z {
+	if v >= 0 && v <= maxInterned {
+		return smallZ[v]
+	}
+	return &Z{value: v}
 }
 
 // DefZ creates new ℤ as a result of subtracting two ℕs - definition of ℤ. Having ℤ defined and having
 // the basic rules described for ℕ, we can implement the operations for ℤ
 //
 // if A < B, A is decreased to 0 and resulting (A - B) = (0 - (B - A)) is called "negative integer"
+//
+// The difference of ℕs is what N.Subtract finds by counting, but we take it directly from machine representation, so
+// creating ℤ takes the same time for any magnitude. DefZ panics with ErrOverflow if A - B doesn't fit int64.
 func DefZ(a *N, b *N) *Z {
 	if a.value >= b.value {
-		res, _ := a.Subtract(b)
-		return &Z{value: int64(res.value)}
+		res := a.value - b.value
+		if res > math.MaxInt64 {
+			panic(ErrOverflow)
+		}
+		return ZFromInt64(int64(res))
 	}
-	res, _ := b.Subtract(a)
-	c := &N{value: ZERO.value}
-	for ; c.value < res.value; c = c.addOne() {
+	res := b.value - a.value
+	if res > math.MaxInt64+1 {
+		panic(ErrOverflow)
 	}
-	return &Z{value: -int64(c.value)} // definition - putting "-" in front of natural number
+	return ZFromInt64(-int64(res)) // definition - putting "-" in front of natural number
 }
 
 func (z *Z) String() string {
 	return fmt.Sprintf("%d", z.value)
 }
 
+// Int64 returns z as machine integer. ℤ is kept in 64 bits, so the conversion is always exact.
+func (z *Z) Int64() int64 {
+	return z.value
+}
+
+// Uint64 returns z as unsigned machine integer, exact is false for negative z (and the value is then meaningless)
+func (z *Z) Uint64() (v uint64, exact bool) {
+	if z.value < 0 {
+		return 0, false
+	}
+	return uint64(z.value), true
+}
+
+// Clone returns a copy of z
+func (z *Z) Clone() *Z {
+	return &Z{value: z.value}
+}
+
 // A + B:
 //  - A >= 0, B >= 0: as in ℕ
 //  - A >= 0, B < 0: A + (0 - |B|) = x -> (A + (0 - |B|)) + |B| = x + |B| -> A + ((0 - |B|) + |B|) = x + |B|
@@ -89,20 +159,20 @@ func (z *Z) Add(arg *Z) *Z {
 		a := &N{value: uint64(z.value)}
 		b := &N{value: uint64(arg.value)}
 		c := a.Add(b)
-		return &Z{value: int64(c.value)}
+		return ZFromInt64(int64(c.value))
 	} else if z.value >= 0 && arg.value < 0 {
 		a := &N{value: uint64(z.value)}
 		b := &N{value: uint64(-arg.value)} // get rid of "-" from negative integer to get ℕ
-		return &Z{value: DefZ(a, b).value}
+		return DefZ(a, b)
 	} else if z.value < 0 && arg.value >= 0 {
 		a := &N{value: uint64(-z.value)} // get rid of "-" from negative integer to get ℕ
 		b := &N{value: uint64(arg.value)}
-		return &Z{value: DefZ(b, a).value}
+		return DefZ(b, a)
 	} else {
 		a := &N{value: uint64(-z.value)}   // get rid of "-" from negative integer to get ℕ
 		b := &N{value: uint64(-arg.value)} // get rid of "-" from negative integer to get ℕ
 		c := a.Add(b)
-		return &Z{value: -int64(c.value)}
+		return ZFromInt64(-int64(c.value))
 	}
 }
 
@@ -120,22 +190,22 @@ func (z *Z) Multiply(arg *Z) *Z {
 		a := &N{value: uint64(z.value)}
 		b := &N{value: uint64(arg.value)}
 		c := a.Multiply(b)
-		return &Z{value: int64(c.value)}
+		return ZFromInt64(int64(c.value))
 	} else if z.value >= 0 && arg.value < 0 {
 		a := &N{value: uint64(z.value)}
 		b := &N{value: uint64(-arg.value)} // get rid of "-" from negative integer to get ℕ
 		c := a.Multiply(b)
-		return &Z{value: -int64(c.value)}
+		return ZFromInt64(-int64(c.value))
 	} else if z.value < 0 && arg.value >= 0 {
 		a := &N{value: uint64(-z.value)} // get rid of "-" from negative integer to get ℕ
 		b := &N{value: uint64(arg.value)}
 		c := a.Multiply(b)
-		return &Z{value: -int64(c.value)}
+		return ZFromInt64(-int64(c.value))
 	} else {
 		a := &N{value: uint64(-z.value)}   // get rid of "-" from negative integer to get ℕ
 		b := &N{value: uint64(-arg.value)} // get rid of "-" from negative integer to get ℕ
 		c := a.Multiply(b)
-		return &Z{value: int64(c.value)}
+		return ZFromInt64(int64(c.value))
 	}
 }
 
@@ -148,10 +218,10 @@ func (z *Z) Power(arg *Z) (*Z, *Q, error) {
 		a := &N{value: uint64(z.value)}
 		b := &N{value: uint64(arg.value)}
 		c := a.Power(b)
-		return &Z{value: int64(c.value)}, nil, nil
+		return ZFromInt64(int64(c.value)), nil, nil
 	} else if arg.value >= 0 {
 		// reimplement from ℕ instead of delegate to ℕ
-		res := NewZ("1")
+		res := OneZ()
 		for i := int64(0); i < arg.value; i++ {
 			res = res.Multiply(z)
 		}
@@ -159,7 +229,7 @@ func (z *Z) Power(arg *Z) (*Z, *Q, error) {
 	} else {
 		// possibly no solution in ℤ - delegating to Z.Divide which may switch to ℚ
 		res, _, _ := z.Power(&Z{value: -arg.value})
-		return (&Z{value: 1}).Divide(res)
+		return OneZ().Divide(res)
 	}
 }
 
@@ -176,17 +246,17 @@ func (z *Z) Subtract(arg *Z) *Z {
 		a := z
 		b := &Z{value: -arg.value}
 		c := a.Add(b)
-		return &Z{value: int64(c.value)}
+		return ZFromInt64(int64(c.value))
 	} else if z.value < 0 && arg.value >= 0 {
 		a := &Z{value: -z.value}
 		b := arg
 		c := a.Add(b)
-		return &Z{value: -int64(c.value)}
+		return ZFromInt64(-int64(c.value))
 	} else {
 		a := &Z{value: -z.value}
 		b := &Z{value: -arg.value}
 		c := a.Subtract(b)
-		return &Z{value: -int64(c.value)}
+		return ZFromInt64(-int64(c.value))
 	}
 }
 
@@ -201,7 +271,7 @@ func (z *Z) Divide(arg *Z) (*Z, *Q, error) {
 		b := &N{value: uint64(arg.value)}
 		zres, qres, e := a.Divide(b)
 		if zres != nil {
-			return &Z{value: int64(zres.value)}, nil, nil
+			return ZFromInt64(int64(zres.value)), nil, nil
 		}
 		if qres != nil {
 			return nil, qres, nil
@@ -210,23 +280,19 @@ func (z *Z) Divide(arg *Z) (*Z, *Q, error) {
 	} else if z.value >= 0 && arg.value < 0 {
 		zres, qres, e := z.Divide(&Z{value: -arg.value})
 		if zres != nil {
-			zres.value = -zres.value
-			return zres, nil, nil
+			return ZFromInt64(-zres.value), nil, nil
 		}
 		if qres != nil {
-			qres.a = -qres.a
-			return nil, qres, nil
+			return nil, &Q{a: -qres.a, b: qres.b}, nil
 		}
 		return nil, nil, e
 	} else if z.value < 0 && arg.value >= 0 {
 		zres, qres, e := (&Z{value: -z.value}).Divide(arg)
 		if zres != nil {
-			zres.value = -zres.value
-			return zres, nil, nil
+			return ZFromInt64(-zres.value), nil, nil
 		}
 		if qres != nil {
-			qres.a = -qres.a
-			return nil, qres, nil
+			return nil, &Q{a: -qres.a, b: qres.b}, nil
 		}
 		return nil, nil, e
 	} else {
@@ -239,7 +305,6 @@ func (z *Z) Divide(arg *Z) (*Z, *Q, error) {
 		}
 		return nil, nil, e
 	}
-	return nil, nil, nil
 }
 
 // A div B: division with remainder: A = QB + R and 0 <= R < |B|
@@ -267,13 +332,15 @@ func (z *Z) DivideR(arg *Z) (*Z, *Z, error) {
 	bv := &N{value: uint64(b)}
 	res, rem, e := av.DivideR(bv)
 	if res != nil && rem != nil {
+		zres := &Z{value: int64(res.value)}
+		zrem := &Z{value: int64(rem.value)}
 		if negrem {
-			rem.value = -rem.value
+			zrem = &Z{value: -zrem.value}
 		}
 		if negres {
-			res.value = -res.value
+			zres = &Z{value: -zres.value}
 		}
-		return &Z{value: int64(res.value)}, &Z{value: int64(rem.value)}, nil
+		return zres, zrem, nil
 	}
 	return nil, nil, e
 }
@@ -20,6 +20,7 @@ package numbers
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -201,3 +202,100 @@ func divideZR(a string, b string) {
 		fmt.Printf("%s/%s: %s\n", a, b, fmt.Errorf("%s", e))
 	}
 }
+
+func TestImmutabilityZ(t *testing.T) {
+	for _, pair := range [][2]string{{"4", "-2"}, {"-4", "2"}, {"-4", "-2"}, {"4", "-3"}, {"-4", "3"}, {"-4", "-3"}} {
+		a := NewZ(pair[0])
+		b := NewZ(pair[1])
+		z, q, _ := a.Divide(b)
+		d, r, _ := a.DivideR(b)
+		a.Add(b)
+		a.Subtract(b)
+		a.Multiply(b)
+		if a.String() != pair[0] || b.String() != pair[1] {
+			t.Errorf("%s/%s: operations changed their arguments: %s, %s", pair[0], pair[1], a, b)
+		}
+		// results are not shared with anything
+		var zs, qs string
+		if z != nil {
+			zs = z.String()
+		}
+		if q != nil {
+			qs = q.String()
+		}
+		a.Divide(b)
+		if z != nil && z.String() != zs || q != nil && q.String() != qs {
+			t.Errorf("%s/%s: repeated division changed previous result", pair[0], pair[1])
+		}
+		ds, rs := d.String(), r.String()
+		a.DivideR(b)
+		if d.String() != ds || r.String() != rs {
+			t.Errorf("%s div %s: repeated division changed previous result", pair[0], pair[1])
+		}
+	}
+	a := NewZ("-7")
+	if c := a.Clone(); c == a || c.value != a.value {
+		t.Errorf("clone of %s: got %s", a, c)
+	}
+}
+
+func TestNativeZ(t *testing.T) {
+	if v := NewZ("-42").Int64(); v != -42 {
+		t.Errorf("Int64 of -42: got %d", v)
+	}
+	if v, exact := NewZ("42").Uint64(); !exact || v != 42 {
+		t.Errorf("Uint64 of 42: got %d, %t", v, exact)
+	}
+	if _, exact := NewZ("-1").Uint64(); exact {
+		t.Errorf("Uint64 of -1: expected inexact")
+	}
+}
+
+func TestZFromInt64(t *testing.T) {
+	if z := ZFromInt64(-42); z.value != -42 {
+		t.Errorf("expected -42, got %s", z)
+	}
+}
+
+func TestConstantsZ(t *testing.T) {
+	NewZ("-3").Power(ZeroZ())
+	OneZ().Subtract(TenZ()).Multiply(TwoZ())
+	if ZeroZ().value != 0 || OneZ().value != 1 || TwoZ().value != 2 || TenZ().value != 10 {
+		t.Errorf("expected 0, 1, 2, 10, got %s, %s, %s, %s", ZeroZ(), OneZ(), TwoZ(), TenZ())
+	}
+}
+
+func TestInterningZ(t *testing.T) {
+	if NewZ("0") != ZeroZ() || ZFromInt64(256) != ZFromInt64(256) || NewZ("7").Add(NewZ("3")) != TenZ() {
+		t.Errorf("small values are not interned")
+	}
+	if ZFromInt64(-1) == ZFromInt64(-1) || ZFromInt64(257) == ZFromInt64(257) {
+		t.Errorf("negative and large values shouldn't be interned")
+	}
+}
+
+func TestDefZ(t *testing.T) {
+	if z := DefZ(NewN("3"), NewN("10")); z.value != -7 {
+		t.Errorf("3-10: expected -7, got %s", z)
+	}
+	if z := DefZ(NewN("10"), NewN("3")); z.value != 7 {
+		t.Errorf("10-3: expected 7, got %s", z)
+	}
+	// would take ages when counting
+	if z := DefZ(&ZERO, NFromUint64(1<<63)); z.value != math.MinInt64 {
+		t.Errorf("0-2^63: expected %d, got %s", int64(math.MinInt64), z)
+	}
+	if z := DefZ(NFromUint64(1<<63-1), &ZERO); z.value != math.MaxInt64 {
+		t.Errorf("(2^63-1)-0: expected %d, got %s", int64(math.MaxInt64), z)
+	}
+	for _, c := range [][2]uint64{{1 << 63, 0}, {0, 1<<63 + 1}} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrOverflow {
+					t.Errorf("%d-%d: expected overflow, got %v", c[0], c[1], r)
+				}
+			}()
+			DefZ(NFromUint64(c[0]), NFromUint64(c[1]))
+		}()
+	}
+}
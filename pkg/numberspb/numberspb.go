@@ -0,0 +1,246 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package numberspb converts exact numbers to and from Protocol Buffers messages defined in numbers.proto, so they can
+// cross service boundaries in binary APIs. The wire format is written and read directly - there's no generated code
+// and no protobuf runtime - and decoding follows the rules of numbers.CanonicalVersion: ℚ has to be in lowest terms
+// with positive denominator. Unknown fields are skipped as in any protobuf decoder.
+package numberspb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Protobuf wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// N is message gomath.numbers.v1.N
+type N struct {
+	Value uint64
+}
+
+// FromN returns the message for n
+func FromN(n *numbers.N) *N {
+	return &N{Value: n.Uint64()}
+}
+
+// ToN returns ℕ of the message
+func (m *N) ToN() *numbers.N {
+	return numbers.NFromUint64(m.Value)
+}
+
+// Marshal returns the wire format of the message
+func (m *N) Marshal() []byte {
+	return appendVarintField(nil, 1, m.Value)
+}
+
+// Unmarshal parses the wire format of the message
+func (m *N) Unmarshal(data []byte) error {
+	*m = N{}
+	return readFields(data, func(field int, v uint64) {
+		if field == 1 {
+			m.Value = v
+		}
+	})
+}
+
+// Z is message gomath.numbers.v1.Z
+type Z struct {
+	Value int64
+}
+
+// FromZ returns the message for z
+func FromZ(z *numbers.Z) *Z {
+	return &Z{Value: z.Int64()}
+}
+
+// ToZ returns ℤ of the message
+func (m *Z) ToZ() *numbers.Z {
+	return numbers.ZFromInt64(m.Value)
+}
+
+// Marshal returns the wire format of the message
+func (m *Z) Marshal() []byte {
+	return appendVarintField(nil, 1, zigzag(m.Value))
+}
+
+// Unmarshal parses the wire format of the message
+func (m *Z) Unmarshal(data []byte) error {
+	*m = Z{}
+	return readFields(data, func(field int, v uint64) {
+		if field == 1 {
+			m.Value = unzigzag(v)
+		}
+	})
+}
+
+// Q is message gomath.numbers.v1.Q
+type Q struct {
+	Numerator   int64
+	Denominator uint64
+}
+
+// FromQ returns the message for q
+func FromQ(q *numbers.Q) *Q {
+	a, b := q.Ratio()
+	return &Q{Numerator: a, Denominator: uint64(b)}
+}
+
+// ToQ returns ℚ of the message, which has to be in canonical form (numbers.ErrNotCanonical is returned otherwise)
+func (m *Q) ToQ() (*numbers.Q, error) {
+	if m.Denominator == 0 || m.Denominator > 1<<63-1 {
+		return nil, fmt.Errorf("invalid denominator %d: %w", m.Denominator, numbers.ErrNotCanonical)
+	}
+	q, e := numbers.QFromInts(m.Numerator, int64(m.Denominator))
+	if e != nil {
+		return nil, e
+	}
+	if a, b := q.Ratio(); a != m.Numerator || uint64(b) != m.Denominator {
+		return nil, fmt.Errorf("%d/%d: %w", m.Numerator, m.Denominator, numbers.ErrNotCanonical)
+	}
+	return q, nil
+}
+
+// Marshal returns the wire format of the message
+func (m *Q) Marshal() []byte {
+	return appendVarintField(appendVarintField(nil, 1, zigzag(m.Numerator)), 2, m.Denominator)
+}
+
+// Unmarshal parses the wire format of the message
+func (m *Q) Unmarshal(data []byte) error {
+	*m = Q{}
+	return readFields(data, func(field int, v uint64) {
+		switch field {
+		case 1:
+			m.Numerator = unzigzag(v)
+		case 2:
+			m.Denominator = v
+		}
+	})
+}
+
+// MarshalN returns the wire format of message N for n
+func MarshalN(n *numbers.N) []byte {
+	return FromN(n).Marshal()
+}
+
+// UnmarshalN returns ℕ of message N in the wire format
+func UnmarshalN(data []byte) (*numbers.N, error) {
+	var m N
+	if e := m.Unmarshal(data); e != nil {
+		return nil, e
+	}
+	return m.ToN(), nil
+}
+
+// MarshalZ returns the wire format of message Z for z
+func MarshalZ(z *numbers.Z) []byte {
+	return FromZ(z).Marshal()
+}
+
+// UnmarshalZ returns ℤ of message Z in the wire format
+func UnmarshalZ(data []byte) (*numbers.Z, error) {
+	var m Z
+	if e := m.Unmarshal(data); e != nil {
+		return nil, e
+	}
+	return m.ToZ(), nil
+}
+
+// MarshalQ returns the wire format of message Q for q
+func MarshalQ(q *numbers.Q) []byte {
+	return FromQ(q).Marshal()
+}
+
+// UnmarshalQ returns ℚ of message Q in the wire format
+func UnmarshalQ(data []byte) (*numbers.Q, error) {
+	var m Q
+	if e := m.Unmarshal(data); e != nil {
+		return nil, e
+	}
+	return m.ToQ()
+}
+
+// appendVarintField appends varint field, unless v is the default 0 which proto3 doesn't write
+func appendVarintField(data []byte, field int, v uint64) []byte {
+	if v == 0 {
+		return data
+	}
+	return binary.AppendUvarint(binary.AppendUvarint(data, uint64(field)<<3|wireVarint), v)
+}
+
+// readFields passes varint fields of data to set and skips fields of other wire types
+func readFields(data []byte, set func(field int, v uint64)) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errors.New("invalid field key")
+		}
+		data = data[n:]
+		field, wire := key>>3, key&7
+		if field == 0 || field > 1<<29-1 {
+			return fmt.Errorf("invalid field number %d", field)
+		}
+		switch wire {
+		case wireVarint:
+			v, n := binary.Uvarint(data)
+			if n <= 0 {
+				return fmt.Errorf("invalid varint of field %d", field)
+			}
+			data = data[n:]
+			set(int(field), v)
+		case wireFixed64, wireFixed32:
+			size := 8
+			if wire == wireFixed32 {
+				size = 4
+			}
+			if len(data) < size {
+				return fmt.Errorf("truncated field %d", field)
+			}
+			data = data[size:]
+		case wireBytes:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || size > uint64(len(data)-n) {
+				return fmt.Errorf("truncated field %d", field)
+			}
+			data = data[n+int(size):]
+		default:
+			return fmt.Errorf("unsupported wire type %d of field %d", wire, field)
+		}
+	}
+	return nil
+}
+
+// zigzag encodes sint64
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+// unzigzag decodes sint64
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
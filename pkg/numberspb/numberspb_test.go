@@ -0,0 +1,115 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package numberspb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestN(t *testing.T) {
+	// the example of protobuf encoding guide
+	data := MarshalN(numbers.NFromUint64(150))
+	if hex.EncodeToString(data) != "089601" {
+		t.Errorf("unexpected %x", data)
+	}
+	for _, v := range []uint64{0, 1, 150, math.MaxUint64} {
+		n, e := UnmarshalN(MarshalN(numbers.NFromUint64(v)))
+		if e != nil || n.Uint64() != v {
+			t.Errorf("%d: got %v (%v)", v, n, e)
+		}
+	}
+	if len(MarshalN(numbers.NFromUint64(0))) != 0 {
+		t.Error("expected default value to be omitted")
+	}
+}
+
+func TestZ(t *testing.T) {
+	for v, h := range map[int64]string{0: "", -1: "0801", 1: "0802", -64: "087f", math.MinInt64: "08ffffffffffffffffff01"} {
+		data := MarshalZ(numbers.ZFromInt64(v))
+		if hex.EncodeToString(data) != h {
+			t.Errorf("%d: expected %s, got %x", v, h, data)
+		}
+		z, e := UnmarshalZ(data)
+		if e != nil || z.Int64() != v {
+			t.Errorf("%d: got %v (%v)", v, z, e)
+		}
+	}
+}
+
+func TestQ(t *testing.T) {
+	data := MarshalQ(numbers.NewQ("-3/4"))
+	if hex.EncodeToString(data) != "08051004" {
+		t.Errorf("unexpected %x", data)
+	}
+	for _, v := range []string{"0/1", "-3/4", "9223372036854775807/2", "-9223372036854775808/1"} {
+		q, e := UnmarshalQ(MarshalQ(numbers.NewQ(v)))
+		if e != nil || q.String() != v {
+			t.Errorf("%s: got %v (%v)", v, q, e)
+		}
+	}
+	for _, m := range []*Q{{Numerator: 2, Denominator: 4}, {Numerator: 1}, {Numerator: 1, Denominator: 1 << 63}, {}} {
+		if _, e := UnmarshalQ(m.Marshal()); !errors.Is(e, numbers.ErrNotCanonical) {
+			t.Errorf("%v: expected ErrNotCanonical, got %v", m, e)
+		}
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	// field 3 as varint, fixed64, bytes and fixed32 around the known fields
+	data, _ := hex.DecodeString("1801" + "080510" + "04" + "190102030405060708" + "1a03616263" + "1d01020304")
+	q, e := UnmarshalQ(data)
+	if e != nil || q.String() != "-3/4" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+	// the last value wins
+	if n, e := UnmarshalN(append(MarshalN(numbers.NFromUint64(1)), MarshalN(numbers.NFromUint64(2))...)); e != nil ||
+		n.Uint64() != 2 {
+		t.Errorf("unexpected %v (%v)", n, e)
+	}
+}
+
+func TestInvalidWireFormat(t *testing.T) {
+	for _, h := range []string{"08", "80", "0080", "1a05", "19010203", "1d01", "0b", "00"} {
+		data, _ := hex.DecodeString(h)
+		if _, e := UnmarshalN(data); e == nil {
+			t.Errorf("%s: expected error", h)
+		}
+	}
+}
+
+func TestMessages(t *testing.T) {
+	m := FromQ(numbers.NewQ("5/-10"))
+	if m.Numerator != -1 || m.Denominator != 2 {
+		t.Errorf("unexpected %v", m)
+	}
+	var decoded Q
+	if e := decoded.Unmarshal(m.Marshal()); e != nil || decoded != *m {
+		t.Errorf("unexpected %v (%v)", decoded, e)
+	}
+	if !bytes.Equal(FromZ(numbers.ZFromInt64(-1)).Marshal(), []byte{0x08, 0x01}) ||
+		FromN(numbers.NFromUint64(3)).ToN().Uint64() != 3 {
+		t.Error("unexpected conversion")
+	}
+}
@@ -0,0 +1,283 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package ordinals implements ordinal numbers below ε₀ - the ordinals which can be written with ω, + , · and ^ from
+// natural numbers - with ordinal addition, multiplication and exponentiation.
+//
+// ℕ is constructed by taking a number and "increasing it by one unit". Ordinals continue the construction past all of
+// ℕ: ω is the first number after 0, 1, 2, ..., then come ω + 1, ω + 2, ..., ω·2, ..., ω^2, ..., ω^ω, ... The
+// operations are defined by the same "repeat the previous one" rules as in ℕ, but they're no longer commutative:
+// 1 + ω = ω < ω + 1 and 2·ω = ω < ω·2.
+package ordinals
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+var (
+	// well known ordinals - shared, because ordinals are immutable
+	zero  = Ordinal{}
+	one   = Ordinal{terms: []term{{exp: &zero, coef: 1}}}
+	omega = Ordinal{terms: []term{{exp: &one, coef: 1}}}
+)
+
+// Ordinal is an immutable ordinal number α < ε₀ in Cantor normal form α = ω^β1·c1 + ω^β2·c2 + ... + ω^βk·ck, where
+// β1 > β2 > ... > βk are ordinals too and c1, ..., ck are positive natural numbers. Each ordinal has exactly one such
+// form, the natural numbers are the ordinals with the only exponent β1 = 0 and 0 is the empty sum.
+type Ordinal struct {
+	// terms of Cantor normal form, exponents in decreasing order
+	terms []term
+
+	fmt.Stringer
+}
+
+// term is ω^exp·coef, coef > 0
+type term struct {
+	exp  *Ordinal
+	coef uint64
+}
+
+// Zero returns 0 - the smallest ordinal
+func Zero() *Ordinal {
+	return &zero
+}
+
+// One returns 1 - the successor of 0
+func One() *Ordinal {
+	return &one
+}
+
+// Omega returns ω - the smallest infinite ordinal, the first one after all natural numbers
+func Omega() *Ordinal {
+	return &omega
+}
+
+// FromN returns natural number n as ordinal
+func FromN(n *numbers.N) *Ordinal {
+	return finite(n.Uint64())
+}
+
+// OmegaPower returns ω^exp
+func OmegaPower(exp *Ordinal) *Ordinal {
+	return &Ordinal{terms: []term{{exp: exp, coef: 1}}}
+}
+
+// finite returns natural number c as ordinal
+func finite(c uint64) *Ordinal {
+	if c == 0 {
+		return &zero
+	}
+	return &Ordinal{terms: []term{{exp: &zero, coef: c}}}
+}
+
+// IsZero checks if α = 0
+func (a *Ordinal) IsZero() bool {
+	return len(a.terms) == 0
+}
+
+// IsFinite checks if α is a natural number
+func (a *Ordinal) IsFinite() bool {
+	return len(a.terms) == 0 || len(a.terms) == 1 && a.terms[0].exp.IsZero()
+}
+
+// IsSuccessor checks if α = β + 1 for some β - it's when the last term of Cantor normal form is finite
+func (a *Ordinal) IsSuccessor() bool {
+	return len(a.terms) > 0 && a.terms[len(a.terms)-1].exp.IsZero()
+}
+
+// IsLimit checks if α > 0 is not a successor - like ω, which comes after all natural numbers, but after none of them
+// directly
+func (a *Ordinal) IsLimit() bool {
+	return len(a.terms) > 0 && !a.IsSuccessor()
+}
+
+// N returns α as natural number, or an error if α is infinite
+func (a *Ordinal) N() (*numbers.N, error) {
+	if !a.IsFinite() {
+		return nil, fmt.Errorf("%s is not a natural number", a)
+	}
+	if a.IsZero() {
+		return numbers.ZeroN(), nil
+	}
+	return numbers.NFromUint64(a.terms[0].coef), nil
+}
+
+// Compare returns -1 if α < β, 0 if α = β and +1 if α > β. Cantor normal forms are compared term by term - the bigger
+// exponent wins, then the bigger coefficient, and a form which continues is bigger than the one which ended.
+func (a *Ordinal) Compare(arg *Ordinal) int {
+	for i := 0; i < len(a.terms) && i < len(arg.terms); i++ {
+		if c := a.terms[i].exp.Compare(arg.terms[i].exp); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.terms[i].coef, arg.terms[i].coef); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a.terms), len(arg.terms))
+}
+
+// Add returns α + β - α followed by β. Terms of α smaller than the leading term ω^e·c of β are absorbed by it
+// (n + ω = ω), so only the terms with bigger exponents are kept and a term with the same exponent gets c added.
+// numbers.ErrOverflow is returned if a coefficient doesn't fit ℕ.
+func (a *Ordinal) Add(arg *Ordinal) (*Ordinal, error) {
+	if arg.IsZero() {
+		return a, nil
+	}
+	lead := arg.terms[0]
+	var res []term
+	for _, t := range a.terms {
+		c := t.exp.Compare(lead.exp)
+		if c < 0 {
+			break
+		}
+		if c == 0 {
+			sum, carry := bits.Add64(t.coef, lead.coef, 0)
+			if carry != 0 {
+				return nil, numbers.ErrOverflow
+			}
+			res = append(res, term{exp: t.exp, coef: sum})
+			return &Ordinal{terms: append(res, arg.terms[1:]...)}, nil
+		}
+		res = append(res, t)
+	}
+	return &Ordinal{terms: append(res, arg.terms...)}, nil
+}
+
+// Multiply returns α·β - α repeated β times. Multiplication distributes over addition only from the left, so α·β is
+// the sum of α·ω^e·c for terms of β, where α·c multiplies the leading coefficient of α and α·ω^e = ω^(α1 + e) for the
+// leading exponent α1 of α. numbers.ErrOverflow is returned if a coefficient doesn't fit ℕ.
+func (a *Ordinal) Multiply(arg *Ordinal) (*Ordinal, error) {
+	if a.IsZero() || arg.IsZero() {
+		return &zero, nil
+	}
+	res := &zero
+	for _, t := range arg.terms {
+		var p *Ordinal
+		if t.exp.IsZero() {
+			hi, lo := bits.Mul64(a.terms[0].coef, t.coef)
+			if hi != 0 {
+				return nil, numbers.ErrOverflow
+			}
+			p = &Ordinal{terms: slices.Clone(a.terms)}
+			p.terms[0].coef = lo
+		} else {
+			e, err := a.terms[0].exp.Add(t.exp)
+			if err != nil {
+				return nil, err
+			}
+			p = &Ordinal{terms: []term{{exp: e, coef: t.coef}}}
+		}
+		var err error
+		if res, err = res.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Power returns α^β - α multiplied by itself β times. β is split into its limit part λ and finite part m, so
+// α^β = α^λ · α^m. For finite α = n >= 2 and λ = ω·γ, n^λ = (n^ω)^γ = ω^γ, and for infinite α with leading exponent
+// α1, α^λ = ω^(α1·λ). α^m is calculated by repeated squaring. numbers.ErrOverflow is returned if a coefficient doesn't
+// fit ℕ.
+func (a *Ordinal) Power(arg *Ordinal) (*Ordinal, error) {
+	switch {
+	case arg.IsZero():
+		return &one, nil
+	case a.IsZero():
+		return &zero, nil
+	case a.Compare(&one) == 0:
+		return &one, nil
+	}
+
+	limit, m := arg.terms, uint64(0)
+	if arg.IsSuccessor() {
+		limit, m = arg.terms[:len(arg.terms)-1], arg.terms[len(arg.terms)-1].coef
+	}
+	res := &one
+	if len(limit) > 0 {
+		if a.IsFinite() {
+			// λ = ω·γ, where each ω^e becomes ω^(e - 1) - with -1 + e = e for infinite e
+			gamma := make([]term, len(limit))
+			for i, t := range limit {
+				gamma[i] = t
+				if t.exp.IsFinite() {
+					gamma[i].exp = finite(t.exp.terms[0].coef - 1)
+				}
+			}
+			res = OmegaPower(&Ordinal{terms: gamma})
+		} else {
+			e, err := a.terms[0].exp.Multiply(&Ordinal{terms: limit})
+			if err != nil {
+				return nil, err
+			}
+			res = OmegaPower(e)
+		}
+	}
+
+	// α^m by repeated squaring - powers of α commute, so the order of factors doesn't matter
+	square := a
+	for ; m > 0; m >>= 1 {
+		var err error
+		if m&1 == 1 {
+			if res, err = res.Multiply(square); err != nil {
+				return nil, err
+			}
+		}
+		if m > 1 {
+			if square, err = square.Multiply(square); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// String formats α in Cantor normal form, like "ω^(ω + 1)·2 + ω^2 + ω·3 + 5". Exponents are right associative, so
+// "ω^ω^2" is ω^(ω^2).
+func (a *Ordinal) String() string {
+	if a.IsZero() {
+		return "0"
+	}
+	parts := make([]string, len(a.terms))
+	for i, t := range a.terms {
+		if t.exp.IsZero() {
+			parts[i] = fmt.Sprint(t.coef)
+			continue
+		}
+		parts[i] = "ω"
+		if e := t.exp; e.Compare(&one) != 0 {
+			if e.IsFinite() || len(e.terms) == 1 && e.terms[0].coef == 1 {
+				parts[i] += "^" + e.String()
+			} else {
+				parts[i] += "^(" + e.String() + ")"
+			}
+		}
+		if t.coef > 1 {
+			parts[i] += fmt.Sprintf("·%d", t.coef)
+		}
+	}
+	return strings.Join(parts, " + ")
+}
+
+var _ = fmt.Stringer(&Ordinal{})
@@ -0,0 +1,196 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ordinals
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func n(v uint64) *Ordinal {
+	return FromN(numbers.NFromUint64(v))
+}
+
+func must(t *testing.T) func(*Ordinal, error) *Ordinal {
+	return func(a *Ordinal, e error) *Ordinal {
+		t.Helper()
+		if e != nil {
+			t.Fatal(e)
+		}
+		return a
+	}
+}
+
+func TestString(t *testing.T) {
+	w := Omega()
+	m := must(t)
+	for _, c := range []struct {
+		a        *Ordinal
+		expected string
+	}{
+		{Zero(), "0"},
+		{One(), "1"},
+		{n(42), "42"},
+		{w, "ω"},
+		{m(w.Multiply(n(3))), "ω·3"},
+		{m(m(w.Multiply(w)).Add(n(5))), "ω^2 + 5"},
+		{OmegaPower(w), "ω^ω"},
+		{OmegaPower(OmegaPower(n(2))), "ω^ω^2"},
+		{m(OmegaPower(m(w.Add(One()))).Multiply(n(2))), "ω^(ω + 1)·2"},
+		{OmegaPower(m(w.Multiply(n(2)))), "ω^(ω·2)"},
+	} {
+		if c.a.String() != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, c.a)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	w := Omega()
+	m := must(t)
+	ordered := []*Ordinal{Zero(), One(), n(2), n(1000), w, m(w.Add(One())), m(w.Multiply(n(2))),
+		m(w.Multiply(w)), m(m(w.Multiply(w)).Add(w)), OmegaPower(n(3)), OmegaPower(w), OmegaPower(OmegaPower(w))}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			if c := a.Compare(b); c != expected {
+				t.Errorf("%s vs %s: expected %d, got %d", a, b, expected, c)
+			}
+		}
+	}
+}
+
+func TestKinds(t *testing.T) {
+	w := Omega()
+	m := must(t)
+	for _, c := range []struct {
+		a                          *Ordinal
+		finite, successor, isLimit bool
+	}{
+		{Zero(), true, false, false},
+		{n(7), true, true, false},
+		{w, false, false, true},
+		{m(w.Add(n(3))), false, true, false},
+		{OmegaPower(w), false, false, true},
+	} {
+		if c.a.IsFinite() != c.finite || c.a.IsSuccessor() != c.successor || c.a.IsLimit() != c.isLimit {
+			t.Errorf("unexpected kind of %s", c.a)
+		}
+	}
+	if v, e := n(7).N(); e != nil || v.Uint64() != 7 {
+		t.Errorf("expected 7, got %v (%v)", v, e)
+	}
+	if _, e := w.N(); e == nil {
+		t.Error("expected error for ω")
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	w := Omega()
+	w1 := must(t)(w.Add(One()))
+	for _, c := range []struct {
+		name     string
+		op       func(*Ordinal, *Ordinal) (*Ordinal, error)
+		a, b     *Ordinal
+		expected string
+	}{
+		{"finite sum", (*Ordinal).Add, n(2), n(3), "5"},
+		{"absorbed", (*Ordinal).Add, n(1), w, "ω"},
+		{"successor", (*Ordinal).Add, w, n(1), "ω + 1"},
+		{"same exponent", (*Ordinal).Add, w1, w, "ω·2"},
+		{"bigger exponent", (*Ordinal).Add, w1, OmegaPower(n(2)), "ω^2"},
+		{"finite product", (*Ordinal).Multiply, n(6), n(7), "42"},
+		{"2·ω", (*Ordinal).Multiply, n(2), w, "ω"},
+		{"ω·2", (*Ordinal).Multiply, w, n(2), "ω·2"},
+		{"(ω + 1)·2", (*Ordinal).Multiply, w1, n(2), "ω·2 + 1"},
+		{"(ω + 1)·ω", (*Ordinal).Multiply, w1, w, "ω^2"},
+		{"ω·(ω + 1)", (*Ordinal).Multiply, w, w1, "ω^2 + ω"},
+		{"by ZERO", (*Ordinal).Multiply, w, Zero(), "0"},
+		{"finite power", (*Ordinal).Power, n(2), n(10), "1024"},
+		{"2^ω", (*Ordinal).Power, n(2), w, "ω"},
+		{"2^(ω + 1)", (*Ordinal).Power, n(2), w1, "ω·2"},
+		{"2^ω^2", (*Ordinal).Power, n(2), OmegaPower(n(2)), "ω^ω"},
+		{"3^ω^ω", (*Ordinal).Power, n(3), OmegaPower(w), "ω^ω^ω"},
+		{"ω^2", (*Ordinal).Power, w, n(2), "ω^2"},
+		{"(ω + 1)^2", (*Ordinal).Power, w1, n(2), "ω^2 + ω + 1"},
+		{"(ω + 1)^ω", (*Ordinal).Power, w1, w, "ω^ω"},
+		{"(ω + 1)^(ω + 1)", (*Ordinal).Power, w1, w1, "ω^(ω + 1) + ω^ω"},
+		{"ω^ω", (*Ordinal).Power, w, w, "ω^ω"},
+		{"1^ω", (*Ordinal).Power, One(), w, "1"},
+		{"0^ω", (*Ordinal).Power, Zero(), w, "0"},
+		{"ω^0", (*Ordinal).Power, w, Zero(), "1"},
+		{"huge finite exponent", (*Ordinal).Power, w, n(math.MaxUint64), "ω^18446744073709551615"},
+	} {
+		res, e := c.op(c.a, c.b)
+		if e != nil || res.String() != c.expected {
+			t.Errorf("%s: expected %s, got %v (%v)", c.name, c.expected, res, e)
+		}
+	}
+}
+
+func TestLaws(t *testing.T) {
+	w := Omega()
+	m := must(t)
+	values := []*Ordinal{Zero(), One(), n(3), w, m(w.Add(n(2))), m(w.Multiply(n(2))), OmegaPower(n(2)),
+		m(OmegaPower(w).Add(w))}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				// associativity of + and ·
+				if m(m(a.Add(b)).Add(c)).Compare(m(a.Add(m(b.Add(c))))) != 0 {
+					t.Errorf("(%s + %s) + %s != %s + (%s + %s)", a, b, c, a, b, c)
+				}
+				if m(m(a.Multiply(b)).Multiply(c)).Compare(m(a.Multiply(m(b.Multiply(c))))) != 0 {
+					t.Errorf("(%s · %s) · %s != %s · (%s · %s)", a, b, c, a, b, c)
+				}
+				// left distributivity and α^(β + γ) = α^β · α^γ
+				if m(a.Multiply(m(b.Add(c)))).Compare(m(m(a.Multiply(b)).Add(m(a.Multiply(c))))) != 0 {
+					t.Errorf("%s · (%s + %s) != %s · %s + %s · %s", a, b, c, a, b, a, c)
+				}
+				if m(a.Power(m(b.Add(c)))).Compare(m(m(a.Power(b)).Multiply(m(a.Power(c))))) != 0 {
+					t.Errorf("%s^(%s + %s) != %s^%s · %s^%s", a, b, c, a, b, a, c)
+				}
+			}
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	big := n(math.MaxUint64)
+	if _, e := big.Add(One()); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := big.Multiply(n(2)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := n(2).Power(n(64)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if p, e := n(2).Power(n(63)); e != nil || p.String() != "9223372036854775808" {
+		t.Errorf("expected 2^63, got %v (%v)", p, e)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Add returns p + arg, adding coefficients of the same powers
+func (p *Polynomial) Add(arg *Polynomial) (*Polynomial, error) {
+	res := make([]*numbers.Q, max(len(p.coefficients), len(arg.coefficients)))
+	for i := range res {
+		var e error
+		if res[i], e = p.Coefficient(i).AddChecked(arg.Coefficient(i)); e != nil {
+			return nil, e
+		}
+	}
+	return New(res...), nil
+}
+
+// Subtract returns p - arg
+func (p *Polynomial) Subtract(arg *Polynomial) (*Polynomial, error) {
+	neg, e := arg.Scale(numbers.NewQ("-1/1"))
+	if e != nil {
+		return nil, e
+	}
+	return p.Add(neg)
+}
+
+// Scale returns c * p
+func (p *Polynomial) Scale(c *numbers.Q) (*Polynomial, error) {
+	res := make([]*numbers.Q, len(p.coefficients))
+	for i, pc := range p.coefficients {
+		var e error
+		if res[i], e = pc.MultiplyChecked(c); e != nil {
+			return nil, e
+		}
+	}
+	return New(res...), nil
+}
+
+// Multiply returns p * arg - coefficient of x^k is the sum of ci * dj for i + j = k
+func (p *Polynomial) Multiply(arg *Polynomial) (*Polynomial, error) {
+	if len(p.coefficients) == 0 || len(arg.coefficients) == 0 {
+		return New(), nil
+	}
+	res := make([]*numbers.Q, len(p.coefficients)+len(arg.coefficients)-1)
+	for i := range res {
+		res[i] = numbers.ZeroQ()
+	}
+	for i, c := range p.coefficients {
+		for j, d := range arg.coefficients {
+			cd, e := c.MultiplyChecked(d)
+			if e != nil {
+				return nil, e
+			}
+			if res[i+j], e = res[i+j].AddChecked(cd); e != nil {
+				return nil, e
+			}
+		}
+	}
+	return New(res...), nil
+}
+
+// DivideR returns quotient and remainder of polynomial long division: p = quotient * arg + remainder, where the degree
+// of remainder is lower than the degree of arg
+func (p *Polynomial) DivideR(arg *Polynomial) (*Polynomial, *Polynomial, error) {
+	if len(arg.coefficients) == 0 {
+		return nil, nil, errors.New("can't divide by ZERO polynomial")
+	}
+	rem := append([]*numbers.Q(nil), p.coefficients...)
+	dn := len(arg.coefficients) - 1
+	lead := arg.coefficients[dn]
+	quotient := make([]*numbers.Q, max(len(rem)-dn, 0))
+	for i := len(rem) - 1; i >= dn; i-- {
+		// the next term of quotient removes the highest power of remainder
+		c, e := rem[i].Divide(lead)
+		if e != nil {
+			return nil, nil, e
+		}
+		quotient[i-dn] = c
+		for j, d := range arg.coefficients {
+			cd, e := c.MultiplyChecked(d)
+			if e != nil {
+				return nil, nil, e
+			}
+			if rem[i-dn+j], e = rem[i-dn+j].SubtractChecked(cd); e != nil {
+				return nil, nil, e
+			}
+		}
+	}
+	return New(quotient...), New(rem[:min(dn, len(rem))]...), nil
+}
+
+// Monic returns p divided by its leading coefficient, so the coefficient of the highest power is 1. The ZERO
+// polynomial stays ZERO.
+func (p *Polynomial) Monic() (*Polynomial, error) {
+	if len(p.coefficients) == 0 {
+		return p, nil
+	}
+	lead := p.coefficients[len(p.coefficients)-1]
+	inv, e := numbers.OneQ().Divide(lead)
+	if e != nil {
+		return nil, e
+	}
+	return p.Scale(inv)
+}
+
+// GCD returns monic greatest common divisor of p and arg - Euclid's algorithm with polynomial division:
+// gcd(A, B) = gcd(B, A mod B) and gcd(A, 0) = A. It's ZERO only when both p and arg are ZERO.
+func GCD(p *Polynomial, arg *Polynomial) (*Polynomial, error) {
+	a, b := p, arg
+	for len(b.coefficients) > 0 {
+		_, r, e := a.DivideR(b)
+		if e != nil {
+			return nil, e
+		}
+		// monic remainders keep the coefficients small
+		if r, e = r.Monic(); e != nil {
+			return nil, e
+		}
+		a, b = b, r
+	}
+	return a.Monic()
+}
@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestArithmetic(t *testing.T) {
+	p := FromInts(1, 2, 3)
+	q := FromInts(-1, 0, -3, 1)
+	if s, e := p.Add(q); e != nil || s.String() != "x^3 + 2x" {
+		t.Errorf("sum: unexpected %v, %v", s, e)
+	}
+	if s, e := p.Subtract(p); e != nil || s.Degree() != -1 {
+		t.Errorf("difference: unexpected %v, %v", s, e)
+	}
+	if s, e := p.Subtract(q); e != nil || s.String() != "-x^3 + 6x^2 + 2x + 2" {
+		t.Errorf("difference: unexpected %v, %v", s, e)
+	}
+	// (x - 1)(x + 1) = x^2 - 1
+	if m, e := FromInts(-1, 1).Multiply(FromInts(1, 1)); e != nil || m.String() != "x^2 - 1" {
+		t.Errorf("product: unexpected %v, %v", m, e)
+	}
+	if m, e := p.Multiply(FromInts()); e != nil || m.Degree() != -1 {
+		t.Errorf("product: unexpected %v, %v", m, e)
+	}
+	if s, e := p.Scale(numbers.NewQ("1/2")); e != nil || s.String() != "(3/2)x^2 + x + 1/2" {
+		t.Errorf("scaled: unexpected %v, %v", s, e)
+	}
+	if _, e := FromInts(math.MaxInt64).Add(FromInts(math.MaxInt64)); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestDivideR(t *testing.T) {
+	for _, c := range []struct {
+		p, d     *Polynomial
+		quo, rem string
+	}{
+		{FromInts(-1, 0, 1), FromInts(-1, 1), "x + 1", "0"},
+		{FromInts(1, 0, 1), FromInts(-1, 1), "x + 1", "2"},
+		{FromInts(1, 2, 3, 4), FromInts(0, 2), "2x^2 + (3/2)x + 1", "1"},
+		{FromInts(1, 1), FromInts(1, 0, 1), "0", "x + 1"},
+		{FromInts(5, 1), FromInts(2), "(1/2)x + 5/2", "0"},
+	} {
+		quo, rem, e := c.p.DivideR(c.d)
+		if e != nil || quo.String() != c.quo || rem.String() != c.rem {
+			t.Errorf("(%s) / (%s): expected %s, %s, got %v, %v, %v", c.p, c.d, c.quo, c.rem, quo, rem, e)
+			continue
+		}
+		back, _ := quo.Multiply(c.d)
+		back, _ = back.Add(rem)
+		if !back.Equal(c.p) {
+			t.Errorf("(%s) / (%s): %s * (%s) + %s != %s", c.p, c.d, quo, c.d, rem, c.p)
+		}
+	}
+	if _, _, e := FromInts(1).DivideR(FromInts()); e == nil {
+		t.Errorf("expected error for division by ZERO")
+	}
+}
+
+func TestGCD(t *testing.T) {
+	for _, c := range []struct {
+		p, q *Polynomial
+		exp  string
+	}{
+		// (x - 1)(x + 2) and (x - 1)(x - 3)
+		{FromInts(-2, 1, 1), FromInts(3, -4, 1), "x - 1"},
+		{FromInts(2, 2), FromInts(3, 3), "x + 1"},
+		{FromInts(1, 1), FromInts(2, 1), "1"},
+		{FromInts(0, 2), FromInts(), "x"},
+		{FromInts(), FromInts(), "0"},
+	} {
+		g, e := GCD(c.p, c.q)
+		if e != nil || g.String() != c.exp {
+			t.Errorf("gcd(%s, %s): expected %q, got %v, %v", c.p, c.q, c.exp, g, e)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Derivative returns formal derivative of p: (c0 + c1*x + ... + cn*x^n)' = c1 + 2*c2*x + ... + n*cn*x^(n-1)
+func (p *Polynomial) Derivative() (*Polynomial, error) {
+	if len(p.coefficients) <= 1 {
+		return New(), nil
+	}
+	res := make([]*numbers.Q, len(p.coefficients)-1)
+	for i := range res {
+		k, _ := numbers.QFromInts(int64(i+1), 1)
+		var e error
+		if res[i], e = p.coefficients[i+1].MultiplyChecked(k); e != nil {
+			return nil, e
+		}
+	}
+	return New(res...), nil
+}
+
+// Integral returns antiderivative of p with given constant: c + c0*x + c1/2*x^2 + ... + cn/(n+1)*x^(n+1). Its
+// derivative is p.
+func (p *Polynomial) Integral(constant *numbers.Q) (*Polynomial, error) {
+	res := make([]*numbers.Q, len(p.coefficients)+1)
+	res[0] = constant
+	for i, c := range p.coefficients {
+		k, _ := numbers.QFromInts(int64(i+1), 1)
+		var e error
+		if res[i+1], e = c.Divide(k); e != nil {
+			return nil, e
+		}
+	}
+	return New(res...), nil
+}
+
+// DefiniteIntegral returns the integral of p over [a, b] - F(b) - F(a) for antiderivative F. It's exact, so the area
+// under x^2 over [0, 1] is exactly 1/3.
+func (p *Polynomial) DefiniteIntegral(a *numbers.Q, b *numbers.Q) (*numbers.Q, error) {
+	f, e := p.Integral(numbers.ZeroQ())
+	if e != nil {
+		return nil, e
+	}
+	fa, e := f.Evaluate(a)
+	if e != nil {
+		return nil, e
+	}
+	fb, e := f.Evaluate(b)
+	if e != nil {
+		return nil, e
+	}
+	return fb.SubtractChecked(fa)
+}
@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDerivative(t *testing.T) {
+	for _, c := range []struct {
+		p   *Polynomial
+		exp string
+	}{
+		{FromInts(1, 0, 3), "6x"},
+		{FromInts(5), "0"},
+		{FromInts(), "0"},
+		{FromInts(1, 1, 1, 1), "3x^2 + 2x + 1"},
+		{New(numbers.NewQ("0/1"), numbers.NewQ("0/1"), numbers.NewQ("1/4")), "(1/2)x"},
+	} {
+		d, e := c.p.Derivative()
+		if e != nil || d.String() != c.exp {
+			t.Errorf("(%s)': expected %q, got %v, %v", c.p, c.exp, d, e)
+		}
+	}
+	if _, e := FromInts(0, 0, math.MaxInt64).Derivative(); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestIntegral(t *testing.T) {
+	for _, c := range []struct {
+		p        *Polynomial
+		constant string
+		exp      string
+	}{
+		{FromInts(0, 6), "0/1", "3x^2"},
+		{FromInts(1, 1, 1), "5/1", "(1/3)x^3 + (1/2)x^2 + x + 5"},
+		{FromInts(), "-1/2", "-1/2"},
+	} {
+		i, e := c.p.Integral(numbers.NewQ(c.constant))
+		if e != nil || i.String() != c.exp {
+			t.Errorf("∫%s: expected %q, got %v, %v", c.p, c.exp, i, e)
+			continue
+		}
+		if d, _ := i.Derivative(); !d.Equal(c.p) {
+			t.Errorf("(∫%s)' = %s", c.p, d)
+		}
+	}
+}
+
+func TestDefiniteIntegral(t *testing.T) {
+	for _, c := range []struct {
+		p    *Polynomial
+		a, b string
+		exp  string
+	}{
+		{FromInts(0, 0, 1), "0/1", "1/1", "1/3"},
+		{FromInts(0, 0, 1), "1/1", "0/1", "-1/3"},
+		{FromInts(1, -3, 2), "0/1", "1/1", "1/6"},
+		{FromInts(0, 1), "-1/1", "1/1", "0/1"},
+		{FromInts(3), "1/2", "3/4", "3/4"},
+	} {
+		v, e := c.p.DefiniteIntegral(numbers.NewQ(c.a), numbers.NewQ(c.b))
+		if e != nil || v.String() != c.exp {
+			t.Errorf("∫%s over [%s, %s]: expected %s, got %v, %v", c.p, c.a, c.b, c.exp, v, e)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/grgrzybek/gomath/pkg/interval"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// ErrNoRoot is returned when an interval certainly contains no root of a polynomial
+var ErrNoRoot = errors.New("no root in the interval")
+
+// EvaluateInterval returns an interval containing p(x) for every x in given interval - Horner's scheme in interval
+// arithmetic. It may be wider than the exact range (x^2 - x over [0, 1] gives [-1, 0] instead of [-1/4, 0]), but
+// never narrower.
+func (p *Polynomial) EvaluateInterval(x *interval.Interval) (*interval.Interval, error) {
+	res := interval.Point(numbers.ZeroQ())
+	for i := len(p.coefficients) - 1; i >= 0; i-- {
+		var e error
+		if res, e = res.Multiply(x); e != nil {
+			return nil, e
+		}
+		if res, e = res.Add(interval.Point(p.coefficients[i])); e != nil {
+			return nil, e
+		}
+	}
+	return res, nil
+}
+
+// NewtonStep is a step of interval Newton method. For m in x and a root r in x, p(m) = p(m) - p(r) = p'(ξ)(m - r) for
+// some ξ in x (the mean value theorem), so r = m - p(m)/p'(ξ) is in N(x) = m - p(m)/p'(x). The result is x ∩ N(x) -
+// usually much narrower than x, but still containing every root of p in x. unique is true when N(x) is in the
+// interior of x, which proves that x contains exactly one root.
+//
+// m is the simplest number in the middle of x and the ends of N(x) are rounded outwards to simpler numbers, so the
+// denominators don't grow faster than necessary. Returns ErrNoRoot when x ∩ N(x) is empty,
+// interval.ErrContainsZero when p' may vanish in x (x has to be split first) and ErrOverflow.
+func (p *Polynomial) NewtonStep(x *interval.Interval) (next *interval.Interval, unique bool, e error) {
+	d, e := p.Derivative()
+	if e != nil {
+		return nil, false, e
+	}
+	return p.newtonStep(d, x)
+}
+
+// Newton returns lazy iterator over shrinking intervals containing the roots of p in x - the results of repeated
+// NewtonStep, which converge quadratically to a simple root. The iteration stops when the interval doesn't shrink
+// any more (it's the root itself or the next step would overflow) or the step fails - NewtonStep tells why.
+func (p *Polynomial) Newton(x *interval.Interval) iter.Seq[*interval.Interval] {
+	return func(yield func(*interval.Interval) bool) {
+		d, e := p.Derivative()
+		if e != nil {
+			return
+		}
+		for {
+			next, _, e := p.newtonStep(d, x)
+			if e != nil || next.Equal(x) {
+				return
+			}
+			if !yield(next) {
+				return
+			}
+			x = next
+		}
+	}
+}
+
+// newtonStep is NewtonStep with derivative d of p
+func (p *Polynomial) newtonStep(d *Polynomial, x *interval.Interval) (*interval.Interval, bool, error) {
+	dx, e := d.EvaluateInterval(x)
+	if e != nil {
+		return nil, false, e
+	}
+	m, e := middle(x)
+	if e != nil {
+		return nil, false, e
+	}
+	pm, e := p.Evaluate(m)
+	if e != nil {
+		return nil, false, e
+	}
+	q, e := interval.Point(pm).Divide(dx)
+	if e != nil {
+		return nil, false, e
+	}
+	n, e := interval.Point(m).Subtract(q)
+	if e != nil {
+		return nil, false, e
+	}
+	if n, e = outwards(n); e != nil {
+		return nil, false, e
+	}
+	res, ok := x.Intersect(n)
+	if !ok {
+		return nil, false, ErrNoRoot
+	}
+	return res, n.Interior(x), nil
+}
+
+// middle returns the simplest number in the middle half of x
+func middle(x *interval.Interval) (*numbers.Q, error) {
+	w, e := x.Width()
+	if e != nil {
+		return nil, e
+	}
+	quarter, e := w.MultiplyChecked(numbers.NewQ("1/4"))
+	if e != nil {
+		return nil, e
+	}
+	lo, e := x.Lo().AddChecked(quarter)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := x.Hi().SubtractChecked(quarter)
+	if e != nil {
+		return nil, e
+	}
+	m, e := interval.New(lo, hi)
+	if e != nil {
+		return nil, e
+	}
+	return m.Simplest()
+}
+
+// outwards returns an interval containing x, with the ends replaced by the simplest numbers at most 1/8 of the width
+// of x away
+func outwards(x *interval.Interval) (*interval.Interval, error) {
+	w, e := x.Width()
+	if e != nil {
+		return nil, e
+	}
+	if w.Compare(numbers.ZeroQ()) == 0 {
+		return x, nil
+	}
+	slack, e := w.MultiplyChecked(numbers.NewQ("1/8"))
+	if e != nil {
+		return nil, e
+	}
+	lo, e := x.Lo().SubtractChecked(slack)
+	if e != nil {
+		return nil, e
+	}
+	hi, e := x.Hi().AddChecked(slack)
+	if e != nil {
+		return nil, e
+	}
+	below, _ := interval.New(lo, x.Lo())
+	above, _ := interval.New(x.Hi(), hi)
+	if lo, e = below.Simplest(); e != nil {
+		return nil, e
+	}
+	if hi, e = above.Simplest(); e != nil {
+		return nil, e
+	}
+	return interval.New(lo, hi)
+}
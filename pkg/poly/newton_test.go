@@ -0,0 +1,93 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/interval"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestEvaluateInterval(t *testing.T) {
+	x, _ := interval.New(numbers.ZeroQ(), numbers.OneQ())
+	if r, e := FromInts(0, -1, 1).EvaluateInterval(x); e != nil || r.String() != "[-1/1, 0/1]" {
+		t.Errorf("x^2 - x over %s: %v (%v)", x, r, e)
+	}
+	if r, e := New().EvaluateInterval(x); e != nil || r.String() != "[0/1, 0/1]" {
+		t.Errorf("0 over %s: %v (%v)", x, r, e)
+	}
+}
+
+func TestNewton(t *testing.T) {
+	// √2 is the only root of x^2 - 2 in [1, 2]
+	p := FromInts(-2, 0, 1)
+	x, _ := interval.New(numbers.OneQ(), numbers.NewQ("2/1"))
+	if _, unique, e := p.NewtonStep(x); e != nil || !unique {
+		t.Errorf("expected unique root in %s, got %v (%v)", x, unique, e)
+	}
+	steps := 0
+	last := x
+	for next := range p.Newton(x) {
+		steps++
+		if !next.Subset(last) || next.Equal(last) {
+			t.Errorf("%s doesn't shrink %s", next, last)
+		}
+		// lo^2 <= 2 <= hi^2
+		lo, _ := p.Evaluate(next.Lo())
+		hi, _ := p.Evaluate(next.Hi())
+		if lo.Compare(numbers.ZeroQ()) > 0 || hi.Compare(numbers.ZeroQ()) < 0 {
+			t.Errorf("%s doesn't contain √2", next)
+		}
+		last = next
+	}
+	w, _ := last.Width()
+	if steps < 4 || w.Compare(numbers.NewQ("1/1000000000000")) > 0 {
+		t.Errorf("expected narrow enclosure of √2, got %s after %d steps", last, steps)
+	}
+}
+
+func TestNewtonExactRoot(t *testing.T) {
+	// (2x - 1)(x + 3) has root 1/2 in [0, 1]
+	p := FromInts(-3, 5, 2)
+	x, _ := interval.New(numbers.ZeroQ(), numbers.OneQ())
+	var last *interval.Interval
+	for next := range p.Newton(x) {
+		last = next
+	}
+	if last == nil || !last.Equal(interval.Point(numbers.NewQ("1/2"))) {
+		t.Errorf("expected [1/2, 1/2], got %v", last)
+	}
+}
+
+func TestNewtonFailures(t *testing.T) {
+	p := FromInts(-2, 0, 1)
+	x, _ := interval.New(numbers.NewQ("2/1"), numbers.NewQ("3/1"))
+	if _, _, e := p.NewtonStep(x); !errors.Is(e, ErrNoRoot) {
+		t.Errorf("expected ErrNoRoot in %s, got %v", x, e)
+	}
+	x, _ = interval.New(numbers.NewQ("-2/1"), numbers.NewQ("2/1"))
+	if _, _, e := p.NewtonStep(x); !errors.Is(e, interval.ErrContainsZero) {
+		t.Errorf("expected ErrContainsZero in %s, got %v", x, e)
+	}
+	for next := range p.Newton(x) {
+		t.Errorf("unexpected enclosure %s", next)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package poly implements polynomials with coefficients in ℚ and their exact calculus
+package poly
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Polynomial is c0 + c1*x + c2*x^2 + ... + cn*x^n with coefficients in ℚ. Like numbers, polynomials are immutable.
+type Polynomial struct {
+	// coefficients, the lowest power first, without trailing ZEROs (empty for the ZERO polynomial)
+	coefficients []*numbers.Q
+
+	fmt.Stringer
+}
+
+// New creates polynomial with given coefficients, the lowest power first: New(1, 0, 3) is 3x^2 + 1
+func New(coefficients ...*numbers.Q) *Polynomial {
+	n := len(coefficients)
+	for n > 0 && coefficients[n-1].Compare(numbers.ZeroQ()) == 0 {
+		n--
+	}
+	return &Polynomial{coefficients: append([]*numbers.Q(nil), coefficients[:n]...)}
+}
+
+// FromInts creates polynomial with integer coefficients, the lowest power first
+func FromInts(coefficients ...int64) *Polynomial {
+	qs := make([]*numbers.Q, len(coefficients))
+	for i, c := range coefficients {
+		qs[i], _ = numbers.QFromInts(c, 1)
+	}
+	return New(qs...)
+}
+
+// Degree returns the highest power with non-ZERO coefficient, -1 for the ZERO polynomial
+func (p *Polynomial) Degree() int {
+	return len(p.coefficients) - 1
+}
+
+// Coefficient returns the coefficient of x^i (ZERO above the degree)
+func (p *Polynomial) Coefficient(i int) *numbers.Q {
+	if i < 0 || i >= len(p.coefficients) {
+		return numbers.ZeroQ()
+	}
+	return p.coefficients[i]
+}
+
+// Evaluate returns p(x), using Horner's scheme: c0 + x(c1 + x(c2 + ... + x*cn))
+func (p *Polynomial) Evaluate(x *numbers.Q) (*numbers.Q, error) {
+	res := numbers.ZeroQ()
+	for i := len(p.coefficients) - 1; i >= 0; i-- {
+		var e error
+		if res, e = res.MultiplyChecked(x); e != nil {
+			return nil, e
+		}
+		if res, e = res.AddChecked(p.coefficients[i]); e != nil {
+			return nil, e
+		}
+	}
+	return res, nil
+}
+
+// Equal checks if p and arg have the same coefficients
+func (p *Polynomial) Equal(arg *Polynomial) bool {
+	if len(p.coefficients) != len(arg.coefficients) {
+		return false
+	}
+	for i, c := range p.coefficients {
+		if c.Compare(arg.coefficients[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats p with the highest power first, like "3x^2 - x + 1/2". Fractional coefficients of x are put in
+// parentheses: "(1/2)x".
+func (p *Polynomial) String() string {
+	if len(p.coefficients) == 0 {
+		return "0"
+	}
+	var sb strings.Builder
+	for i := len(p.coefficients) - 1; i >= 0; i-- {
+		a, b := p.coefficients[i].Ratio()
+		if a == 0 {
+			continue
+		}
+		switch {
+		case sb.Len() == 0 && a < 0:
+			sb.WriteString("-")
+		case sb.Len() > 0 && a < 0:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		c := strings.TrimPrefix(p.coefficients[i].String(), "-")
+		switch {
+		case i == 0 && b == 1:
+			sb.WriteString(strings.TrimSuffix(c, "/1"))
+		case i == 0:
+			sb.WriteString(c)
+		case b != 1:
+			sb.WriteString("(" + c + ")")
+		case c != "1/1":
+			sb.WriteString(strings.TrimSuffix(c, "/1"))
+		}
+		switch {
+		case i == 1:
+			sb.WriteString("x")
+		case i > 1:
+			fmt.Fprintf(&sb, "x^%d", i)
+		}
+	}
+	return sb.String()
+}
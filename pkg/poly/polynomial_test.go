@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPolynomial(t *testing.T) {
+	for _, c := range []struct {
+		p   *Polynomial
+		exp string
+		deg int
+	}{
+		{FromInts(), "0", -1},
+		{FromInts(0, 0), "0", -1},
+		{FromInts(5), "5", 0},
+		{FromInts(-5), "-5", 0},
+		{FromInts(1, 0, 3), "3x^2 + 1", 2},
+		{FromInts(1, -1, 0, 0), "-x + 1", 1},
+		{FromInts(0, 1), "x", 1},
+		{New(numbers.NewQ("1/2"), numbers.NewQ("-3/4"), numbers.NewQ("2/1")), "2x^2 - (3/4)x + 1/2", 2},
+		{FromInts(-1, 0, 0, -1), "-x^3 - 1", 3},
+	} {
+		if c.p.String() != c.exp || c.p.Degree() != c.deg {
+			t.Errorf("expected %q of degree %d, got %q of degree %d", c.exp, c.deg, c.p, c.p.Degree())
+		}
+	}
+	p := FromInts(1, 2)
+	if p.Coefficient(1).String() != "2/1" || p.Coefficient(5).String() != "0/1" || p.Coefficient(-1).String() != "0/1" {
+		t.Errorf("unexpected coefficients of %s", p)
+	}
+	if !FromInts(1, 2, 0).Equal(FromInts(1, 2)) || FromInts(1, 2).Equal(FromInts(1, 3)) {
+		t.Errorf("wrong equality")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	p := FromInts(1, -3, 2) // 2x^2 - 3x + 1 = (2x - 1)(x - 1)
+	for x, exp := range map[string]string{"0/1": "1/1", "1/1": "0/1", "1/2": "0/1", "2/1": "3/1", "1/3": "2/9"} {
+		v, e := p.Evaluate(numbers.NewQ(x))
+		if e != nil || v.String() != exp {
+			t.Errorf("p(%s): expected %s, got %v, %v", x, exp, v, e)
+		}
+	}
+	if v, e := FromInts().Evaluate(numbers.NewQ("7/1")); e != nil || v.String() != "0/1" {
+		t.Errorf("expected 0, got %v, %v", v, e)
+	}
+	if _, e := FromInts(0, 0, 0, 1).Evaluate(numbers.NewQ("3000000/1")); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
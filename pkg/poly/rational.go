@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// RationalFunction is P(x) / Q(x) for polynomials P and Q != 0. It's kept in canonical form - P and Q have no common
+// factor and Q is monic - so equal functions have equal representation, just like ℚ. Rational functions are immutable.
+type RationalFunction struct {
+	num *Polynomial
+	den *Polynomial
+
+	fmt.Stringer
+}
+
+// PoleError is returned when rational function is evaluated where its denominator is ZERO
+type PoleError struct {
+	At *numbers.Q
+}
+
+func (e *PoleError) Error() string {
+	return fmt.Sprintf("rational function has a pole at %s", e.At)
+}
+
+// NewRationalFunction creates num / den in canonical form - both are divided by their GCD and by the leading
+// coefficient of den: (2x^2 - 2) / (2x - 2) is (x + 1) / 1
+func NewRationalFunction(num *Polynomial, den *Polynomial) (*RationalFunction, error) {
+	if den.Degree() < 0 {
+		return nil, errors.New("denominator can't be ZERO polynomial")
+	}
+	g, e := GCD(num, den)
+	if e != nil {
+		return nil, e
+	}
+	if num, _, e = num.DivideR(g); e != nil {
+		return nil, e
+	}
+	if den, _, e = den.DivideR(g); e != nil {
+		return nil, e
+	}
+	lead := den.Coefficient(den.Degree())
+	inv, e := numbers.OneQ().Divide(lead)
+	if e != nil {
+		return nil, e
+	}
+	if num, e = num.Scale(inv); e != nil {
+		return nil, e
+	}
+	if den, e = den.Scale(inv); e != nil {
+		return nil, e
+	}
+	return &RationalFunction{num: num, den: den}, nil
+}
+
+// Numerator returns P of canonical P / Q
+func (r *RationalFunction) Numerator() *Polynomial {
+	return r.num
+}
+
+// Denominator returns monic Q of canonical P / Q
+func (r *RationalFunction) Denominator() *Polynomial {
+	return r.den
+}
+
+// Add returns A/B + C/D = (AD + CB) / BD
+func (r *RationalFunction) Add(arg *RationalFunction) (*RationalFunction, error) {
+	ad, e := r.num.Multiply(arg.den)
+	if e != nil {
+		return nil, e
+	}
+	cb, e := arg.num.Multiply(r.den)
+	if e != nil {
+		return nil, e
+	}
+	num, e := ad.Add(cb)
+	if e != nil {
+		return nil, e
+	}
+	den, e := r.den.Multiply(arg.den)
+	if e != nil {
+		return nil, e
+	}
+	return NewRationalFunction(num, den)
+}
+
+// Subtract returns A/B - C/D = A/B + (-C)/D
+func (r *RationalFunction) Subtract(arg *RationalFunction) (*RationalFunction, error) {
+	neg, e := arg.num.Scale(numbers.NewQ("-1/1"))
+	if e != nil {
+		return nil, e
+	}
+	return r.Add(&RationalFunction{num: neg, den: arg.den})
+}
+
+// Multiply returns A/B * C/D = AC / BD
+func (r *RationalFunction) Multiply(arg *RationalFunction) (*RationalFunction, error) {
+	num, e := r.num.Multiply(arg.num)
+	if e != nil {
+		return nil, e
+	}
+	den, e := r.den.Multiply(arg.den)
+	if e != nil {
+		return nil, e
+	}
+	return NewRationalFunction(num, den)
+}
+
+// Divide returns A/B / C/D = AD / BC, C can't be ZERO
+func (r *RationalFunction) Divide(arg *RationalFunction) (*RationalFunction, error) {
+	if arg.num.Degree() < 0 {
+		return nil, errors.New("can't divide by ZERO")
+	}
+	num, e := r.num.Multiply(arg.den)
+	if e != nil {
+		return nil, e
+	}
+	den, e := r.den.Multiply(arg.num)
+	if e != nil {
+		return nil, e
+	}
+	return NewRationalFunction(num, den)
+}
+
+// Evaluate returns P(x) / Q(x) or *PoleError if Q(x) = 0. The function is canonical, so removable singularities are
+// already gone: (x^2 - 1) / (x - 1) is x + 1 and its value at 1 is 2.
+func (r *RationalFunction) Evaluate(x *numbers.Q) (*numbers.Q, error) {
+	den, e := r.den.Evaluate(x)
+	if e != nil {
+		return nil, e
+	}
+	if den.Compare(numbers.ZeroQ()) == 0 {
+		return nil, &PoleError{At: x}
+	}
+	num, e := r.num.Evaluate(x)
+	if e != nil {
+		return nil, e
+	}
+	return num.Divide(den)
+}
+
+// String formats r as "(P) / (Q)", or just "P" if Q = 1
+func (r *RationalFunction) String() string {
+	if r.den.Degree() == 0 {
+		return r.num.String()
+	}
+	return fmt.Sprintf("(%s) / (%s)", r.num, r.den)
+}
@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func rf(t *testing.T, num *Polynomial, den *Polynomial) *RationalFunction {
+	r, e := NewRationalFunction(num, den)
+	if e != nil {
+		t.Fatalf("unexpected error %v", e)
+	}
+	return r
+}
+
+func TestNewRationalFunction(t *testing.T) {
+	for _, c := range []struct {
+		num, den *Polynomial
+		exp      string
+	}{
+		{FromInts(-2, 0, 2), FromInts(-2, 2), "x + 1"},
+		{FromInts(1), FromInts(0, 2), "(1/2) / (x)"},
+		{FromInts(1, 1), FromInts(-1, 0, 1), "(1) / (x - 1)"},
+		{FromInts(), FromInts(1, 1), "0"},
+		{FromInts(3, 0, 1), FromInts(1, 0, 1, 0, 0), "(x^2 + 3) / (x^2 + 1)"},
+	} {
+		if r := rf(t, c.num, c.den); r.String() != c.exp {
+			t.Errorf("(%s) / (%s): expected %q, got %q", c.num, c.den, c.exp, r)
+		}
+	}
+	if _, e := NewRationalFunction(FromInts(1), FromInts()); e == nil {
+		t.Errorf("expected error for ZERO denominator")
+	}
+	r := rf(t, FromInts(2), FromInts(0, 4))
+	if r.Numerator().String() != "1/2" || r.Denominator().String() != "x" {
+		t.Errorf("unexpected %s / %s", r.Numerator(), r.Denominator())
+	}
+}
+
+func TestRationalFunctionArithmetic(t *testing.T) {
+	a := rf(t, FromInts(1), FromInts(-1, 1)) // 1 / (x - 1)
+	b := rf(t, FromInts(1), FromInts(1, 1))  // 1 / (x + 1)
+	if s, e := a.Add(b); e != nil || s.String() != "(2x) / (x^2 - 1)" {
+		t.Errorf("sum: unexpected %v, %v", s, e)
+	}
+	if s, e := a.Subtract(b); e != nil || s.String() != "(2) / (x^2 - 1)" {
+		t.Errorf("difference: unexpected %v, %v", s, e)
+	}
+	if s, e := a.Subtract(a); e != nil || s.String() != "0" {
+		t.Errorf("difference: unexpected %v, %v", s, e)
+	}
+	if m, e := a.Multiply(b); e != nil || m.String() != "(1) / (x^2 - 1)" {
+		t.Errorf("product: unexpected %v, %v", m, e)
+	}
+	if d, e := a.Divide(b); e != nil || d.String() != "(x + 1) / (x - 1)" {
+		t.Errorf("quotient: unexpected %v, %v", d, e)
+	}
+	if _, e := a.Divide(rf(t, FromInts(), FromInts(1))); e == nil {
+		t.Errorf("expected error for division by ZERO")
+	}
+}
+
+func TestRationalFunctionEvaluate(t *testing.T) {
+	r := rf(t, FromInts(-1, 0, 1), FromInts(-2, 1)) // (x^2 - 1) / (x - 2)
+	for x, exp := range map[string]string{"0/1": "1/2", "1/1": "0/1", "3/1": "8/1", "5/2": "21/2"} {
+		v, e := r.Evaluate(numbers.NewQ(x))
+		if e != nil || v.String() != exp {
+			t.Errorf("r(%s): expected %s, got %v, %v", x, exp, v, e)
+		}
+	}
+	_, e := r.Evaluate(numbers.NewQ("2/1"))
+	var pe *PoleError
+	if !errors.As(e, &pe) || pe.At.String() != "2/1" {
+		t.Errorf("expected pole at 2, got %v", e)
+	}
+	// removable singularity
+	r = rf(t, FromInts(-1, 0, 1), FromInts(-1, 1))
+	if v, e := r.Evaluate(numbers.OneQ()); e != nil || v.String() != "2/1" {
+		t.Errorf("expected 2, got %v, %v", v, e)
+	}
+}
@@ -0,0 +1,786 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+
+	"github.com/grgrzybek/gomath/pkg/interval"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// maxIsolationDepth limits bisection when isolating the roots - it's never reached for square-free polynomials,
+// unless the roots are extremely close
+const maxIsolationDepth = 200
+
+// rootPrecision is the width to which the enclosures of irrational roots are narrowed
+var rootPrecision = numbers.NewQ("1/1000000000")
+
+// Root is a real root of a polynomial. It's always enclosed in an isolating interval (a point for rational roots)
+// and, where feasible, known exactly - as a quadratic surd or as an expression with nested radicals.
+type Root struct {
+	surd      *Surd
+	radical   string
+	enclosure *interval.Interval
+
+	fmt.Stringer
+}
+
+// exactRoot is a root found with a formula, with its approximate value to match it with an isolating interval
+type exactRoot struct {
+	surd    *Surd
+	radical string
+	value   float64
+}
+
+// Surd returns r as a quadratic surd (or rational number), if it's one
+func (r *Root) Surd() (*Surd, bool) {
+	return r.surd, r.surd != nil
+}
+
+// Radical returns r written exactly with radicals - the surd or the formula of Cardano or Ferrari
+func (r *Root) Radical() (string, bool) {
+	if r.surd != nil {
+		return r.surd.String(), true
+	}
+	return r.radical, r.radical != ""
+}
+
+// Enclosure returns an interval containing r and no other root of the polynomial
+func (r *Root) Enclosure() *interval.Interval {
+	return r.enclosure
+}
+
+// String formats r with radicals or, when it's not known exactly, as "x ∈ [lo, hi]"
+func (r *Root) String() string {
+	if s, ok := r.Radical(); ok {
+		return s
+	}
+	return "x ∈ " + r.enclosure.String()
+}
+
+// Roots returns distinct real roots of p in increasing order. Rational roots are found with the rational root
+// theorem and the remaining factor is solved with
+//   - the quadratic formula, which gives quadratic surds,
+//   - Cardano's formula, when the cubic has single real root (three real roots can't be written with real radicals -
+//     casus irreducibilis),
+//   - Ferrari's method, when the resolvent cubic has a rational root.
+//
+// All other roots (and all irrational roots of higher degrees) are only isolated - with interval Newton method and
+// bisection, or with Sturm's theorem where the interval arithmetic overflows - and enclosed in intervals not wider
+// than 10^-9. Returns an error for the ZERO polynomial (every number
+// is its root) and ErrOverflow.
+func (p *Polynomial) Roots() ([]*Root, error) {
+	if len(p.coefficients) == 0 {
+		return nil, errors.New("every number is a root of the ZERO polynomial")
+	}
+	f, e := p.squareFree()
+	if e != nil {
+		return nil, e
+	}
+	rationals, f, e := f.rationalRoots()
+	if e != nil {
+		return nil, e
+	}
+	roots, e := f.irrationalRoots(rationals)
+	if e != nil {
+		return nil, e
+	}
+	for _, r := range rationals {
+		roots = append(roots, &Root{surd: &Surd{a: r, b: numbers.ZeroQ(), d: 1}, enclosure: interval.Point(r)})
+	}
+	slices.SortFunc(roots, func(a, b *Root) int {
+		if c := a.enclosure.Lo().Compare(b.enclosure.Lo()); c != 0 {
+			return c
+		}
+		return a.enclosure.Hi().Compare(b.enclosure.Hi())
+	})
+	return roots, nil
+}
+
+// squareFree returns monic p / gcd(p, p') - the polynomial with the same roots as p, but all of them simple
+func (p *Polynomial) squareFree() (*Polynomial, error) {
+	d, e := p.Derivative()
+	if e != nil {
+		return nil, e
+	}
+	g, e := GCD(p, d)
+	if e != nil {
+		return nil, e
+	}
+	q, _, e := p.DivideR(g)
+	if e != nil {
+		return nil, e
+	}
+	return q.Monic()
+}
+
+// rationalRoots returns rational roots of p and p divided by (x - r) for each of them. By the rational root theorem,
+// a/b in lowest terms is a root of c0 + c1*x + ... + cn*x^n with integer coefficients only if a divides c0 and b
+// divides cn.
+func (p *Polynomial) rationalRoots() ([]*numbers.Q, *Polynomial, error) {
+	var roots []*numbers.Q
+	for p.Degree() > 0 && p.coefficients[0].Compare(numbers.ZeroQ()) == 0 {
+		roots = append(roots, numbers.ZeroQ())
+		p = New(p.coefficients[1:]...)
+	}
+	if p.Degree() < 1 {
+		return roots, p, nil
+	}
+	c0, cn, e := p.integerEnds()
+	if e != nil {
+		return nil, nil, e
+	}
+	bound, e := p.cauchyBound()
+	if e != nil {
+		return nil, nil, e
+	}
+	for _, b := range divisors(cn) {
+		for _, a := range divisors(c0) {
+			for _, sign := range []int64{1, -1} {
+				x, e := numbers.QFromInts(sign*a, b)
+				if e != nil {
+					return nil, nil, e
+				}
+				if _, den := x.Ratio(); den != b || x.Abs().Compare(bound) >= 0 {
+					continue
+				}
+				v, e := p.Evaluate(x)
+				if e != nil {
+					return nil, nil, e
+				}
+				if v.Compare(numbers.ZeroQ()) != 0 {
+					continue
+				}
+				roots = append(roots, x)
+				if p, _, e = p.DivideR(New(numbers.ZeroQ().Subtract(x), numbers.OneQ())); e != nil {
+					return nil, nil, e
+				}
+			}
+		}
+	}
+	return roots, p, nil
+}
+
+// irrationalRoots returns real roots of square-free p without rational roots, with enclosures not containing given
+// rational numbers
+func (p *Polynomial) irrationalRoots(rationals []*numbers.Q) ([]*Root, error) {
+	if p.Degree() < 1 {
+		return nil, nil
+	}
+	bound, e := p.cauchyBound()
+	if e != nil {
+		return nil, e
+	}
+	x, e := interval.New(numbers.ZeroQ().Subtract(bound), bound)
+	if e != nil {
+		return nil, e
+	}
+	d, e := p.Derivative()
+	if e != nil {
+		return nil, e
+	}
+	enclosures, e := p.isolate(d, x, 0)
+	if errors.Is(e, numbers.ErrOverflow) {
+		// interval arithmetic overflows for high degrees or big coefficients, Sturm's theorem in math/big doesn't
+		enclosures, e = p.sturmIsolate(x)
+	}
+	if e != nil {
+		return nil, e
+	}
+	roots := make([]*Root, len(enclosures))
+	for i, x := range enclosures {
+		if x, e = p.narrow(x, rationals); e != nil {
+			return nil, e
+		}
+		roots[i] = &Root{enclosure: x}
+	}
+	exact, e := p.exactRoots()
+	if e != nil {
+		return nil, e
+	}
+	if len(exact) == len(roots) {
+		slices.SortFunc(exact, func(a, b *exactRoot) int {
+			return compareFloat64(a.value, b.value)
+		})
+		for i, r := range exact {
+			roots[i].surd, roots[i].radical = r.surd, r.radical
+		}
+	}
+	return roots, nil
+}
+
+// isolate returns intervals in x, each containing exactly one root of square-free p without rational roots, in
+// increasing order. An interval is either proved to contain single root by NewtonStep, or to contain no roots (by
+// NewtonStep or because p is not ZERO anywhere in it), or it's split in two at its middle (which is rational, so it's not a root).
+func (p *Polynomial) isolate(d *Polynomial, x *interval.Interval, depth int) ([]*interval.Interval, error) {
+	if depth > maxIsolationDepth {
+		return nil, errors.New("can't isolate the roots")
+	}
+	// Newton's method can't tell anything where p' vanishes, but p may be far from ZERO there
+	v, e := p.EvaluateInterval(x)
+	if e != nil {
+		return nil, e
+	}
+	if !v.Contains(numbers.ZeroQ()) {
+		return nil, nil
+	}
+	next, unique, e := p.newtonStep(d, x)
+	switch {
+	case errors.Is(e, ErrNoRoot):
+		return nil, nil
+	case errors.Is(e, interval.ErrContainsZero):
+		next = x
+	case e != nil:
+		return nil, e
+	case unique:
+		return []*interval.Interval{next}, nil
+	}
+	m, e := middle(next)
+	if e != nil {
+		return nil, e
+	}
+	left, _ := interval.New(next.Lo(), m)
+	right, _ := interval.New(m, next.Hi())
+	l, e := p.isolate(d, left, depth+1)
+	if e != nil {
+		return nil, e
+	}
+	r, e := p.isolate(d, right, depth+1)
+	if e != nil {
+		return nil, e
+	}
+	return append(l, r...), nil
+}
+
+// sturmIsolate is isolate calculated in math/big, using Sturm's theorem: for the Sturm sequence p0 = p, p1 = p' and
+// p(i+1) = -(p(i-1) mod p(i)), the number of distinct roots in (a, b] is V(a) - V(b), where V(x) is the number of
+// sign changes in p0(x), p1(x), ... Intervals with more roots are split at dyadic fractions in their middle, which
+// are never roots of p without rational roots.
+func (p *Polynomial) sturmIsolate(x *interval.Interval) ([]*interval.Interval, error) {
+	chain := [][]*big.Rat{bigCoefficients(p.coefficients)}
+	d := make([]*big.Rat, len(chain[0])-1)
+	for i := range d {
+		d[i] = new(big.Rat).Mul(chain[0][i+1], big.NewRat(int64(i+1), 1))
+	}
+	for next := d; len(next) > 0; next = bigRemainder(chain[len(chain)-2], chain[len(chain)-1]) {
+		chain = append(chain, next)
+		if len(chain) == 2 {
+			continue
+		}
+		for _, c := range next {
+			c.Neg(c)
+		}
+	}
+
+	var res []*interval.Interval
+	var split func(lo *big.Rat, hi *big.Rat, roots int, depth int) error
+	split = func(lo *big.Rat, hi *big.Rat, roots int, depth int) error {
+		switch {
+		case roots == 0:
+			return nil
+		case depth > maxIsolationDepth:
+			return errors.New("can't isolate the roots")
+		case roots == 1:
+			l, e := numbers.QFromRat(lo)
+			if e != nil {
+				return e
+			}
+			h, e := numbers.QFromRat(hi)
+			if e != nil {
+				return e
+			}
+			x, e := interval.New(l, h)
+			if e == nil {
+				res = append(res, x)
+			}
+			return e
+		}
+		m := dyadic(lo, hi)
+		left := variations(chain, lo) - variations(chain, m)
+		if e := split(lo, m, left, depth+1); e != nil {
+			return e
+		}
+		return split(m, hi, roots-left, depth+1)
+	}
+	lo, hi := x.Lo().Rat(), x.Hi().Rat()
+	if e := split(lo, hi, variations(chain, lo)-variations(chain, hi), 0); e != nil {
+		return nil, e
+	}
+	return res, nil
+}
+
+// variations returns the number of sign changes (ignoring ZEROs) of the polynomials of the chain at x
+func variations(chain [][]*big.Rat, x *big.Rat) int {
+	res, last := 0, 0
+	for _, c := range chain {
+		if s := bigSignAt(c, x); s != 0 {
+			if s == -last {
+				res++
+			}
+			last = s
+		}
+	}
+	return res
+}
+
+// bigCoefficients returns the coefficients in math/big
+func bigCoefficients(coefficients []*numbers.Q) []*big.Rat {
+	res := make([]*big.Rat, len(coefficients))
+	for i, c := range coefficients {
+		res[i] = c.Rat()
+	}
+	return res
+}
+
+// bigRemainder returns a mod b for polynomials with coefficients in math/big (without trailing ZEROs), b not ZERO
+func bigRemainder(a []*big.Rat, b []*big.Rat) []*big.Rat {
+	res := make([]*big.Rat, len(a))
+	for i, c := range a {
+		res[i] = new(big.Rat).Set(c)
+	}
+	lead := b[len(b)-1]
+	for len(res) >= len(b) {
+		factor := new(big.Rat).Quo(res[len(res)-1], lead)
+		shift := len(res) - len(b)
+		for i, c := range b {
+			res[i+shift].Sub(res[i+shift], new(big.Rat).Mul(factor, c))
+		}
+		res = res[:len(res)-1]
+		for len(res) > 0 && res[len(res)-1].Sign() == 0 {
+			res = res[:len(res)-1]
+		}
+	}
+	return res
+}
+
+// bigSignAt returns the sign of the polynomial with given coefficients at x
+func bigSignAt(coefficients []*big.Rat, x *big.Rat) int {
+	res := new(big.Rat)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		res.Add(res.Mul(res, x), coefficients[i])
+	}
+	return res.Sign()
+}
+
+// narrow returns isolating interval x of a root of p narrowed to rootPrecision and split at given rational numbers,
+// keeping the part with the root - p changes sign at its simple root and it's not ZERO at rational numbers.
+// Newton's method is used as long as it doesn't overflow, then the bisection.
+func (p *Polynomial) narrow(x *interval.Interval, rationals []*numbers.Q) (*interval.Interval, error) {
+	for next := range p.Newton(x) {
+		x = next
+		if w, e := x.Width(); e != nil || w.Compare(rootPrecision) <= 0 {
+			break
+		}
+	}
+	x, e := p.bisect(x)
+	if e != nil {
+		return nil, e
+	}
+	for _, r := range rationals {
+		if r.Compare(x.Lo()) <= 0 || r.Compare(x.Hi()) >= 0 {
+			continue
+		}
+		if p.signAt(x.Lo().Rat()) != p.signAt(r.Rat()) {
+			x, _ = interval.New(x.Lo(), r)
+		} else {
+			x, _ = interval.New(r, x.Hi())
+		}
+	}
+	return x, nil
+}
+
+// bisect narrows isolating interval x of a root of p to rootPrecision by bisection. The points of division are the
+// simplest dyadic fractions in the middle half of x and the signs of p are calculated in math/big, so the ends of x
+// stay small.
+func (p *Polynomial) bisect(x *interval.Interval) (*interval.Interval, error) {
+	lo, hi := x.Lo().Rat(), x.Hi().Rat()
+	sign, precision := p.signAt(lo), rootPrecision.Rat()
+	for new(big.Rat).Sub(hi, lo).Cmp(precision) > 0 {
+		m := dyadic(lo, hi)
+		if p.signAt(m) == sign {
+			lo = m
+		} else {
+			hi = m
+		}
+	}
+	l, e := numbers.QFromRat(lo)
+	if e != nil {
+		return nil, e
+	}
+	h, e := numbers.QFromRat(hi)
+	if e != nil {
+		return nil, e
+	}
+	return interval.New(l, h)
+}
+
+// signAt returns the sign of p(x)
+func (p *Polynomial) signAt(x *big.Rat) int {
+	return bigSignAt(bigCoefficients(p.coefficients), x)
+}
+
+// dyadic returns k/2^n with the least n in the middle half of [lo, hi]
+func dyadic(lo *big.Rat, hi *big.Rat) *big.Rat {
+	quarter := new(big.Rat).Quo(new(big.Rat).Sub(hi, lo), big.NewRat(4, 1))
+	a, b := new(big.Rat).Add(lo, quarter), new(big.Rat).Sub(hi, quarter)
+	for scale := big.NewInt(1); ; scale.Lsh(scale, 1) {
+		// ceil(a·scale) = -floor(-a·scale) - big.Int.Div rounds down for positive divisor
+		as, bs := new(big.Rat).Mul(a, new(big.Rat).SetInt(scale)), new(big.Rat).Mul(b, new(big.Rat).SetInt(scale))
+		c := new(big.Int).Neg(new(big.Int).Div(new(big.Int).Neg(as.Num()), as.Denom()))
+		if c.Cmp(new(big.Int).Div(bs.Num(), bs.Denom())) <= 0 {
+			return new(big.Rat).SetFrac(c, scale)
+		}
+	}
+}
+
+// exactRoots returns real roots of monic square-free p without rational roots, found with formulas - or nil, when
+// there are no formulas with real radicals
+func (p *Polynomial) exactRoots() ([]*exactRoot, error) {
+	switch p.Degree() {
+	case 2:
+		return p.quadratic()
+	case 3:
+		return p.cardano()
+	case 4:
+		return p.ferrari()
+	}
+	return nil, nil
+}
+
+// quadratic solves x^2 + bx + c = 0: x = -b/2 ± √(b^2/4 - c)
+func (p *Polynomial) quadratic() ([]*exactRoot, error) {
+	b, c := p.coefficients[1].Rat(), p.coefficients[0].Rat()
+	half, e := numbers.QFromRat(new(big.Rat).Quo(b, big.NewRat(-2, 1)))
+	if e != nil {
+		return nil, e
+	}
+	disc := new(big.Rat).Sub(new(big.Rat).Quo(new(big.Rat).Mul(b, b), big.NewRat(4, 1)), c)
+	if disc.Sign() < 0 {
+		return nil, nil
+	}
+	return surdRoots(half, numbers.OneQ(), disc)
+}
+
+// cardano solves x^3 + bx^2 + cx + d = 0 with single real root. For x = t - b/3 it's t^3 + pt + q = 0 and
+// t = ∛(-q/2 + √D) + ∛(-q/2 - √D), where D = q^2/4 + p^3/27. D < 0 means three real roots.
+func (p *Polynomial) cardano() ([]*exactRoot, error) {
+	s, e := numbers.QFromRat(new(big.Rat).Quo(p.coefficients[2].Rat(), big.NewRat(-3, 1)))
+	if e != nil {
+		return nil, e
+	}
+	g, e := p.shift(s)
+	if e != nil {
+		return nil, e
+	}
+	pr, qr := g.Coefficient(1).Rat(), g.Coefficient(0).Rat()
+	pp := new(big.Rat).Mul(pr, new(big.Rat).Mul(pr, pr))
+	d := new(big.Rat).Add(new(big.Rat).Quo(new(big.Rat).Mul(qr, qr), big.NewRat(4, 1)), pp.Quo(pp, big.NewRat(27, 1)))
+	if d.Sign() <= 0 {
+		return nil, nil
+	}
+	base := &Surd{a: s, b: numbers.ZeroQ(), d: 1}
+	if pr.Sign() == 0 {
+		q, e := numbers.QFromRat(new(big.Rat).Neg(qr))
+		if e != nil {
+			return nil, e
+		}
+		v, _ := q.Float64()
+		return []*exactRoot{{radical: radical(base, 1, "∛("+formatQ(q)+")"), value: base.Float64() + math.Cbrt(v)}}, nil
+	}
+	half, e := numbers.QFromRat(new(big.Rat).Quo(qr, big.NewRat(-2, 1)))
+	if e != nil {
+		return nil, e
+	}
+	dq, e := numbers.QFromRat(d)
+	if e != nil {
+		return nil, e
+	}
+	u, e := NewSurd(half, numbers.OneQ(), dq)
+	if e != nil {
+		return nil, e
+	}
+	v, e := NewSurd(half, numbers.NewQ("-1/1"), dq)
+	if e != nil {
+		return nil, e
+	}
+	term := "∛(" + u.String() + ") + ∛(" + v.String() + ")"
+	value := base.Float64() + math.Cbrt(u.Float64()) + math.Cbrt(v.Float64())
+	return []*exactRoot{{radical: radical(base, 1, term), value: value}}, nil
+}
+
+// ferrari solves x^4 + bx^3 + cx^2 + dx + e = 0. For x = y - b/4 it's y^4 + py^2 + qy + r = 0, which is biquadratic
+// for q = 0. Otherwise, for m > 0 being the root of resolvent cubic m^3 + pm^2 + (p^2/4 - r)m - q^2/8, both sides of
+// (y^2 + p/2 + m)^2 = 2my^2 - qy + m^2 + mp + p^2/4 - r are squares and y^2 + p/2 + m = ±(√(2m)y - q/(2√(2m))).
+// The roots are y = σ(1/2)√(2m) ± (1/2)√(-2m - 2p - σ(q/m)√(2m)) for σ = ±1. Only rational m is used.
+func (p *Polynomial) ferrari() ([]*exactRoot, error) {
+	s, e := numbers.QFromRat(new(big.Rat).Quo(p.coefficients[3].Rat(), big.NewRat(-4, 1)))
+	if e != nil {
+		return nil, e
+	}
+	g, e := p.shift(s)
+	if e != nil {
+		return nil, e
+	}
+	pr, qr, rr := g.Coefficient(2).Rat(), g.Coefficient(1).Rat(), g.Coefficient(0).Rat()
+	base := &Surd{a: s, b: numbers.ZeroQ(), d: 1}
+	if qr.Sign() == 0 {
+		return biquadratic(base, pr, rr)
+	}
+	// resolvent cubic
+	c1 := new(big.Rat).Sub(new(big.Rat).Quo(new(big.Rat).Mul(pr, pr), big.NewRat(4, 1)), rr)
+	c0 := new(big.Rat).Quo(new(big.Rat).Mul(qr, qr), big.NewRat(-8, 1))
+	cs := make([]*numbers.Q, 4)
+	for i, c := range []*big.Rat{c0, c1, pr, big.NewRat(1, 1)} {
+		if cs[i], e = numbers.QFromRat(c); e != nil {
+			return nil, e
+		}
+	}
+	ms, _, e := New(cs...).rationalRoots()
+	if e != nil {
+		return nil, e
+	}
+	for _, m := range ms {
+		if m.Compare(numbers.ZeroQ()) <= 0 {
+			continue
+		}
+		mr := m.Rat()
+		m2, e := numbers.QFromRat(new(big.Rat).Add(mr, mr))
+		if e != nil {
+			return nil, e
+		}
+		var roots []*exactRoot
+		for _, sigma := range []int64{1, -1} {
+			b, e := NewSurd(s, numbers.NewQ(fmt.Sprintf("%d/2", sigma)), m2)
+			if e != nil {
+				return nil, e
+			}
+			wa := new(big.Rat).Neg(new(big.Rat).Add(m2.Rat(), new(big.Rat).Add(pr, pr)))
+			wb := new(big.Rat).Quo(new(big.Rat).Mul(qr, big.NewRat(-sigma, 1)), mr)
+			w, e := quadraticSurd(wa, wb, m2.Rat())
+			if e != nil {
+				return nil, e
+			}
+			r, e := nestedRoots(b, numbers.NewQ("1/2"), w)
+			if e != nil {
+				return nil, e
+			}
+			roots = append(roots, r...)
+		}
+		return roots, nil
+	}
+	return nil, nil
+}
+
+// biquadratic solves y^4 + py^2 + r = 0 as a quadratic equation for z = y^2 and returns x = base ± √z
+func biquadratic(base *Surd, p *big.Rat, r *big.Rat) ([]*exactRoot, error) {
+	disc := new(big.Rat).Sub(new(big.Rat).Mul(p, p), new(big.Rat).Mul(r, big.NewRat(4, 1)))
+	if disc.Sign() < 0 {
+		return nil, nil
+	}
+	var roots []*exactRoot
+	for _, sigma := range []int64{1, -1} {
+		z, e := quadraticSurd(new(big.Rat).Quo(p, big.NewRat(-2, 1)), big.NewRat(sigma, 2), disc)
+		if e != nil {
+			return nil, e
+		}
+		r, e := nestedRoots(base, numbers.OneQ(), z)
+		if e != nil {
+			return nil, e
+		}
+		roots = append(roots, r...)
+	}
+	return roots, nil
+}
+
+// nestedRoots returns real values of base ± c√w - surds, when possible
+func nestedRoots(base *Surd, c *numbers.Q, w *Surd) ([]*exactRoot, error) {
+	if w.Compare(numbers.ZeroQ()) <= 0 {
+		return nil, nil
+	}
+	coefficient := "(" + formatQ(c) + ")"
+	if formatQ(c) == "1" {
+		coefficient = ""
+	}
+	cf, _ := c.Float64()
+	wq, ok := w.Rational()
+	if !ok {
+		term := coefficient + "√(" + w.String() + ")"
+		value := cf * math.Sqrt(w.Float64())
+		return []*exactRoot{
+			{radical: radical(base, 1, term), value: base.Float64() + value},
+			{radical: radical(base, -1, term), value: base.Float64() - value},
+		}, nil
+	}
+	h, e := NewSurd(numbers.ZeroQ(), c, wq)
+	if e != nil {
+		return nil, e
+	}
+	if hq, ok := h.Rational(); ok {
+		var roots []*exactRoot
+		for _, c := range []*numbers.Q{hq, numbers.ZeroQ().Subtract(hq)} {
+			a, e := base.a.AddChecked(c)
+			if e != nil {
+				return nil, e
+			}
+			r, e := NewSurd(a, base.b, qFromInt(base.d))
+			if e != nil {
+				return nil, e
+			}
+			roots = append(roots, &exactRoot{surd: r, value: r.Float64()})
+		}
+		return roots, nil
+	}
+	if a, ok := base.Rational(); ok {
+		return surdRoots(a, c, wq.Rat())
+	}
+	term := coefficient + "√" + formatQ(wq)
+	return []*exactRoot{
+		{radical: radical(base, 1, term), value: base.Float64() + h.Float64()},
+		{radical: radical(base, -1, term), value: base.Float64() - h.Float64()},
+	}, nil
+}
+
+// surdRoots returns a ± b√radicand
+func surdRoots(a *numbers.Q, b *numbers.Q, radicand *big.Rat) ([]*exactRoot, error) {
+	rq, e := numbers.QFromRat(radicand)
+	if e != nil {
+		return nil, e
+	}
+	var roots []*exactRoot
+	for _, c := range []*numbers.Q{b, numbers.ZeroQ().Subtract(b)} {
+		r, e := NewSurd(a, c, rq)
+		if e != nil {
+			return nil, e
+		}
+		roots = append(roots, &exactRoot{surd: r, value: r.Float64()})
+	}
+	return roots, nil
+}
+
+// quadraticSurd returns a + b√d for big.Rat arguments
+func quadraticSurd(a *big.Rat, b *big.Rat, d *big.Rat) (*Surd, error) {
+	qs := make([]*numbers.Q, 3)
+	for i, v := range []*big.Rat{a, b, d} {
+		var e error
+		if qs[i], e = numbers.QFromRat(v); e != nil {
+			return nil, e
+		}
+	}
+	return NewSurd(qs[0], qs[1], qs[2])
+}
+
+// radical formats base + term or base - term
+func radical(base *Surd, sign int, term string) string {
+	if a, ok := base.Rational(); ok && a.Compare(numbers.ZeroQ()) == 0 {
+		if sign < 0 {
+			return "-" + term
+		}
+		return term
+	}
+	if sign < 0 {
+		return base.String() + " - " + term
+	}
+	return base.String() + " + " + term
+}
+
+// shift returns p(x + s) - Horner's scheme with polynomials
+func (p *Polynomial) shift(s *numbers.Q) (*Polynomial, error) {
+	res, xs := New(), New(s, numbers.OneQ())
+	for i := len(p.coefficients) - 1; i >= 0; i-- {
+		var e error
+		if res, e = res.Multiply(xs); e != nil {
+			return nil, e
+		}
+		if res, e = res.Add(New(p.coefficients[i])); e != nil {
+			return nil, e
+		}
+	}
+	return res, nil
+}
+
+// cauchyBound returns 1 + max |ci/cn| - every root of p is less than that in absolute value
+func (p *Polynomial) cauchyBound() (*numbers.Q, error) {
+	lead := p.coefficients[len(p.coefficients)-1]
+	bound := numbers.ZeroQ()
+	for _, c := range p.coefficients[:len(p.coefficients)-1] {
+		v, e := c.Divide(lead)
+		if e != nil {
+			return nil, e
+		}
+		if v = v.Abs(); v.Compare(bound) > 0 {
+			bound = v
+		}
+	}
+	return bound.AddChecked(numbers.OneQ())
+}
+
+// integerEnds returns the lowest and the highest coefficients of p multiplied by the common denominator of all
+// coefficients
+func (p *Polynomial) integerEnds() (int64, int64, error) {
+	l := big.NewInt(1)
+	for _, c := range p.coefficients {
+		_, b := c.Ratio()
+		bb := big.NewInt(b)
+		l.Mul(l, bb.Div(bb, new(big.Int).GCD(nil, nil, l, bb)))
+	}
+	ends := make([]int64, 2)
+	for i, c := range []*numbers.Q{p.coefficients[0], p.coefficients[len(p.coefficients)-1]} {
+		v := new(big.Rat).Mul(c.Rat(), new(big.Rat).SetInt(l))
+		if !v.Num().IsInt64() {
+			return 0, 0, numbers.ErrOverflow
+		}
+		ends[i] = v.Num().Int64()
+	}
+	return ends[0], ends[1], nil
+}
+
+// divisors returns positive divisors of v != 0
+func divisors(v int64) []int64 {
+	factors, _ := primes.Factorize(numbers.NFromUint64(uint64(max(v, -v))))
+	res := []int64{1}
+	for _, f := range factors {
+		p := int64(f.Prime.Uint64())
+		n := len(res)
+		for k, pk := 1, p; k <= f.Exponent; k, pk = k+1, pk*p {
+			for _, d := range res[:n] {
+				res = append(res, d*pk)
+			}
+		}
+	}
+	return res
+}
+
+// compareFloat64 compares a and b
+func compareFloat64(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+var _ = fmt.Stringer(&Root{})
@@ -0,0 +1,142 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func product(ps ...*Polynomial) *Polynomial {
+	res := FromInts(1)
+	for _, p := range ps {
+		res, _ = res.Multiply(p)
+	}
+	return res
+}
+
+func TestRoots(t *testing.T) {
+	for _, c := range []struct {
+		p   *Polynomial
+		exp []string
+	}{
+		{FromInts(6, -5, 1), []string{"2", "3"}},
+		{FromInts(1, 0, 1), nil},
+		{FromInts(5), nil},
+		{New(numbers.NewQ("1/2"), numbers.NewQ("-3/2"), numbers.NewQ("1/1")), []string{"1/2", "1"}},
+		{FromInts(-1, -1, 1), []string{"1/2 - (1/2)√5", "1/2 + (1/2)√5"}},
+		// repeated roots are reported once
+		{product(FromInts(-2, 0, 1), FromInts(-2, 0, 1), FromInts(-1, 1)), []string{"-√2", "1", "√2"}},
+		// Cardano
+		{FromInts(-2, 0, 0, 1), []string{"∛(2)"}},
+		{FromInts(-1, -1, 0, 1), []string{"∛(1/2 + (1/18)√69) + ∛(1/2 - (1/18)√69)"}},
+		{FromInts(-1, 1, 1, 1), []string{"-1/3 + ∛(17/27 + (1/9)√33) + ∛(17/27 - (1/9)√33)"}},
+		// casus irreducibilis
+		{FromInts(1, -3, 0, 1), []string{"x ∈", "x ∈", "x ∈"}},
+		// Ferrari
+		{FromInts(-3, -4, 1, 0, 1), []string{"1/2 - (1/2)√5", "1/2 + (1/2)√5"}},
+		{FromInts(-1, -4, 0, 0, 1), []string{"(1/2)√2 - (1/2)√(-2 + 4√2)", "(1/2)√2 + (1/2)√(-2 + 4√2)"}},
+		{FromInts(2, 0, -4, 0, 1), []string{"-√(2 + √2)", "-√(2 - √2)", "√(2 - √2)", "√(2 + √2)"}},
+		{FromInts(-2, 0, 0, 0, 1), []string{"-√(√2)", "√(√2)"}},
+		{FromInts(6, 0, -5, 0, 1), []string{"-√3", "-√2", "√2", "√3"}},
+		// quartic with irrational resolvent roots and quintic are only isolated
+		{FromInts(1, 1, 0, 0, 1), nil},
+		{FromInts(-1, 1, 0, 0, 1), []string{"x ∈", "x ∈"}},
+		{FromInts(1, -1, 0, 0, 0, 1), []string{"x ∈"}},
+		{product(FromInts(1, -1, 0, 0, 0, 1), FromInts(-1, 1)), []string{"x ∈", "1"}},
+		// interval Newton method overflows for these, Sturm's theorem is used instead
+		{FromInts(-2, 0, 0, 0, 0, 0, 0, 0, 1), []string{"x ∈", "x ∈"}},
+		{FromInts(-2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1), []string{"x ∈"}},
+		{FromInts(1, -10, 0, 0, 0, 1), []string{"x ∈", "x ∈", "x ∈"}},
+	} {
+		roots, e := c.p.Roots()
+		if e != nil || len(roots) != len(c.exp) {
+			t.Errorf("%s: expected %d roots, got %v, %v", c.p, len(c.exp), roots, e)
+			continue
+		}
+		for i, r := range roots {
+			if !strings.HasPrefix(r.String(), c.exp[i]) {
+				t.Errorf("%s: expected %s, got %s", c.p, c.exp[i], r)
+			}
+			checkRoot(t, c.p, r)
+		}
+	}
+	if _, e := FromInts().Roots(); e == nil {
+		t.Errorf("expected error for ZERO polynomial")
+	}
+}
+
+// checkRoot checks that the enclosure of r is narrow, contains its exact value and a root of p
+func checkRoot(t *testing.T, p *Polynomial, r *Root) {
+	t.Helper()
+	x := r.Enclosure()
+	if w, _ := x.Width(); w.Compare(rootPrecision) > 0 {
+		t.Errorf("%s: enclosure %s is too wide", p, x)
+	}
+	if s, ok := r.Surd(); ok && (s.Compare(x.Lo()) < 0 || s.Compare(x.Hi()) > 0) {
+		t.Errorf("%s: %s is not in %s", p, s, x)
+	}
+	lo, _ := x.Lo().Float64()
+	hi, _ := x.Hi().Float64()
+	if _, ok := r.Radical(); ok {
+		if v := evaluateFloat64(p, (lo+hi)/2); math.Abs(v) > 1e-6 {
+			t.Errorf("%s: p(%s) = %v", p, r, v)
+		}
+	}
+	f, _ := p.squareFree()
+	if x.Lo().Compare(x.Hi()) != 0 && f.signAt(x.Lo().Rat())*f.signAt(x.Hi().Rat()) >= 0 {
+		t.Errorf("%s: p doesn't change sign in %s", p, x)
+	}
+}
+
+func evaluateFloat64(p *Polynomial, x float64) float64 {
+	res := 0.0
+	for i := p.Degree(); i >= 0; i-- {
+		c, _ := p.Coefficient(i).Float64()
+		res = res*x + c
+	}
+	return res
+}
+
+func TestRootsAreIsolatedFromRationalRoots(t *testing.T) {
+	// 1 and 17/12 are close to √2, the enclosure of √2 has to exclude them
+	p := product(FromInts(-2, 0, 1), FromInts(-17, 12), FromInts(-1, 1))
+	roots, e := p.Roots()
+	if e != nil || len(roots) != 4 {
+		t.Fatalf("expected 4 roots, got %v, %v", roots, e)
+	}
+	for i, exp := range []string{"-√2", "1", "√2", "17/12"} {
+		if roots[i].String() != exp {
+			t.Errorf("expected %s, got %s", exp, roots[i])
+		}
+	}
+	if roots[2].Enclosure().Contains(numbers.NewQ("17/12")) {
+		t.Errorf("%s contains 17/12", roots[2].Enclosure())
+	}
+}
+
+func TestShift(t *testing.T) {
+	p, e := FromInts(1, 2, 1).shift(numbers.NewQ("-1/1"))
+	if e != nil || p.String() != "x^2" {
+		t.Errorf("expected x^2, got %v, %v", p, e)
+	}
+}
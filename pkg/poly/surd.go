@@ -0,0 +1,154 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// Surd is a quadratic surd a + b√d with a, b in ℚ and square-free integer d > 1 - the kind of number the quadratic
+// formula gives for polynomials with rational coefficients. Rational numbers are surds with b = 0 (and d = 1), so
+// every Surd has single representation. Values of Surd are immutable.
+type Surd struct {
+	a *numbers.Q
+	b *numbers.Q
+	d int64
+
+	fmt.Stringer
+}
+
+// NewSurd creates a + b√radicand for radicand >= 0, moving square factors out of the root: 1 + √(8/9) is
+// 1 + (2/3)√2. Returns an error for negative radicand and ErrOverflow.
+func NewSurd(a *numbers.Q, b *numbers.Q, radicand *numbers.Q) (*Surd, error) {
+	n, m := radicand.Ratio()
+	if n < 0 {
+		return nil, errors.New("can't take square root of negative number")
+	}
+	if n == 0 || b.Compare(numbers.ZeroQ()) == 0 {
+		return &Surd{a: a, b: numbers.ZeroQ(), d: 1}, nil
+	}
+	// √(n/m) = √(nm)/m = outside·√inside/m
+	nm, e := qFromInt(n).MultiplyChecked(qFromInt(m))
+	if e != nil {
+		return nil, e
+	}
+	v, _ := nm.Ratio()
+	outside, inside := primes.SimplifySqrt(numbers.NFromUint64(uint64(v)))
+	c, e := numbers.QFromInts(int64(outside.Uint64()), m)
+	if e != nil {
+		return nil, e
+	}
+	if c, e = b.MultiplyChecked(c); e != nil {
+		return nil, e
+	}
+	if inside.Uint64() == 1 {
+		if a, e = a.AddChecked(c); e != nil {
+			return nil, e
+		}
+		return &Surd{a: a, b: numbers.ZeroQ(), d: 1}, nil
+	}
+	return &Surd{a: a, b: c, d: int64(inside.Uint64())}, nil
+}
+
+// Parts returns a, b and d of a + b√d
+func (s *Surd) Parts() (a *numbers.Q, b *numbers.Q, d int64) {
+	return s.a, s.b, s.d
+}
+
+// Rational returns s as ℚ if it's rational
+func (s *Surd) Rational() (*numbers.Q, bool) {
+	if s.d != 1 {
+		return nil, false
+	}
+	return s.a, true
+}
+
+// Compare returns -1, 0 or 1 when s is less than, equal to or greater than q. It's exact: the sign of x + y√d is
+// obvious, unless x and y have different signs - then it's the sign of the one with greater square (x^2 vs y^2·d).
+func (s *Surd) Compare(q *numbers.Q) int {
+	x := new(big.Rat).Sub(s.a.Rat(), q.Rat())
+	y := s.b.Rat()
+	sx, sy := x.Sign(), y.Sign()
+	if sy == 0 || sx == sy {
+		return sx
+	}
+	if sx == 0 {
+		return sy
+	}
+	x2 := new(big.Rat).Mul(x, x)
+	y2d := new(big.Rat).Mul(new(big.Rat).Mul(y, y), big.NewRat(s.d, 1))
+	if x2.Cmp(y2d) > 0 {
+		return sx
+	}
+	return sy
+}
+
+// Float64 returns the nearest float64 value for s
+func (s *Surd) Float64() float64 {
+	a, _ := s.a.Float64()
+	b, _ := s.b.Float64()
+	return a + b*math.Sqrt(float64(s.d))
+}
+
+// String formats s like polynomials are formatted, with fractional coefficients in parentheses: "-1/2 + (1/2)√5",
+// "1 - √2", "3√2", "2/3".
+func (s *Surd) String() string {
+	if s.d == 1 {
+		return formatQ(s.a)
+	}
+	root := fmt.Sprintf("√%d", s.d)
+	b := strings.TrimPrefix(formatQ(s.b), "-")
+	switch {
+	case b == "1":
+	case strings.Contains(b, "/"):
+		root = "(" + b + ")" + root
+	default:
+		root = b + root
+	}
+	neg := s.b.Compare(numbers.ZeroQ()) < 0
+	switch {
+	case s.a.Compare(numbers.ZeroQ()) == 0 && neg:
+		return "-" + root
+	case s.a.Compare(numbers.ZeroQ()) == 0:
+		return root
+	case neg:
+		return formatQ(s.a) + " - " + root
+	}
+	return formatQ(s.a) + " + " + root
+}
+
+// formatQ formats q without "/1" for integers
+func formatQ(q *numbers.Q) string {
+	return strings.TrimSuffix(q.String(), "/1")
+}
+
+// qFromInt returns v as ℚ
+func qFromInt(v int64) *numbers.Q {
+	q, _ := numbers.QFromInts(v, 1)
+	return q
+}
+
+var _ = fmt.Stringer(&Surd{})
@@ -0,0 +1,89 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package poly
+
+import (
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestNewSurd(t *testing.T) {
+	for _, c := range []struct {
+		a, b, radicand string
+		exp            string
+		rational       bool
+	}{
+		{"1/1", "1/1", "8/9", "1 + (2/3)√2", false},
+		{"0/1", "1/1", "2/1", "√2", false},
+		{"0/1", "-3/1", "2/1", "-3√2", false},
+		{"-1/2", "-1/2", "5/1", "-1/2 - (1/2)√5", false},
+		{"1/1", "2/1", "72/1", "1 + 12√2", false},
+		{"1/1", "1/1", "9/4", "5/2", true},
+		{"2/3", "5/1", "0/1", "2/3", true},
+		{"2/3", "0/1", "7/1", "2/3", true},
+		{"0/1", "1/1", "1/12", "(1/6)√3", false},
+	} {
+		s, e := NewSurd(numbers.NewQ(c.a), numbers.NewQ(c.b), numbers.NewQ(c.radicand))
+		if e != nil || s.String() != c.exp {
+			t.Errorf("%s + %s√(%s): expected %s, got %v, %v", c.a, c.b, c.radicand, c.exp, s, e)
+			continue
+		}
+		if _, ok := s.Rational(); ok != c.rational {
+			t.Errorf("%s: expected rational %v", s, c.rational)
+		}
+	}
+	if _, e := NewSurd(numbers.OneQ(), numbers.OneQ(), numbers.NewQ("-2/1")); e == nil {
+		t.Errorf("expected error for negative radicand")
+	}
+}
+
+func TestSurdParts(t *testing.T) {
+	s, _ := NewSurd(numbers.NewQ("1/2"), numbers.OneQ(), numbers.NewQ("20/1"))
+	if a, b, d := s.Parts(); a.String() != "1/2" || b.String() != "2/1" || d != 5 {
+		t.Errorf("expected 1/2, 2, 5, got %s, %s, %d", a, b, d)
+	}
+	if v := s.Float64(); math.Abs(v-(0.5+2*math.Sqrt(5))) > 1e-15 {
+		t.Errorf("expected %v, got %v", 0.5+2*math.Sqrt(5), v)
+	}
+}
+
+func TestSurdCompare(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		d    string
+		q    string
+		exp  int
+	}{
+		{"0/1", "1/1", "2/1", "7/5", 1},
+		{"0/1", "1/1", "2/1", "3/2", -1},
+		{"0/1", "-1/1", "2/1", "-7/5", -1},
+		{"1/1", "-1/1", "2/1", "0/1", -1},
+		{"2/1", "-1/1", "2/1", "0/1", 1},
+		{"-2/1", "1/1", "5/1", "0/1", 1},
+		{"3/2", "1/1", "9/4", "3/1", 0},
+		{"0/1", "1/1", "3/1", "0/1", 1},
+	} {
+		s, _ := NewSurd(numbers.NewQ(c.a), numbers.NewQ(c.b), numbers.NewQ(c.d))
+		if r := s.Compare(numbers.NewQ(c.q)); r != c.exp {
+			t.Errorf("%s vs %s: expected %d, got %d", s, c.q, c.exp, r)
+		}
+	}
+}
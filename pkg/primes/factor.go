@@ -0,0 +1,163 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// PrimePower is p^k - a factor of prime factorization
+type PrimePower struct {
+	Prime    *numbers.N
+	Exponent int
+}
+
+func (pp PrimePower) String() string {
+	if pp.Exponent == 1 {
+		return pp.Prime.String()
+	}
+	return fmt.Sprintf("%s^%d", pp.Prime, pp.Exponent)
+}
+
+// Factorization formats prime factorization as "2^3 × 3^2 × 5" ("1" for empty factorization)
+func Factorization(factors []PrimePower) string {
+	if len(factors) == 0 {
+		return "1"
+	}
+	s := make([]string, len(factors))
+	for i, f := range factors {
+		s[i] = f.String()
+	}
+	return strings.Join(s, " × ")
+}
+
+// Factorize returns prime factorization of n > 0 - primes in increasing order with their exponents (empty for 1).
+//
+// Small factors are found by trial division and the remaining ones with Pollard's rho (Brent's variant): for
+// x(i+1) = x(i)^2 + c (mod n), the values modulo unknown factor p repeat much sooner (after about √p steps) than
+// modulo n, and then gcd(x(i) - x(j), n) reveals p.
+func Factorize(n *numbers.N) ([]PrimePower, error) {
+	v := n.Uint64()
+	if v == 0 {
+		return nil, errors.New("ZERO has no prime factorization")
+	}
+	var factors []uint64
+	for p := uint64(2); p < 1000 && p*p <= v; p++ {
+		for v%p == 0 {
+			factors = append(factors, p)
+			v /= p
+		}
+	}
+	if v > 1 {
+		factors = append(factors, rho(v)...)
+	}
+	slices.Sort(factors)
+
+	res := make([]PrimePower, 0)
+	for _, f := range factors {
+		if last := len(res) - 1; last >= 0 && res[last].Prime.Uint64() == f {
+			res[last].Exponent++
+		} else {
+			res = append(res, PrimePower{Prime: numbers.NFromUint64(f), Exponent: 1})
+		}
+	}
+	return res, nil
+}
+
+// Totient returns Euler's φ(n) - the number of 1 <= k <= n coprime with n. For n = p1^k1 * ... * pr^kr,
+// φ(n) = n * (1 - 1/p1) * ... * (1 - 1/pr).
+func Totient(n *numbers.N) (*numbers.N, error) {
+	factors, e := Factorize(n)
+	if e != nil {
+		return nil, e
+	}
+	res := n.Uint64()
+	for _, f := range factors {
+		p := f.Prime.Uint64()
+		res = res / p * (p - 1)
+	}
+	return numbers.NFromUint64(res), nil
+}
+
+// rho returns prime factors (with repetitions) of n > 1 without small factors
+func rho(n uint64) []uint64 {
+	if isPrime(n) {
+		return []uint64{n}
+	}
+	if r := isqrt(n); r*r == n {
+		// x^2 + c doesn't work well for squares of primes
+		f := rho(r)
+		return append(f, f...)
+	}
+	for c := uint64(1); ; c++ {
+		if d := brent(n, c); d != n {
+			return append(rho(d), rho(n/d)...)
+		}
+	}
+}
+
+// brent returns a divisor of composite n found with x^2 + c, which may be n itself if this c fails
+func brent(n uint64, c uint64) uint64 {
+	f := func(x uint64) uint64 {
+		x = modarith.MulMod(x, x, n)
+		if x += c; x >= n || x < c {
+			x -= n
+		}
+		return x
+	}
+	const m = 128
+	y, g, r, q := uint64(2), uint64(1), uint64(1), uint64(1)
+	var x, ys uint64
+	for g == 1 {
+		x = y
+		for range r {
+			y = f(y)
+		}
+		for k := uint64(0); k < r && g == 1; k += m {
+			ys = y
+			for i := uint64(0); i < min(m, r-k); i++ {
+				y = f(y)
+				q = modarith.MulMod(q, diff(x, y), n)
+			}
+			g = modarith.GCD(q, n)
+		}
+		r *= 2
+	}
+	if g == n {
+		// the product of differences hit a multiple of n - go back step by step
+		for g = 1; g == 1; {
+			ys = f(ys)
+			g = modarith.GCD(diff(x, ys), n)
+		}
+	}
+	return g
+}
+
+func diff(a uint64, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,62 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestFactorize(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		1:                    "1",
+		2:                    "2",
+		360:                  "2^3 × 3^2 × 5",
+		97:                   "97",
+		1001:                 "7 × 11 × 13",
+		4294967297:           "641 × 6700417",
+		1000000016000000063:  "1000000007 × 1000000009",
+		999966000289:         "999983^2",
+		18446744073709551615: "3 × 5 × 17 × 257 × 641 × 65537 × 6700417",
+		18446744073709551557: "18446744073709551557",
+		9223372036854775808:  "2^63",
+	} {
+		f, e := Factorize(numbers.NFromUint64(v))
+		if e != nil || Factorization(f) != exp {
+			t.Errorf("%d: expected %s, got %s, %v", v, exp, Factorization(f), e)
+		}
+	}
+	if _, e := Factorize(&numbers.ZERO); e == nil {
+		t.Errorf("expected error for ZERO")
+	}
+}
+
+func TestTotient(t *testing.T) {
+	for v, exp := range map[uint64]uint64{1: 1, 2: 1, 9: 6, 36: 12, 97: 96, 1000000: 400000,
+		18446744073709551557: 18446744073709551556} {
+		n, e := Totient(numbers.NFromUint64(v))
+		if e != nil || n.Uint64() != exp {
+			t.Errorf("φ(%d): expected %d, got %v, %v", v, exp, n, e)
+		}
+	}
+	if _, e := Totient(&numbers.ZERO); e == nil {
+		t.Errorf("expected error for ZERO")
+	}
+}
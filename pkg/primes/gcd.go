@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"math"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// GCD returns the greatest common divisor of a and b found by prime factorization - the product of primes shared by
+// a and b, each with the smaller of its exponents. The shared factors are returned too (gcd(0, b) = b and
+// gcd(0, 0) = 0 has no factors). It's much slower than Euclid's algorithm, but shows what gcd is made of.
+func GCD(a *numbers.N, b *numbers.N) (*numbers.N, []PrimePower) {
+	switch {
+	case a.Uint64() == 0 && b.Uint64() == 0:
+		return numbers.ZeroN(), []PrimePower{}
+	case a.Uint64() == 0:
+		f, _ := Factorize(b)
+		return b, f
+	case b.Uint64() == 0:
+		f, _ := Factorize(a)
+		return a, f
+	}
+	fa, _ := Factorize(a)
+	fb, _ := Factorize(b)
+	shared := make([]PrimePower, 0)
+	res := uint64(1)
+	for i, j := 0, 0; i < len(fa) && j < len(fb); {
+		pa, pb := fa[i].Prime.Uint64(), fb[j].Prime.Uint64()
+		switch {
+		case pa < pb:
+			i++
+		case pa > pb:
+			j++
+		default:
+			k := min(fa[i].Exponent, fb[j].Exponent)
+			shared = append(shared, PrimePower{Prime: fa[i].Prime, Exponent: k})
+			for range k {
+				res *= pa
+			}
+			i, j = i+1, j+1
+		}
+	}
+	return numbers.NFromUint64(res), shared
+}
+
+// LCM returns the least common multiple of a and b found by prime factorization - the product of primes of a or b,
+// each with the bigger of its exponents, which are returned too. lcm(0, b) = 0. Returns ErrOverflow if the result
+// doesn't fit uint64.
+func LCM(a *numbers.N, b *numbers.N) (*numbers.N, []PrimePower, error) {
+	if a.Uint64() == 0 || b.Uint64() == 0 {
+		return numbers.ZeroN(), []PrimePower{}, nil
+	}
+	fa, _ := Factorize(a)
+	fb, _ := Factorize(b)
+	all := make([]PrimePower, 0)
+	for i, j := 0, 0; i < len(fa) || j < len(fb); {
+		switch {
+		case j == len(fb) || i < len(fa) && fa[i].Prime.Uint64() < fb[j].Prime.Uint64():
+			all = append(all, fa[i])
+			i++
+		case i == len(fa) || fa[i].Prime.Uint64() > fb[j].Prime.Uint64():
+			all = append(all, fb[j])
+			j++
+		default:
+			all = append(all, PrimePower{Prime: fa[i].Prime, Exponent: max(fa[i].Exponent, fb[j].Exponent)})
+			i, j = i+1, j+1
+		}
+	}
+	res := numbers.OneN()
+	for _, f := range all {
+		for range f.Exponent {
+			var e error
+			if res, e = checkedMultiply(res, f.Prime); e != nil {
+				return nil, nil, e
+			}
+		}
+	}
+	return res, all, nil
+}
+
+// GCDOfFactors is GCD usable as numbers.GCDStrategy
+func GCDOfFactors(a *numbers.N, b *numbers.N) *numbers.N {
+	g, _ := GCD(a, b)
+	return g
+}
+
+// ReduceQ creates ℚ num/den reduced to lowest terms by dividing by the greatest common divisor found by prime
+// factorization, instead of default Euclid's algorithm
+func ReduceQ(num int64, den int64) (*numbers.Q, error) {
+	return numbers.QFromIntsWith(num, den, GCDOfFactors)
+}
+
+// checkedMultiply returns a * b or ErrOverflow
+func checkedMultiply(a *numbers.N, b *numbers.N) (*numbers.N, error) {
+	x, y := a.Uint64(), b.Uint64()
+	if y != 0 && x > math.MaxUint64/y {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.NFromUint64(x * y), nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestGCD(t *testing.T) {
+	for _, c := range []struct {
+		a, b, gcd uint64
+		shared    string
+	}{
+		{360, 84, 12, "2^2 × 3"},
+		{84, 360, 12, "2^2 × 3"},
+		{17, 19, 1, "1"},
+		{0, 12, 12, "2^2 × 3"},
+		{12, 0, 12, "2^2 × 3"},
+		{0, 0, 0, "1"},
+		{1 << 63, 1 << 40, 1 << 40, "2^40"},
+		{18446744073709551615, 4294967297, 4294967297, "641 × 6700417"},
+	} {
+		g, f := GCD(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b))
+		if g.Uint64() != c.gcd || Factorization(f) != c.shared {
+			t.Errorf("gcd(%d, %d): expected %d = %s, got %s = %s", c.a, c.b, c.gcd, c.shared, g, Factorization(f))
+		}
+		if e := numbers.Euclid(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b)); e.Uint64() != g.Uint64() {
+			t.Errorf("gcd(%d, %d): Euclid found %s", c.a, c.b, e)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	for _, c := range []struct {
+		a, b, lcm uint64
+		all       string
+	}{
+		{360, 84, 2520, "2^3 × 3^2 × 5 × 7"},
+		{4, 6, 12, "2^2 × 3"},
+		{17, 1, 17, "17"},
+		{0, 5, 0, "1"},
+		{1 << 32, 1<<32 - 1, 1<<64 - 1<<32, "2^32 × 3 × 5 × 17 × 257 × 65537"},
+	} {
+		l, f, e := LCM(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b))
+		if e != nil || l.Uint64() != c.lcm || Factorization(f) != c.all {
+			t.Errorf("lcm(%d, %d): expected %d = %s, got %v = %s, %v", c.a, c.b, c.lcm, c.all, l, Factorization(f), e)
+		}
+	}
+	if _, _, e := LCM(numbers.NFromUint64(1<<33), numbers.NFromUint64(1<<32-1)); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestReduceQ(t *testing.T) {
+	for _, c := range []struct {
+		num, den int64
+		exp      string
+	}{
+		{360, -84, "-30/7"},
+		{0, 7, "0/1"},
+		{-9223372036854775808, -9223372036854775808, "1/1"},
+		{1000000016000000063, 1000000007, "1000000009/1"},
+	} {
+		q, e := ReduceQ(c.num, c.den)
+		if e != nil || q.String() != c.exp {
+			t.Errorf("%d/%d: expected %s, got %v, %v", c.num, c.den, c.exp, q, e)
+		}
+	}
+	if _, e := ReduceQ(1, 0); e == nil {
+		t.Errorf("expected error for ZERO denominator")
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// GoldbachPair is a pair of primes P <= Q summing to an even number
+type GoldbachPair struct {
+	P *numbers.N
+	Q *numbers.N
+}
+
+func (gp GoldbachPair) String() string {
+	return fmt.Sprintf("%s + %s", gp.P, gp.Q)
+}
+
+// GoldbachPartitions returns all pairs of primes p <= q with p + q = n for even n >= 4, ordered by p. n can't be
+// bigger than MaxBetween, because all primes up to n are sieved with Between.
+//
+// Goldbach's conjecture says there's always at least one such pair - it's verified for all n up to 4 * 10^18, but
+// not proven.
+func GoldbachPartitions(n *numbers.N) ([]GoldbachPair, error) {
+	v := n.Uint64()
+	if e := checkGoldbach(v); e != nil {
+		return nil, e
+	}
+	if v > MaxBetween {
+		return nil, fmt.Errorf("%d is bigger than %d", v, MaxBetween)
+	}
+	ps, _ := Between(numbers.NFromUint64(2), n)
+	prime := make([]bool, v+1)
+	for _, p := range ps {
+		prime[p.Uint64()] = true
+	}
+	res := make([]GoldbachPair, 0)
+	for _, p := range ps {
+		q := v - p.Uint64()
+		if q < p.Uint64() {
+			break
+		}
+		if prime[q] {
+			res = append(res, GoldbachPair{P: p, Q: numbers.NFromUint64(q)})
+		}
+	}
+	return res, nil
+}
+
+// GoldbachPartition returns the pair of primes p <= q with p + q = n and the smallest p, for any even n >= 4. Such
+// p is always small (below 10^4 for all n checked so far), so there's no need to sieve up to n.
+func GoldbachPartition(n *numbers.N) (GoldbachPair, error) {
+	v := n.Uint64()
+	if e := checkGoldbach(v); e != nil {
+		return GoldbachPair{}, e
+	}
+	for p := uint64(2); p <= v/2; p++ {
+		if isPrime(p) && isPrime(v-p) {
+			return GoldbachPair{P: numbers.NFromUint64(p), Q: numbers.NFromUint64(v - p)}, nil
+		}
+	}
+	// a counterexample to Goldbach's conjecture
+	return GoldbachPair{}, fmt.Errorf("%d is not a sum of two primes", v)
+}
+
+// checkGoldbach checks if v is even and at least 4
+func checkGoldbach(v uint64) error {
+	if v%2 != 0 {
+		return errors.New("odd numbers have no Goldbach partitions")
+	}
+	if v < 4 {
+		return errors.New("the smallest number with Goldbach partition is 4")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestGoldbachPartitions(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		4:   "2 + 2",
+		6:   "3 + 3",
+		10:  "3 + 7, 5 + 5",
+		28:  "5 + 23, 11 + 17",
+		100: "3 + 97, 11 + 89, 17 + 83, 29 + 71, 41 + 59, 47 + 53",
+	} {
+		ps, e := GoldbachPartitions(numbers.NFromUint64(v))
+		if e != nil {
+			t.Errorf("%d: unexpected error %v", v, e)
+			continue
+		}
+		var s []string
+		for _, p := range ps {
+			s = append(s, p.String())
+		}
+		if strings.Join(s, ", ") != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, strings.Join(s, ", "))
+		}
+	}
+	ps, _ := GoldbachPartitions(numbers.NFromUint64(1000000))
+	if len(ps) != 5402 {
+		t.Errorf("1000000: expected 5402 partitions, got %d", len(ps))
+	}
+	for _, v := range []uint64{0, 2, 3, 7, 101, MaxBetween + 2} {
+		if _, e := GoldbachPartitions(numbers.NFromUint64(v)); e == nil {
+			t.Errorf("%d: expected error", v)
+		}
+	}
+}
+
+func TestGoldbachPartition(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		4:                    "2 + 2",
+		100:                  "3 + 97",
+		98:                   "19 + 79",
+		18446744073709551614: "",
+	} {
+		p, e := GoldbachPartition(numbers.NFromUint64(v))
+		if e != nil {
+			t.Errorf("%d: unexpected error %v", v, e)
+			continue
+		}
+		if exp != "" && p.String() != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, p)
+		}
+		if p.P.Uint64()+p.Q.Uint64() != v || !IsPrime(p.P) || !IsPrime(p.Q) {
+			t.Errorf("%d: wrong partition %s", v, p)
+		}
+	}
+	for _, v := range []uint64{1, 2, 9} {
+		if _, e := GoldbachPartition(numbers.NFromUint64(v)); e == nil {
+			t.Errorf("%d: expected error", v)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"iter"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Iterator returns lazy iterator over all primes in increasing order: 2, 3, 5, 7, 11, ... - it ends only at the
+// largest prime fitting uint64, so the loop has to be stopped by the caller.
+//
+// It's incremental sieve of Eratosthenes: instead of crossing out a fixed range, the next odd multiple of each prime
+// found so far is kept in a map, and a number which isn't there is prime. A prime p is added to the map only when p^2
+// is reached - these primes come from another (recursive) Iterator, so only primes up to √n are remembered.
+func Iterator() iter.Seq[*numbers.N] {
+	return func(yield func(*numbers.N) bool) {
+		for _, p := range []uint64{2, 3, 5, 7} {
+			if !yield(numbers.NFromUint64(p)) {
+				return
+			}
+		}
+
+		// iterator over base primes, started when needed
+		var next func() (*numbers.N, bool)
+		var stop func()
+		defer func() {
+			if stop != nil {
+				stop()
+			}
+		}()
+		// odd multiples of the base primes - the next one mapped to 2p
+		multiples := make(map[uint64]uint64)
+		p, q := uint64(3), uint64(9)
+		for c := uint64(9); c <= largestPrime; c += 2 {
+			step, composite := multiples[c]
+			if composite {
+				delete(multiples, c)
+			} else if c < q {
+				if !yield(numbers.NFromUint64(c)) {
+					return
+				}
+				continue
+			} else {
+				// c = p^2, so p starts crossing out its multiples
+				step = 2 * p
+				if next == nil {
+					next, stop = iter.Pull(Iterator())
+					// skip 2 and 3
+					next()
+					next()
+				}
+				np, _ := next()
+				p = np.Uint64()
+				q = p * p
+			}
+			m := c + step
+			for _, taken := multiples[m]; taken; _, taken = multiples[m] {
+				m += step
+			}
+			multiples[m] = step
+		}
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestIterator(t *testing.T) {
+	ps, _ := Between(numbers.NFromUint64(0), numbers.NFromUint64(2000000))
+	i := 0
+	for p := range Iterator() {
+		if i == len(ps) {
+			break
+		}
+		if p.Uint64() != ps[i].Uint64() {
+			t.Fatalf("prime #%d: expected %s, got %s", i+1, ps[i], p)
+		}
+		i++
+	}
+	if i != len(ps) {
+		t.Errorf("expected %d primes, got %d", len(ps), i)
+	}
+
+	// early stop
+	var first []uint64
+	for p := range Iterator() {
+		first = append(first, p.Uint64())
+		if len(first) == 5 {
+			break
+		}
+	}
+	if len(first) != 5 || first[4] != 11 {
+		t.Errorf("expected 2 3 5 7 11, got %v", first)
+	}
+}
+
+func BenchmarkIterator(b *testing.B) {
+	for range b.N {
+		n := 0
+		for range Iterator() {
+			if n++; n == 100000 {
+				break
+			}
+		}
+	}
+}
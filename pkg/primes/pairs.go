@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// PrimePair is a pair of primes P < Q with a fixed gap Q - P
+type PrimePair struct {
+	P *numbers.N
+	Q *numbers.N
+}
+
+func (pp PrimePair) String() string {
+	return fmt.Sprintf("(%s, %s)", pp.P, pp.Q)
+}
+
+// TwinPrimes returns lazy iterator over pairs of primes (p, p + 2) with p + 2 <= bound: (3, 5), (5, 7), (11, 13), ...
+func TwinPrimes(bound *numbers.N) (iter.Seq[PrimePair], error) {
+	return primePairs(bound, 2)
+}
+
+// CousinPrimes returns lazy iterator over pairs of primes (p, p + 4) with p + 4 <= bound: (3, 7), (7, 11), ...
+func CousinPrimes(bound *numbers.N) (iter.Seq[PrimePair], error) {
+	return primePairs(bound, 4)
+}
+
+// SexyPrimes returns lazy iterator over pairs of primes (p, p + 6) with p + 6 <= bound: (5, 11), (7, 13), ... - there
+// may be other primes between p and p + 6, like 7 + 6 = 13 with 11 in between.
+func SexyPrimes(bound *numbers.N) (iter.Seq[PrimePair], error) {
+	return primePairs(bound, 6)
+}
+
+// primePairs returns iterator over pairs of primes with given gap, primes up to bound are sieved with Between when
+// the iteration starts
+func primePairs(bound *numbers.N, gap uint64) (iter.Seq[PrimePair], error) {
+	v := bound.Uint64()
+	if v > MaxBetween {
+		return nil, fmt.Errorf("%d is bigger than %d", v, MaxBetween)
+	}
+	return func(yield func(PrimePair) bool) {
+		ps, _ := Between(numbers.NFromUint64(0), bound)
+		// the other prime of the pair is at most gap/2 primes further
+		for i, p := range ps {
+			for _, q := range ps[i+1:] {
+				if d := q.Uint64() - p.Uint64(); d == gap {
+					if !yield(PrimePair{P: p, Q: q}) {
+						return
+					}
+				} else if d > gap {
+					break
+				}
+			}
+		}
+	}, nil
+}
+
+// PrimeGaps returns the differences between consecutive primes p, a <= p <= b: for 2..30 it's 1 2 2 4 2 4 2 4 6 (for
+// primes 2 3 5 7 11 13 17 19 23 29). The range can't be bigger than MaxBetween.
+func PrimeGaps(a *numbers.N, b *numbers.N) ([]*numbers.N, error) {
+	ps, e := Between(a, b)
+	if e != nil {
+		return nil, e
+	}
+	res := make([]*numbers.N, 0, max(len(ps)-1, 0))
+	for i := 1; i < len(ps); i++ {
+		res = append(res, numbers.NFromUint64(ps[i].Uint64()-ps[i-1].Uint64()))
+	}
+	return res, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPrimePairs(t *testing.T) {
+	for _, c := range []struct {
+		pairs func(*numbers.N) (iter.Seq[PrimePair], error)
+		bound uint64
+		exp   string
+	}{
+		{TwinPrimes, 50, "(3, 5) (5, 7) (11, 13) (17, 19) (29, 31) (41, 43)"},
+		{TwinPrimes, 4, ""},
+		{TwinPrimes, 5, "(3, 5)"},
+		{CousinPrimes, 50, "(3, 7) (7, 11) (13, 17) (19, 23) (37, 41) (43, 47)"},
+		{SexyPrimes, 50, "(5, 11) (7, 13) (11, 17) (13, 19) (17, 23) (23, 29) (31, 37) (37, 43) (41, 47)"},
+		{SexyPrimes, 46, "(5, 11) (7, 13) (11, 17) (13, 19) (17, 23) (23, 29) (31, 37) (37, 43)"},
+	} {
+		pairs, e := c.pairs(numbers.NFromUint64(c.bound))
+		if e != nil {
+			t.Errorf("%d: unexpected error %v", c.bound, e)
+			continue
+		}
+		var s []string
+		for p := range pairs {
+			s = append(s, p.String())
+		}
+		if strings.Join(s, " ") != c.exp {
+			t.Errorf("%d: expected %q, got %q", c.bound, c.exp, strings.Join(s, " "))
+		}
+	}
+
+	twins, _ := TwinPrimes(numbers.NFromUint64(1000000))
+	count := 0
+	for range twins {
+		count++
+	}
+	if count != 8169 {
+		t.Errorf("expected 8169 twin primes below 10^6, got %d", count)
+	}
+	for p := range twins {
+		if p.P.Uint64() != 3 {
+			t.Errorf("expected (3, 5) first, got %s", p)
+		}
+		break
+	}
+	if _, e := SexyPrimes(numbers.NFromUint64(MaxBetween + 1)); e == nil {
+		t.Errorf("expected error for too big bound")
+	}
+}
+
+func TestPrimeGaps(t *testing.T) {
+	for _, c := range []struct {
+		a, b uint64
+		exp  string
+	}{
+		{0, 30, "1 2 2 4 2 4 2 4 6"},
+		{1327, 1361, "34"},
+		{24, 28, ""},
+		{2, 2, ""},
+	} {
+		gaps, e := PrimeGaps(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b))
+		if e != nil {
+			t.Errorf("%d..%d: unexpected error %v", c.a, c.b, e)
+			continue
+		}
+		var s []string
+		for _, g := range gaps {
+			s = append(s, g.String())
+		}
+		if strings.Join(s, " ") != c.exp {
+			t.Errorf("%d..%d: expected %q, got %q", c.a, c.b, c.exp, strings.Join(s, " "))
+		}
+	}
+	if _, e := PrimeGaps(numbers.NFromUint64(10), numbers.NFromUint64(2)); e == nil {
+		t.Errorf("expected error for empty range")
+	}
+}
@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxPrimePi is the biggest n accepted by PrimePi - π(10^14) takes about half a minute
+const MaxPrimePi = 100_000_000_000_000
+
+// maxPiSieve limits the size of sieve used by PrimePi to 2^27 bits (16 MiB)
+const maxPiSieve = 1 << 27
+
+// PrimePi returns π(n) - the number of primes p <= n, for n <= MaxPrimePi.
+//
+// Small n are counted with sieve of Eratosthenes. For bigger n only numbers up to about n^(2/3) are sieved and the
+// rest is Meissel–Lehmer method: with a = π(n^(1/4)), b = π(n^(1/2)), c = π(n^(1/3))
+//
+//	π(n) = φ(n, a) + (b + a - 2)(b - a + 1) / 2 - Σ(a<i<=b) π(n / p_i) - Σ(a<i<=c) Σ(i<=j<=π(√(n/p_i))) (π(n / (p_i p_j)) - j + 1)
+//
+// where φ(x, a) counts numbers 1..x not divisible by first a primes: φ(x, a) = φ(x, a - 1) - φ(x / p_a, a - 1).
+func PrimePi(n *numbers.N) (*numbers.N, error) {
+	v := n.Uint64()
+	if v > MaxPrimePi {
+		return nil, fmt.Errorf("%d is bigger than %d", v, MaxPrimePi)
+	}
+	c := icbrt(v)
+	t := newPiTable(max(min(c*c, maxPiSieve), isqrt(v)+1, 64))
+	return numbers.NFromUint64(uint64(t.pi(v))), nil
+}
+
+// piTable is a sieve with counts of primes, so π(x) for x <= limit is found in constant time
+type piTable struct {
+	limit uint64
+	// bit i of prime[k] is set if 64k + i is prime
+	prime []uint64
+	// count[k] is the number of primes < 64k
+	count  []uint32
+	primes []uint64
+	// small[a][r] is φ(r, a) for r < p_1 * ... * p_a, which is enough to find φ(x, a) for any x
+	small [][]int64
+}
+
+// smallPhi is the number of primes with tabulated φ(x, a) - the biggest table has 2*3*5*7*11*13 = 30030 entries
+const smallPhi = 6
+
+func newPiTable(limit uint64) *piTable {
+	t := &piTable{limit: limit}
+	words := limit/64 + 1
+	composite := make([]uint64, words)
+	composite[0] = 3 // 0 and 1
+	for p := uint64(2); p*p <= limit; p++ {
+		if composite[p/64]&(1<<(p%64)) != 0 {
+			continue
+		}
+		for m := p * p; m <= limit; m += p {
+			composite[m/64] |= 1 << (m % 64)
+		}
+	}
+	t.prime = make([]uint64, words)
+	t.count = make([]uint32, words)
+	total := uint32(0)
+	for k, c := range composite {
+		t.prime[k] = ^c
+		if k == len(composite)-1 && (limit+1)%64 != 0 {
+			// numbers above limit
+			t.prime[k] &= 1<<((limit+1)%64) - 1
+		}
+		t.count[k] = total
+		total += uint32(bits.OnesCount64(t.prime[k]))
+	}
+	t.primes = make([]uint64, 0, total)
+	for k, w := range t.prime {
+		for ; w != 0; w &= w - 1 {
+			t.primes = append(t.primes, uint64(k)*64+uint64(bits.TrailingZeros64(w)))
+		}
+	}
+	t.small = make([][]int64, smallPhi+1)
+	t.small[0] = []int64{0}
+	for a, m := 1, uint64(1); a <= smallPhi; a++ {
+		m *= t.primes[a-1]
+		t.small[a] = make([]int64, m)
+		for r := uint64(1); r < m; r++ {
+			t.small[a][r] = t.small[a][r-1]
+			if modarith.GCD(r, m) == 1 {
+				t.small[a][r]++
+			}
+		}
+	}
+	return t
+}
+
+// pi returns π(x), using the table or Meissel–Lehmer formula with the table for smaller values
+func (t *piTable) pi(x uint64) int64 {
+	if x <= t.limit {
+		k := x / 64
+		return int64(t.count[k]) + int64(bits.OnesCount64(t.prime[k]&(2<<(x%64)-1)))
+	}
+	a := t.pi(isqrt(isqrt(x)))
+	b := t.pi(isqrt(x))
+	c := t.pi(icbrt(x))
+	sum := t.phi(x, a) + (b+a-2)*(b-a+1)/2
+	for i := a + 1; i <= b; i++ {
+		w := x / t.primes[i-1]
+		sum -= t.pi(w)
+		if i <= c {
+			bi := t.pi(isqrt(w))
+			for j := i; j <= bi; j++ {
+				sum -= t.pi(w/t.primes[j-1]) - (j - 1)
+			}
+		}
+	}
+	return sum
+}
+
+// phi returns φ(x, a) - the number of 1 <= k <= x not divisible by any of first a primes
+func (t *piTable) phi(x uint64, a int64) int64 {
+	if a == 0 || x == 0 {
+		return int64(x)
+	}
+	if a <= smallPhi {
+		// numbers coprime to m = p_1 * ... * p_a repeat with period m and there are φ(m, a) of them in each period
+		tab := t.small[a]
+		m := uint64(len(tab))
+		return int64(x/m)*tab[m-1] + tab[x%m]
+	}
+	if x <= t.limit {
+		if next := t.primes[a]; x < next*next {
+			// only 1 and primes p_a < p <= x are left
+			return max(t.pi(x)-a, 0) + 1
+		}
+	}
+	return t.phi(x, a-1) - t.phi(x/t.primes[a-1], a-1)
+}
+
+// icbrt returns floor(∛n)
+func icbrt(n uint64) uint64 {
+	r := uint64(math.Cbrt(float64(n)))
+	for r > 0 && r*r*r > n {
+		r--
+	}
+	for (r+1)*(r+1)*(r+1) <= n {
+		r++
+	}
+	return r
+}
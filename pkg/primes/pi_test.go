@@ -0,0 +1,58 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPrimePi(t *testing.T) {
+	for v, exp := range map[uint64]uint64{
+		0: 0, 1: 0, 2: 1, 3: 2, 10: 4, 63: 18, 64: 18, 100: 25, 1000: 168, 10000: 1229, 1000000: 78498,
+		100000000:     5761455,
+		1000000000:    50847534,
+		10000000000:   455052511,
+		100000000000:  4118054813,
+		1000000000000: 37607912018,
+	} {
+		pi, e := PrimePi(numbers.NFromUint64(v))
+		if e != nil || pi.Uint64() != exp {
+			t.Errorf("π(%d): expected %d, got %v, %v", v, exp, pi, e)
+		}
+	}
+	if _, e := PrimePi(numbers.NFromUint64(MaxPrimePi + 1)); e == nil {
+		t.Errorf("expected error for too big n")
+	}
+}
+
+func TestPrimePiSieve(t *testing.T) {
+	ps, _ := Between(numbers.NFromUint64(0), numbers.NFromUint64(100000))
+	tab := newPiTable(100000)
+	count := int64(0)
+	for x := uint64(0); x <= 100000; x++ {
+		if count < int64(len(ps)) && ps[count].Uint64() == x {
+			count++
+		}
+		if tab.pi(x) != count {
+			t.Fatalf("π(%d): expected %d, got %d", x, count, tab.pi(x))
+		}
+	}
+}
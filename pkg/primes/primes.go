@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package primes explores prime numbers in ℕ: primality testing, factorization, searching and counting
+package primes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxBetween is the maximal size of range checked by Between
+const MaxBetween = 1 << 26
+
+// largestPrime is the largest prime fitting uint64
+const largestPrime = 18446744073709551557
+
+// witnesses are enough to make Miller–Rabin test deterministic for every n < 2^64
+var witnesses = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime checks if n is prime - natural number with exactly two divisors: 1 and itself.
+//
+// It's deterministic Miller–Rabin test: n - 1 = d * 2^s (d odd) and for prime n every a has either a^d = 1 (mod n) or
+// a^(d*2^r) = -1 (mod n) for some r < s. For n < 2^64 it's enough to check first 12 primes as a.
+func IsPrime(n *numbers.N) bool {
+	v := n.Uint64()
+	return isPrime(v)
+}
+
+func isPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range witnesses {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	d, s := n-1, 0
+	for d%2 == 0 {
+		d, s = d/2, s+1
+	}
+	for _, a := range witnesses {
+		if !strongProbablePrime(n, a, d, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// strongProbablePrime checks if odd n passes Miller–Rabin test for base a, where n - 1 = d * 2^s
+func strongProbablePrime(n uint64, a uint64, d uint64, s int) bool {
+	x := modarith.PowMod(a%n, d, n)
+	if x == 1 || x == n-1 {
+		return true
+	}
+	for r := 1; r < s; r++ {
+		x = modarith.MulMod(x, x, n)
+		if x == n-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the smallest prime greater than n
+func Next(n *numbers.N) (*numbers.N, error) {
+	v := n.Uint64()
+	if v >= largestPrime {
+		return nil, numbers.ErrOverflow
+	}
+	for v++; !isPrime(v); v++ {
+	}
+	return numbers.NFromUint64(v), nil
+}
+
+// Between returns primes p, a <= p <= b, in increasing order. The range can't be bigger than MaxBetween - use Sieve
+// to iterate over bigger ranges.
+func Between(a *numbers.N, b *numbers.N) ([]*numbers.N, error) {
+	if a.Uint64() > b.Uint64() {
+		return nil, errors.New("empty range")
+	}
+	if b.Uint64()-a.Uint64() >= MaxBetween {
+		return nil, fmt.Errorf("range is bigger than %d", MaxBetween)
+	}
+	ps, _ := Sieve(a, b)
+	res := make([]*numbers.N, 0)
+	for p := range ps {
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// isqrt returns floor(√n)
+func isqrt(n uint64) uint64 {
+	r := uint64(0)
+	for bit := uint64(1) << 62; bit > 0; bit >>= 2 {
+		if n >= r+bit {
+			n -= r + bit
+			r = r>>1 + bit
+		} else {
+			r >>= 1
+		}
+	}
+	return r
+}
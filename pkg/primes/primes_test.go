@@ -0,0 +1,94 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestIsPrime(t *testing.T) {
+	for v, exp := range map[uint64]bool{
+		0: false, 1: false, 2: true, 3: true, 4: false, 37: true, 91: false, 97: true,
+		561:                  false, // Carmichael number
+		3215031751:           false, // strong pseudoprime to bases 2, 3, 5 and 7
+		2147483647:           true,
+		1000000007:           true,
+		4294967297:           false, // 641 × 6700417
+		18446744073709551557: true,
+		18446744073709551615: false,
+		3825123056546413051:  false, // strong pseudoprime to bases 2..23
+	} {
+		if IsPrime(numbers.NFromUint64(v)) != exp {
+			t.Errorf("%d: expected %v", v, exp)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	for v, exp := range map[uint64]uint64{0: 2, 1: 2, 2: 3, 13: 17, 100: 101, 18446744073709551556: 18446744073709551557} {
+		n, e := Next(numbers.NFromUint64(v))
+		if e != nil || n.Uint64() != exp {
+			t.Errorf("%d: expected %d, got %v, %v", v, exp, n, e)
+		}
+	}
+	if _, e := Next(numbers.NFromUint64(18446744073709551557)); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	for _, c := range []struct {
+		a, b uint64
+		exp  string
+	}{
+		{0, 30, "2 3 5 7 11 13 17 19 23 29"},
+		{10, 30, "11 13 17 19 23 29"},
+		{24, 28, ""},
+		{2, 2, "2"},
+		{1000000000, 1000000100, "1000000007 1000000009 1000000021 1000000033 1000000087 1000000093 1000000097"},
+		{18446744073709551500, 18446744073709551615, "18446744073709551521 18446744073709551533 " +
+			"18446744073709551557"},
+	} {
+		ps, e := Between(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b))
+		if e != nil {
+			t.Errorf("%d..%d: unexpected error %v", c.a, c.b, e)
+			continue
+		}
+		var s []string
+		for _, p := range ps {
+			s = append(s, p.String())
+		}
+		if strings.Join(s, " ") != c.exp {
+			t.Errorf("%d..%d: expected %s, got %s", c.a, c.b, c.exp, strings.Join(s, " "))
+		}
+	}
+	ps, _ := Between(numbers.NewN("1"), numbers.NewN("1000000"))
+	if len(ps) != 78498 {
+		t.Errorf("expected 78498 primes up to 10^6, got %d", len(ps))
+	}
+	if _, e := Between(numbers.NewN("10"), numbers.NewN("9")); e == nil {
+		t.Errorf("expected error for empty range")
+	}
+	if _, e := Between(numbers.NewN("0"), numbers.NFromUint64(MaxBetween)); e == nil {
+		t.Errorf("expected error for too big range")
+	}
+}
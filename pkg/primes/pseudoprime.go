@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"github.com/grgrzybek/gomath/internal/modarith"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// IsFermatPseudoprime checks if n is composite, but passes Fermat test for given base: base^(n-1) = 1 (mod n), which
+// holds for every prime n not dividing base. 341 = 11 * 31 is the smallest Fermat pseudoprime to base 2.
+func IsFermatPseudoprime(n *numbers.N, base *numbers.N) bool {
+	v := n.Uint64()
+	if v < 4 || isPrime(v) {
+		return false
+	}
+	return modarith.PowMod(base.Uint64()%v, v-1, v) == 1
+}
+
+// IsStrongPseudoprime checks if odd n is composite, but passes Miller–Rabin test (see IsPrime) for given base.
+// 2047 = 23 * 89 is the smallest strong pseudoprime to base 2. Unlike Fermat test, no composite passes it for all
+// bases, so IsPrime can be deterministic.
+func IsStrongPseudoprime(n *numbers.N, base *numbers.N) bool {
+	v := n.Uint64()
+	if v < 4 || v%2 == 0 || isPrime(v) {
+		return false
+	}
+	d, s := v-1, 0
+	for d%2 == 0 {
+		d, s = d/2, s+1
+	}
+	return strongProbablePrime(v, base.Uint64(), d, s)
+}
+
+// IsCarmichael checks if n is Carmichael number - composite n which is Fermat pseudoprime to every base coprime with
+// n, like 561 = 3 * 11 * 17.
+//
+// It's Korselt's criterion: n is square-free and p - 1 divides n - 1 for every prime p dividing n.
+func IsCarmichael(n *numbers.N) bool {
+	v := n.Uint64()
+	if v < 4 || isPrime(v) {
+		return false
+	}
+	factors, _ := Factorize(n)
+	if len(factors) < 2 {
+		return false
+	}
+	for _, f := range factors {
+		if p := f.Prime.Uint64(); f.Exponent > 1 || (v-1)%(p-1) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestIsFermatPseudoprime(t *testing.T) {
+	var found []uint64
+	for v := uint64(0); v < 2000; v++ {
+		if IsFermatPseudoprime(numbers.NFromUint64(v), numbers.NFromUint64(2)) {
+			found = append(found, v)
+		}
+	}
+	if exp := []uint64{341, 561, 645, 1105, 1387, 1729, 1905}; !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	if !IsFermatPseudoprime(numbers.NFromUint64(91), numbers.NFromUint64(3)) {
+		t.Errorf("91 is Fermat pseudoprime to base 3")
+	}
+	if IsFermatPseudoprime(numbers.NFromUint64(91), numbers.NFromUint64(2)) {
+		t.Errorf("91 is not Fermat pseudoprime to base 2")
+	}
+}
+
+func TestIsStrongPseudoprime(t *testing.T) {
+	var found []uint64
+	for v := uint64(0); v < 10000; v++ {
+		if IsStrongPseudoprime(numbers.NFromUint64(v), numbers.NFromUint64(2)) {
+			found = append(found, v)
+		}
+	}
+	if exp := []uint64{2047, 3277, 4033, 4681, 8321}; !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	// Fermat pseudoprime to base 2, but not strong one
+	if IsStrongPseudoprime(numbers.NFromUint64(341), numbers.NFromUint64(2)) {
+		t.Errorf("341 is not strong pseudoprime to base 2")
+	}
+	for _, b := range []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23} {
+		if !IsStrongPseudoprime(numbers.NFromUint64(3825123056546413051), numbers.NFromUint64(b)) {
+			t.Errorf("3825123056546413051 is strong pseudoprime to base %d", b)
+		}
+	}
+}
+
+func TestIsCarmichael(t *testing.T) {
+	var found []uint64
+	for v := uint64(0); v < 10000; v++ {
+		if IsCarmichael(numbers.NFromUint64(v)) {
+			found = append(found, v)
+		}
+	}
+	if exp := []uint64{561, 1105, 1729, 2465, 2821, 6601, 8911}; !slices.Equal(found, exp) {
+		t.Errorf("expected %v, got %v", exp, found)
+	}
+	for _, v := range []uint64{1, 2, 97, 341, 4} {
+		if IsCarmichael(numbers.NFromUint64(v)) {
+			t.Errorf("%d is not Carmichael number", v)
+		}
+	}
+}
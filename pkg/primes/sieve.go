@@ -0,0 +1,156 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"math/bits"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// SieveSegment is the (approximate) size of a segment crossed out at once by Sieve
+const SieveSegment = 1 << 18
+
+// maxSieveBase limits primes used by Sieve to cross out multiples - above (2^24)^2 = 2^48 remaining numbers are
+// checked with IsPrime
+const maxSieveBase = 1 << 24
+
+var (
+	// wheel are the residues modulo 30 coprime with 2, 3 and 5 - only such numbers (except 2, 3 and 5) can be prime
+	wheel = [8]uint64{1, 7, 11, 13, 17, 19, 23, 29}
+	// wheelGap is the distance from wheel[i] to the next residue on the wheel
+	wheelGap = [8]uint64{6, 4, 2, 4, 2, 4, 6, 2}
+	// wheelIndex maps residue modulo 30 to its position on the wheel or to the position of the next residue
+	wheelIndex [30]int
+	// wheelBit maps residue modulo 30 to the bit representing it in a byte of the sieve (0 if it's not on the wheel)
+	wheelBit [30]byte
+)
+
+func init() {
+	i := 0
+	for r := range 30 {
+		wheelIndex[r] = i
+		if i < 8 && uint64(r) == wheel[i] {
+			wheelBit[r] = 1 << i
+			i++
+		}
+	}
+}
+
+// Sieve returns lazy iterator over primes p, a <= p <= b, in increasing order. Unlike Between, the range can be
+// arbitrarily big.
+//
+// It's segmented sieve of Eratosthenes: primes up to √b are found first and then [a, b] is sieved in segments of
+// about SieveSegment numbers, so the memory is proportional to √b and the segment size, not to the size of the range.
+//
+// Multiples of 2, 3 and 5 are skipped with a wheel: only 8 of every 30 numbers are coprime with 30, so each byte
+// of the segment keeps 30 numbers and each prime crosses out only its multiples p*k for k on the wheel.
+func Sieve(a *numbers.N, b *numbers.N) (iter.Seq[*numbers.N], error) {
+	lo, hi := a.Uint64(), b.Uint64()
+	if lo > hi {
+		return nil, errors.New("empty range")
+	}
+	return func(yield func(*numbers.N) bool) {
+		for _, p := range []uint64{2, 3, 5} {
+			if lo <= p && p <= hi && !yield(numbers.NFromUint64(p)) {
+				return
+			}
+		}
+		limit := min(isqrt(hi), maxSieveBase)
+		base := smallPrimes(limit)
+		sieved := limit == isqrt(hi)
+
+		const width = SieveSegment / 30
+		segment := make([]byte, width)
+		for start := lo - lo%30; ; start += 30 * width {
+			// the last number of the segment, capped at hi (also when it doesn't fit uint64)
+			end := hi
+			if hi-start >= 30*width {
+				end = start + 30*width - 1
+			}
+			for i := range segment {
+				segment[i] = 0xff
+			}
+			for _, p := range base {
+				if p < 7 {
+					continue
+				}
+				if p > end/p {
+					break
+				}
+				// the first k on the wheel with p*k in the segment and k >= p
+				k := start / p
+				if k*p < start {
+					k++
+				}
+				k = max(k, p)
+				i := wheelIndex[k%30]
+				k += wheel[i] - k%30
+				for ; k <= end/p; k, i = k+wheelGap[i], (i+1)%8 {
+					off := p*k - start
+					segment[off/30] &^= wheelBit[off%30]
+				}
+			}
+			for j, bs := range segment {
+				for ; bs != 0; bs &= bs - 1 {
+					off := uint64(j)*30 + wheel[bits.TrailingZeros8(bs)]
+					if off > end-start {
+						break
+					}
+					if v := start + off; v >= lo && v > 1 && (sieved || isPrime(v)) {
+						if !yield(numbers.NFromUint64(v)) {
+							return
+						}
+					}
+				}
+			}
+			if end == hi {
+				return
+			}
+		}
+	}, nil
+}
+
+// smallPrimes returns all primes p <= limit (limit < 2^32), sieving only odd numbers kept as bits
+func smallPrimes(limit uint64) []uint64 {
+	if limit < 2 {
+		return []uint64{}
+	}
+	// bit i represents 2i + 1
+	composite := make([]uint64, limit/128+1)
+	for p := uint64(3); p*p <= limit; p += 2 {
+		if composite[p/128]&(1<<(p/2%64)) != 0 {
+			continue
+		}
+		for m := p * p; m <= limit; m += 2 * p {
+			composite[m/128] |= 1 << (m / 2 % 64)
+		}
+	}
+	res := make([]uint64, 0, int(1.26*float64(limit)/math.Log(float64(limit)))+1)
+	res = append(res, 2)
+	for p := uint64(3); p <= limit; p += 2 {
+		if composite[p/128]&(1<<(p/2%64)) == 0 {
+			res = append(res, p)
+		}
+	}
+	return res
+}
@@ -0,0 +1,91 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestSieve(t *testing.T) {
+	for _, c := range []struct {
+		a, b uint64
+		exp  string
+	}{
+		{0, 30, "2 3 5 7 11 13 17 19 23 29"},
+		{24, 28, ""},
+		{2, 2, "2"},
+		{1000000000000, 1000000000100, "1000000000039 1000000000061 1000000000063 1000000000091"},
+		{18446744073709551500, 18446744073709551615, "18446744073709551521 18446744073709551533 " +
+			"18446744073709551557"},
+	} {
+		ps, e := Sieve(numbers.NFromUint64(c.a), numbers.NFromUint64(c.b))
+		if e != nil {
+			t.Errorf("%d..%d: unexpected error %v", c.a, c.b, e)
+			continue
+		}
+		var s []string
+		for p := range ps {
+			s = append(s, p.String())
+		}
+		if strings.Join(s, " ") != c.exp {
+			t.Errorf("%d..%d: expected %q, got %q", c.a, c.b, c.exp, strings.Join(s, " "))
+		}
+	}
+	if _, e := Sieve(numbers.NFromUint64(10), numbers.NFromUint64(2)); e == nil {
+		t.Errorf("expected error for empty range")
+	}
+}
+
+func TestSieveSegments(t *testing.T) {
+	// many segments, compared with Between
+	a, b := uint64(1000000), uint64(1000000+3*SieveSegment+12345)
+	exp, _ := Between(numbers.NFromUint64(a), numbers.NFromUint64(b))
+	ps, _ := Sieve(numbers.NFromUint64(a), numbers.NFromUint64(b))
+	i := 0
+	for p := range ps {
+		if i >= len(exp) || p.Uint64() != exp[i].Uint64() {
+			t.Fatalf("prime #%d: unexpected %s", i, p)
+		}
+		i++
+	}
+	if i != len(exp) {
+		t.Errorf("expected %d primes, got %d", len(exp), i)
+	}
+
+	// range bigger than MaxBetween
+	ps, _ = Sieve(numbers.NFromUint64(0), numbers.NFromUint64(MaxBetween*2))
+	count := 0
+	for range ps {
+		count++
+	}
+	if count != 7603553 {
+		t.Errorf("expected 7603553 primes below 2^27, got %d", count)
+	}
+}
+
+func BenchmarkSieve(b *testing.B) {
+	for range b.N {
+		ps, _ := Sieve(numbers.NFromUint64(1000000000), numbers.NFromUint64(1000000000+10000000))
+		for range ps {
+		}
+	}
+}
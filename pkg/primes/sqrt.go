@@ -0,0 +1,43 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// SimplifySqrt returns outside and square-free inside such that √n = outside·√inside: √72 = 6·√2, √49 = 7·√1,
+// √0 = 0·√1. For n = p1^k1 * ... * pr^kr, each prime goes outside ki/2 times and stays inside if ki is odd.
+func SimplifySqrt(n *numbers.N) (outside *numbers.N, inside *numbers.N) {
+	if n.Uint64() == 0 {
+		return numbers.ZeroN(), numbers.OneN()
+	}
+	factors, _ := Factorize(n)
+	out, in := uint64(1), uint64(1)
+	for _, f := range factors {
+		p := f.Prime.Uint64()
+		for range f.Exponent / 2 {
+			out *= p
+		}
+		if f.Exponent%2 == 1 {
+			in *= p
+		}
+	}
+	return numbers.NFromUint64(out), numbers.NFromUint64(in)
+}
@@ -0,0 +1,45 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package primes
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestSimplifySqrt(t *testing.T) {
+	for n, exp := range map[uint64][2]uint64{
+		0:                    {0, 1},
+		1:                    {1, 1},
+		2:                    {1, 2},
+		49:                   {7, 1},
+		72:                   {6, 2},
+		360:                  {6, 10},
+		1000000:              {1000, 1},
+		18446744073709551615: {1, 18446744073709551615},
+		9223372036854775808:  {2147483648, 2},
+		999966000289:         {999983, 1},
+	} {
+		out, in := SimplifySqrt(numbers.NFromUint64(n))
+		if out.Uint64() != exp[0] || in.Uint64() != exp[1] {
+			t.Errorf("√%d: expected %d·√%d, got %s·√%s", n, exp[0], exp[1], out, in)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package probability
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// ErrEmpty is returned for distributions without outcomes
+var ErrEmpty = errors.New("distribution has no outcomes")
+
+// MaxDice limits the number of dice and their sides in DiceSum
+const MaxDice = 1000
+
+// Outcome is a value of discrete random variable with its probability
+type Outcome struct {
+	Value       *numbers.Z
+	Probability *numbers.Q
+}
+
+func (o Outcome) String() string {
+	return fmt.Sprintf("P(%s) = %s", o.Value, o.Probability)
+}
+
+// Distribution is a discrete probability distribution - outcomes with different values in increasing order
+type Distribution []Outcome
+
+// DiceSum returns the distribution of the sum of given number of fair dice with given number of sides (1..sides):
+// for two 6-sided dice P(7) = 6/36 = 1/6 and P(2) = P(12) = 1/36.
+//
+// The number of ways to get each sum is found by adding one die at a time: ways(s) for d dice is the sum of
+// ways(s - 1), ..., ways(s - sides) for d - 1 dice.
+func DiceSum(dice *numbers.N, sides *numbers.N) (Distribution, error) {
+	d, s := dice.Uint64(), sides.Uint64()
+	if d == 0 || s == 0 {
+		return nil, errors.New("there has to be at least one die with at least one side")
+	}
+	if d > MaxDice || s > MaxDice {
+		return nil, fmt.Errorf("number of dice and sides can't be bigger than %d", MaxDice)
+	}
+	// ways[i] is the number of ways to get sum i (without dice, only 0 is possible)
+	ways := []*big.Int{big.NewInt(1)}
+	for range d {
+		next := make([]*big.Int, len(ways)+int(s))
+		for i := range next {
+			next[i] = new(big.Int)
+		}
+		for i, w := range ways {
+			for face := 1; face <= int(s); face++ {
+				next[i+face].Add(next[i+face], w)
+			}
+		}
+		ways = next
+	}
+	all := new(big.Int).Exp(new(big.Int).SetUint64(s), new(big.Int).SetUint64(d), nil)
+	res := make(Distribution, 0, len(ways))
+	for sum, w := range ways {
+		if w.Sign() == 0 {
+			continue
+		}
+		p, e := numbers.QFromRat(new(big.Rat).SetFrac(w, all))
+		if e != nil {
+			return nil, e
+		}
+		res = append(res, Outcome{Value: numbers.ZFromInt64(int64(sum)), Probability: p})
+	}
+	return res, nil
+}
+
+// BinomialDistribution returns the distribution of the number of successes in n independent trials with success
+// probability p (see Binomial), leaving out impossible outcomes
+func BinomialDistribution(n *numbers.N, p *numbers.Q) (Distribution, error) {
+	terms, e := binomialTerms(n, n, p)
+	if e != nil {
+		return nil, e
+	}
+	res := make(Distribution, 0, len(terms))
+	for k, t := range terms {
+		if t.Sign() == 0 {
+			continue
+		}
+		q, e := numbers.QFromRat(t)
+		if e != nil {
+			return nil, e
+		}
+		res = append(res, Outcome{Value: numbers.ZFromInt64(int64(k)), Probability: q})
+	}
+	return res, nil
+}
+
+// ExpectedValue returns E(X) = x1 * P(x1) + x2 * P(x2) + ... - the mean value of the distribution
+func (d Distribution) ExpectedValue() (*numbers.Q, error) {
+	if len(d) == 0 {
+		return nil, ErrEmpty
+	}
+	res := new(big.Rat)
+	for _, o := range d {
+		x := new(big.Rat).SetInt64(o.Value.Int64())
+		res.Add(res, x.Mul(x, o.Probability.Rat()))
+	}
+	return numbers.QFromRat(res)
+}
+
+// Variance returns Var(X) = E((X - E(X))^2) = E(X^2) - E(X)^2
+func (d Distribution) Variance() (*numbers.Q, error) {
+	if len(d) == 0 {
+		return nil, ErrEmpty
+	}
+	mean, sq := new(big.Rat), new(big.Rat)
+	for _, o := range d {
+		x := new(big.Rat).SetInt64(o.Value.Int64())
+		p := o.Probability.Rat()
+		mean.Add(mean, new(big.Rat).Mul(x, p))
+		sq.Add(sq, new(big.Rat).Mul(new(big.Rat).Mul(x, x), p))
+	}
+	return numbers.QFromRat(sq.Sub(sq, mean.Mul(mean, mean)))
+}
+
+// Probability returns P(X = value) - ZERO for values which are not outcomes of the distribution
+func (d Distribution) Probability(value *numbers.Z) *numbers.Q {
+	i, found := slices.BinarySearchFunc(d, value, func(o Outcome, v *numbers.Z) int {
+		return o.Value.Compare(v)
+	})
+	if !found {
+		return numbers.ZeroQ()
+	}
+	return d[i].Probability
+}
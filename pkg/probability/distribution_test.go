@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package probability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDiceSum(t *testing.T) {
+	d, e := DiceSum(n(2), n(6))
+	if e != nil {
+		t.Fatalf("unexpected error %v", e)
+	}
+	var s []string
+	for _, o := range d {
+		s = append(s, o.String())
+	}
+	exp := "P(2) = 1/36, P(3) = 1/18, P(4) = 1/12, P(5) = 1/9, P(6) = 5/36, P(7) = 1/6, P(8) = 5/36, P(9) = 1/9, " +
+		"P(10) = 1/12, P(11) = 1/18, P(12) = 1/36"
+	if strings.Join(s, ", ") != exp {
+		t.Errorf("expected %q, got %q", exp, strings.Join(s, ", "))
+	}
+	if p := d.Probability(numbers.ZFromInt64(7)); p.String() != "1/6" {
+		t.Errorf("P(7): expected 1/6, got %s", p)
+	}
+	if p := d.Probability(numbers.ZFromInt64(13)); p.String() != "0/1" {
+		t.Errorf("P(13): expected 0, got %s", p)
+	}
+	total, _ := numbers.SumQ(func() (res []*numbers.Q) {
+		for _, o := range d {
+			res = append(res, o.Probability)
+		}
+		return
+	}())
+	if total.String() != "1/1" {
+		t.Errorf("expected total probability 1, got %s", total)
+	}
+
+	d, _ = DiceSum(n(3), n(6))
+	if p := d.Probability(numbers.ZFromInt64(10)); p.String() != "1/8" {
+		t.Errorf("3 dice, P(10): expected 1/8, got %s", p)
+	}
+	d, _ = DiceSum(n(1), n(1))
+	if len(d) != 1 || d[0].Probability.String() != "1/1" {
+		t.Errorf("unexpected %v", d)
+	}
+	for _, c := range [][2]uint64{{0, 6}, {1, 0}, {MaxDice + 1, 6}} {
+		if _, e := DiceSum(n(c[0]), n(c[1])); e == nil {
+			t.Errorf("%v: expected error", c)
+		}
+	}
+	// 6^30 doesn't fit int64
+	if _, e := DiceSum(n(30), n(6)); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestExpectedValue(t *testing.T) {
+	d, _ := DiceSum(n(1), n(6))
+	if ev, e := d.ExpectedValue(); e != nil || ev.String() != "7/2" {
+		t.Errorf("expected 7/2, got %v, %v", ev, e)
+	}
+	if v, e := d.Variance(); e != nil || v.String() != "35/12" {
+		t.Errorf("expected 35/12, got %v, %v", v, e)
+	}
+	d, _ = DiceSum(n(2), n(6))
+	if ev, e := d.ExpectedValue(); e != nil || ev.String() != "7/1" {
+		t.Errorf("expected 7, got %v, %v", ev, e)
+	}
+	if v, e := d.Variance(); e != nil || v.String() != "35/6" {
+		t.Errorf("expected 35/6, got %v, %v", v, e)
+	}
+
+	// n * p and n * p * (1 - p)
+	b, _ := BinomialDistribution(n(10), numbers.NewQ("1/3"))
+	if ev, e := b.ExpectedValue(); e != nil || ev.String() != "10/3" {
+		t.Errorf("expected 10/3, got %v, %v", ev, e)
+	}
+	if v, e := b.Variance(); e != nil || v.String() != "20/9" {
+		t.Errorf("expected 20/9, got %v, %v", v, e)
+	}
+	b, _ = BinomialDistribution(n(5), numbers.NewQ("1/1"))
+	if len(b) != 1 || b[0].Value.Int64() != 5 {
+		t.Errorf("unexpected %v", b)
+	}
+
+	var empty Distribution
+	if _, e := empty.ExpectedValue(); e != ErrEmpty {
+		t.Errorf("expected ErrEmpty, got %v", e)
+	}
+	if _, e := empty.Variance(); e != ErrEmpty {
+		t.Errorf("expected ErrEmpty, got %v", e)
+	}
+}
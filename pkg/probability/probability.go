@@ -0,0 +1,150 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package probability calculates exact probabilities of discrete distributions in ℚ - there's no rounding, so
+// probabilities of all outcomes always sum to exactly 1
+package probability
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/grgrzybek/gomath/pkg/combinatorics"
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxTrials limits the number of trials or the population size - exact probabilities of bigger experiments rarely fit
+// int64 and their intermediate values grow too much
+const MaxTrials = 10000
+
+// maxCachedBinomials limits the memory of binomial coefficients remembered between the calls
+const maxCachedBinomials = 1 << 16
+
+// binomials are shared by all distributions - CDFs and distributions of the same experiment need the same
+// coefficients again and again
+var binomials = combinatorics.NewBoundedCache(maxCachedBinomials)
+
+// Binomial returns the probability of exactly k successes in n independent trials with success probability p:
+// C(n, k) * p^k * (1 - p)^(n - k)
+func Binomial(n *numbers.N, k *numbers.N, p *numbers.Q) (*numbers.Q, error) {
+	terms, e := binomialTerms(n, k, p)
+	if e != nil {
+		return nil, e
+	}
+	return numbers.QFromRat(terms[len(terms)-1])
+}
+
+// BinomialCDF returns the probability of at most k successes in n independent trials with success probability p
+func BinomialCDF(n *numbers.N, k *numbers.N, p *numbers.Q) (*numbers.Q, error) {
+	terms, e := binomialTerms(n, k, p)
+	if e != nil {
+		return nil, e
+	}
+	return numbers.QFromRat(sum(terms))
+}
+
+// binomialTerms returns binomial probabilities of 0..min(k, n) successes
+func binomialTerms(n *numbers.N, k *numbers.N, p *numbers.Q) ([]*big.Rat, error) {
+	rp := p.Rat()
+	if rp.Sign() < 0 || rp.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, fmt.Errorf("probability %s is not between 0 and 1", p)
+	}
+	if n.Uint64() > MaxTrials {
+		return nil, fmt.Errorf("number of trials is bigger than %d", MaxTrials)
+	}
+	if k.Uint64() > n.Uint64() {
+		return nil, fmt.Errorf("%s successes in %s trials are impossible", k, n)
+	}
+	q := new(big.Rat).Sub(big.NewRat(1, 1), rp)
+	res := make([]*big.Rat, 0, k.Uint64()+1)
+	for i := uint64(0); i <= k.Uint64(); i++ {
+		t := new(big.Rat).SetInt(binomial(n.Uint64(), i))
+		t.Mul(t, pow(rp, i))
+		t.Mul(t, pow(q, n.Uint64()-i))
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+// Hypergeometric returns the probability of exactly k successes in n draws without replacement from population of
+// size total with given number of successes: C(successes, k) * C(total - successes, n - k) / C(total, n)
+func Hypergeometric(total *numbers.N, successes *numbers.N, n *numbers.N, k *numbers.N) (*numbers.Q, error) {
+	terms, e := hypergeometricTerms(total, successes, n, k)
+	if e != nil {
+		return nil, e
+	}
+	return numbers.QFromRat(terms[len(terms)-1])
+}
+
+// HypergeometricCDF returns the probability of at most k successes in n draws without replacement (see
+// Hypergeometric)
+func HypergeometricCDF(total *numbers.N, successes *numbers.N, n *numbers.N, k *numbers.N) (*numbers.Q, error) {
+	terms, e := hypergeometricTerms(total, successes, n, k)
+	if e != nil {
+		return nil, e
+	}
+	return numbers.QFromRat(sum(terms))
+}
+
+// hypergeometricTerms returns hypergeometric probabilities of 0..k successes
+func hypergeometricTerms(total *numbers.N, successes *numbers.N, n *numbers.N, k *numbers.N) ([]*big.Rat, error) {
+	nt, ns, nn, nk := total.Uint64(), successes.Uint64(), n.Uint64(), k.Uint64()
+	if nt > MaxTrials {
+		return nil, fmt.Errorf("population is bigger than %d", MaxTrials)
+	}
+	if ns > nt || nn > nt {
+		return nil, fmt.Errorf("can't take %s successes or %s draws from population of %s", successes, n, total)
+	}
+	if nk > nn {
+		return nil, fmt.Errorf("%s successes in %s draws are impossible", k, n)
+	}
+	all := binomial(nt, nn)
+	res := make([]*big.Rat, 0, nk+1)
+	for i := uint64(0); i <= nk; i++ {
+		c := big.NewInt(0)
+		if i <= ns && nn-i <= nt-ns {
+			c.Mul(binomial(ns, i), binomial(nt-ns, nn-i))
+		}
+		res = append(res, new(big.Rat).SetFrac(c, all))
+	}
+	return res, nil
+}
+
+// binomial returns C(n, k) = n! / (k! (n - k)!)
+func binomial(n uint64, k uint64) *big.Int {
+	// n <= MaxTrials is always cached
+	res, _ := binomials.BigBinomial(numbers.NFromUint64(n), numbers.NFromUint64(k))
+	return res
+}
+
+// pow returns r^e
+func pow(r *big.Rat, e uint64) *big.Rat {
+	num := new(big.Int).Exp(r.Num(), new(big.Int).SetUint64(e), nil)
+	den := new(big.Int).Exp(r.Denom(), new(big.Int).SetUint64(e), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// sum returns the sum of rs
+func sum(rs []*big.Rat) *big.Rat {
+	res := new(big.Rat)
+	for _, r := range rs {
+		res.Add(res, r)
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package probability
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func n(v uint64) *numbers.N {
+	return numbers.NFromUint64(v)
+}
+
+func TestBinomial(t *testing.T) {
+	half := numbers.NewQ("1/2")
+	for _, c := range []struct {
+		n, k     uint64
+		p        string
+		pmf, cdf string
+	}{
+		{10, 5, "1/2", "63/256", "319/512"},
+		{10, 0, "1/2", "1/1024", "1/1024"},
+		{10, 10, "1/2", "1/1024", "1/1"},
+		{4, 2, "1/6", "25/216", "425/432"},
+		{3, 1, "0/1", "0/1", "1/1"},
+		{3, 3, "1/1", "1/1", "1/1"},
+		{0, 0, "1/3", "1/1", "1/1"},
+	} {
+		p := numbers.NewQ(c.p)
+		pmf, e := Binomial(n(c.n), n(c.k), p)
+		if e != nil || pmf.String() != c.pmf {
+			t.Errorf("B(%d, %s) = %d: expected %s, got %v, %v", c.n, c.p, c.k, c.pmf, pmf, e)
+		}
+		cdf, e := BinomialCDF(n(c.n), n(c.k), p)
+		if e != nil || cdf.String() != c.cdf {
+			t.Errorf("B(%d, %s) <= %d: expected %s, got %v, %v", c.n, c.p, c.k, c.cdf, cdf, e)
+		}
+	}
+	if _, e := Binomial(n(3), n(4), half); e == nil {
+		t.Errorf("expected error for k > n")
+	}
+	if _, e := Binomial(n(3), n(1), numbers.NewQ("3/2")); e == nil {
+		t.Errorf("expected error for p > 1")
+	}
+	if _, e := Binomial(n(3), n(1), numbers.NewQ("-1/2")); e == nil {
+		t.Errorf("expected error for p < 0")
+	}
+	if _, e := Binomial(n(MaxTrials+1), n(1), half); e == nil {
+		t.Errorf("expected error for too many trials")
+	}
+	// 1/2^100 doesn't fit int64
+	if _, e := Binomial(n(100), n(50), half); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	// the coefficients are remembered
+	if binomials.Len() == 0 {
+		t.Errorf("binomial coefficients are not cached")
+	}
+}
+
+func TestHypergeometric(t *testing.T) {
+	for _, c := range []struct {
+		total, successes, draws, k uint64
+		pmf, cdf                   string
+	}{
+		// 2 aces in 5 cards from a deck of 52
+		{52, 4, 5, 2, "2162/54145", "10810/10829"},
+		{52, 4, 5, 0, "35673/54145", "35673/54145"},
+		// lottery - 6 of 49
+		{49, 6, 6, 6, "1/13983816", "1/1"},
+		{10, 3, 5, 4, "0/1", "1/1"},
+	} {
+		pmf, e := Hypergeometric(n(c.total), n(c.successes), n(c.draws), n(c.k))
+		if e != nil || pmf.String() != c.pmf {
+			t.Errorf("H(%d, %d, %d) = %d: expected %s, got %v, %v", c.total, c.successes, c.draws, c.k, c.pmf, pmf, e)
+		}
+		cdf, e := HypergeometricCDF(n(c.total), n(c.successes), n(c.draws), n(c.k))
+		if e != nil || cdf.String() != c.cdf {
+			t.Errorf("H(%d, %d, %d) <= %d: expected %s, got %v, %v", c.total, c.successes, c.draws, c.k, c.cdf, cdf, e)
+		}
+	}
+	for _, c := range [][4]uint64{{10, 11, 1, 0}, {10, 1, 11, 0}, {10, 3, 2, 3}, {MaxTrials + 1, 1, 1, 1}} {
+		if _, e := Hypergeometric(n(c[0]), n(c[1]), n(c[2]), n(c[3])); e == nil {
+			t.Errorf("%v: expected error", c)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sequences
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Factoradic returns digits of n in factorial number system, the most significant first: the k-th digit from the
+// right (counting from 0) has weight k! and is at most k, so 463 = 3*5! + 4*4! + 1*3! + 0*2! + 1*1! + 0*0! is
+// [3 4 1 0 1 0]. The last digit is always 0 and 0 is [0].
+func Factoradic(n *numbers.N) []int {
+	v := n.Uint64()
+	res := []int{0}
+	for radix := uint64(2); v > 0; radix++ {
+		res = append(res, int(v%radix))
+		v /= radix
+	}
+	slices.Reverse(res)
+	return res
+}
+
+// FromFactoradic returns ℕ with given digits in factorial number system (see Factoradic). An error is returned if
+// k-th digit from the right is bigger than k or the value doesn't fit uint64.
+func FromFactoradic(digits []int) (*numbers.N, error) {
+	if len(digits) == 0 {
+		return nil, errors.New("no factoradic digits")
+	}
+	v := uint64(0)
+	for i, d := range digits {
+		radix := len(digits) - i
+		if d < 0 || d >= radix {
+			return nil, fmt.Errorf("digit %d at position %d has to be between 0 and %d", d, radix-1, radix-1)
+		}
+		// v = v * radix + d
+		hi, lo := bits.Mul64(v, uint64(radix))
+		var carry uint64
+		if v, carry = bits.Add64(lo, uint64(d), 0); hi != 0 || carry != 0 {
+			return nil, numbers.ErrOverflow
+		}
+	}
+	return numbers.NFromUint64(v), nil
+}
+
+// LehmerCode returns Lehmer code of permutation of 0..k-1: i-th element of the code is the number of elements after
+// perm[i] which are smaller than it. [2 0 3 1] has code [2 0 1 0]. The code is a valid factoradic number.
+func LehmerCode(perm []int) ([]int, error) {
+	if e := checkPermutation(perm); e != nil {
+		return nil, e
+	}
+	res := make([]int, len(perm))
+	for i, p := range perm {
+		for _, q := range perm[i+1:] {
+			if q < p {
+				res[i]++
+			}
+		}
+	}
+	return res, nil
+}
+
+// PermutationIndex returns the position (counting from 0) of permutation of 0..k-1 among all k! permutations in
+// lexicographic order - its Lehmer code read as factoradic number: [0 1 2] is 0, [2 1 0] is 5.
+func PermutationIndex(perm []int) (*numbers.N, error) {
+	code, e := LehmerCode(perm)
+	if e != nil {
+		return nil, e
+	}
+	if len(code) == 0 {
+		return numbers.NFromUint64(0), nil
+	}
+	return FromFactoradic(code)
+}
+
+// Permutation returns permutation of 0..k-1 at given position among all k! permutations in lexicographic order - the
+// factoradic digits of index are Lehmer code, so each digit selects one of the remaining elements. An error is
+// returned if index >= k!.
+func Permutation(index *numbers.N, k int) ([]int, error) {
+	if k < 0 {
+		return nil, errors.New("permutation size can't be negative")
+	}
+	code := Factoradic(index)
+	if len(code) > max(k, 1) || (k == 0 && index.Uint64() > 0) {
+		return nil, fmt.Errorf("index %s of a permutation of %d elements must be less than %d!", index, k, k)
+	}
+	if k == 0 {
+		return []int{}, nil
+	}
+	code = append(make([]int, k-len(code)), code...)
+	remaining := make([]int, k)
+	for i := range remaining {
+		remaining[i] = i
+	}
+	res := make([]int, 0, k)
+	for _, d := range code {
+		res = append(res, remaining[d])
+		remaining = slices.Delete(remaining, d, d+1)
+	}
+	return res, nil
+}
+
+// checkPermutation checks if perm contains each of 0..k-1 exactly once
+func checkPermutation(perm []int) error {
+	seen := make([]bool, len(perm))
+	for _, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			return fmt.Errorf("%v is not a permutation of 0..%d", perm, len(perm)-1)
+		}
+		seen[p] = true
+	}
+	return nil
+}
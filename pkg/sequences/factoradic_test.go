@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sequences
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestFactoradic(t *testing.T) {
+	for v, exp := range map[uint64][]int{
+		0: {0}, 1: {1, 0}, 2: {1, 0, 0}, 5: {2, 1, 0}, 463: {3, 4, 1, 0, 1, 0},
+		2432902008176640000: {1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, // 20!
+	} {
+		d := Factoradic(numbers.NFromUint64(v))
+		if !slices.Equal(d, exp) {
+			t.Errorf("%d: expected %v, got %v", v, exp, d)
+		}
+		n, e := FromFactoradic(d)
+		if e != nil || n.Uint64() != v {
+			t.Errorf("%v: expected %d, got %v, %v", d, v, n, e)
+		}
+	}
+	d := Factoradic(numbers.NFromUint64(math.MaxUint64))
+	if n, e := FromFactoradic(d); e != nil || n.Uint64() != math.MaxUint64 {
+		t.Errorf("%v: expected %d, got %v, %v", d, uint64(math.MaxUint64), n, e)
+	}
+	for _, d := range [][]int{{}, {1}, {2, 0}, {0, -1, 0}, {8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}} {
+		if _, e := FromFactoradic(d); e == nil {
+			t.Errorf("%v: expected error", d)
+		}
+	}
+}
+
+func TestLehmerCode(t *testing.T) {
+	code, e := LehmerCode([]int{2, 0, 3, 1})
+	if e != nil || !slices.Equal(code, []int{2, 0, 1, 0}) {
+		t.Errorf("expected [2 0 1 0], got %v, %v", code, e)
+	}
+	for _, p := range [][]int{{0, 0}, {1, 2}, {-1, 0}} {
+		if _, e := LehmerCode(p); e == nil {
+			t.Errorf("%v: expected error", p)
+		}
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	var all []string
+	for i := range uint64(6) {
+		p, e := Permutation(numbers.NFromUint64(i), 3)
+		if e != nil {
+			t.Fatalf("%d: unexpected error %v", i, e)
+		}
+		all = append(all, fmt.Sprint(p))
+		idx, e := PermutationIndex(p)
+		if e != nil || idx.Uint64() != i {
+			t.Errorf("%v: expected %d, got %v, %v", p, i, idx, e)
+		}
+	}
+	if fmt.Sprint(all) != "[[0 1 2] [0 2 1] [1 0 2] [1 2 0] [2 0 1] [2 1 0]]" {
+		t.Errorf("unexpected order %v", all)
+	}
+
+	// the last permutation of 20 elements
+	p, _ := Permutation(numbers.NFromUint64(2432902008176639999), 20)
+	if p[0] != 19 || p[19] != 0 {
+		t.Errorf("unexpected %v", p)
+	}
+	// index fits uint64 even for more elements
+	p, _ = Permutation(numbers.NFromUint64(1), 25)
+	if idx, e := PermutationIndex(p); e != nil || idx.Uint64() != 1 {
+		t.Errorf("%v: expected 1, got %v, %v", p, idx, e)
+	}
+	if p, e := Permutation(numbers.NFromUint64(0), 0); e != nil || len(p) != 0 {
+		t.Errorf("expected empty permutation, got %v, %v", p, e)
+	}
+	for _, c := range [][2]int{{6, 3}, {1, 0}, {0, -1}} {
+		if _, e := Permutation(numbers.NFromUint64(uint64(c[0])), c[1]); e == nil {
+			t.Errorf("%d of %d: expected error", c[0], c[1])
+		}
+	}
+	expected := "index 6 of a permutation of 3 elements must be less than 3!"
+	if _, e := Permutation(numbers.NFromUint64(6), 3); e == nil || e.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, e)
+	}
+	reversed := make([]int, 21)
+	for i := range reversed {
+		reversed[i] = 20 - i
+	}
+	if _, e := PermutationIndex(reversed); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package sequences generates well known integer sequences and numeral systems based on them
+package sequences
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Fibonacci returns lazy iterator over Fibonacci numbers F(0) = 0, F(1) = 1, F(n) = F(n-1) + F(n-2): 0, 1, 1, 2, 3, 5,
+// 8, ... It ends at F(93), the largest one fitting uint64.
+func Fibonacci() iter.Seq[*numbers.N] {
+	return func(yield func(*numbers.N) bool) {
+		a, b := uint64(0), uint64(1)
+		for overflow := false; ; {
+			if !yield(numbers.NFromUint64(a)) || overflow {
+				return
+			}
+			sum, carry := bits.Add64(a, b, 0)
+			a, b, overflow = b, sum, carry != 0
+		}
+	}
+}
+
+// fibonacci returns F(2), F(3), ... not greater than v: 1, 2, 3, 5, ...
+func fibonacci(v uint64) []uint64 {
+	res := make([]uint64, 0)
+	for f := range Fibonacci() {
+		if f.Uint64() > v {
+			break
+		}
+		if f.Uint64() > 0 && (len(res) == 0 || res[len(res)-1] != f.Uint64()) {
+			res = append(res, f.Uint64())
+		}
+	}
+	return res
+}
+
+// Zeckendorf returns n in Zeckendorf representation - every n is a unique sum of non-consecutive Fibonacci numbers
+// F(k), k >= 2. The digits say which of ..., 8, 5, 3, 2, 1 are used (the most significant first): 4 = 3 + 1 is "101",
+// 12 = 8 + 3 + 1 is "10101" and 0 is "0". There are never two consecutive 1s.
+//
+// The representation is greedy - the largest Fibonacci number not greater than n is always used.
+func Zeckendorf(n *numbers.N) string {
+	v := n.Uint64()
+	if v == 0 {
+		return "0"
+	}
+	var sb strings.Builder
+	for _, f := range slices.Backward(fibonacci(v)) {
+		if f <= v {
+			v -= f
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return strings.TrimLeft(sb.String(), "0")
+}
+
+// ZeckendorfTerms returns the Fibonacci numbers summing to n in its Zeckendorf representation, the largest first
+func ZeckendorfTerms(n *numbers.N) []*numbers.N {
+	v := n.Uint64()
+	res := make([]*numbers.N, 0)
+	for _, f := range slices.Backward(fibonacci(v)) {
+		if f <= v {
+			v -= f
+			res = append(res, numbers.NFromUint64(f))
+		}
+	}
+	return res
+}
+
+// ParseZeckendorf returns ℕ written in Zeckendorf representation (see Zeckendorf). An error is returned for other
+// characters than 0 and 1, for consecutive 1s (which wouldn't be unique) and for values not fitting uint64.
+func ParseZeckendorf(digits string) (*numbers.N, error) {
+	if digits == "" {
+		return nil, errors.New("empty Zeckendorf representation")
+	}
+	if strings.Contains(digits, "11") {
+		return nil, errors.New("Zeckendorf representation can't contain consecutive 1s")
+	}
+	digits = strings.TrimLeft(digits, "0")
+	fs := fibonacci(math.MaxUint64)
+	if len(digits) > len(fs) {
+		return nil, numbers.ErrOverflow
+	}
+	v := uint64(0)
+	for i, c := range digits {
+		switch c {
+		case '1':
+			f := fs[len(digits)-1-i]
+			if v > math.MaxUint64-f {
+				return nil, numbers.ErrOverflow
+			}
+			v += f
+		case '0':
+		default:
+			return nil, errors.New("Zeckendorf representation can contain only 0 and 1")
+		}
+	}
+	return numbers.NFromUint64(v), nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sequences
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestFibonacci(t *testing.T) {
+	var s []string
+	count := 0
+	var last *numbers.N
+	for f := range Fibonacci() {
+		if count < 10 {
+			s = append(s, f.String())
+		}
+		count++
+		last = f
+	}
+	if strings.Join(s, " ") != "0 1 1 2 3 5 8 13 21 34" {
+		t.Errorf("unexpected %v", s)
+	}
+	if count != 94 || last.Uint64() != 12200160415121876738 {
+		t.Errorf("expected F(93) = 12200160415121876738 last, got F(%d) = %s", count-1, last)
+	}
+}
+
+func TestZeckendorf(t *testing.T) {
+	for v, exp := range map[uint64]string{
+		0: "0", 1: "1", 2: "10", 3: "100", 4: "101", 5: "1000", 6: "1001", 7: "1010", 12: "10101", 100: "1000010100",
+	} {
+		if z := Zeckendorf(numbers.NFromUint64(v)); z != exp {
+			t.Errorf("%d: expected %q, got %q", v, exp, z)
+		}
+	}
+	for v := range uint64(2000) {
+		z := Zeckendorf(numbers.NFromUint64(v))
+		if strings.Contains(z, "11") {
+			t.Fatalf("%d: consecutive 1s in %q", v, z)
+		}
+		n, e := ParseZeckendorf(z)
+		if e != nil || n.Uint64() != v {
+			t.Fatalf("%q: expected %d, got %v, %v", z, v, n, e)
+		}
+	}
+	z := Zeckendorf(numbers.NFromUint64(math.MaxUint64))
+	if n, e := ParseZeckendorf(z); e != nil || n.Uint64() != math.MaxUint64 {
+		t.Errorf("%q: expected %d, got %v, %v", z, uint64(math.MaxUint64), n, e)
+	}
+}
+
+func TestZeckendorfTerms(t *testing.T) {
+	var s []string
+	for _, f := range ZeckendorfTerms(numbers.NFromUint64(100)) {
+		s = append(s, f.String())
+	}
+	if strings.Join(s, " + ") != "89 + 8 + 3" {
+		t.Errorf("unexpected %v", s)
+	}
+	if len(ZeckendorfTerms(numbers.NFromUint64(0))) != 0 {
+		t.Errorf("expected no terms for 0")
+	}
+}
+
+func TestParseZeckendorf(t *testing.T) {
+	for s, exp := range map[string]uint64{"0": 0, "00101": 4, "1000010100": 100} {
+		n, e := ParseZeckendorf(s)
+		if e != nil || n.Uint64() != exp {
+			t.Errorf("%q: expected %d, got %v, %v", s, exp, n, e)
+		}
+	}
+	for _, s := range []string{"", "11", "102", "1" + strings.Repeat("0", 100), strings.Repeat("10", 46)} {
+		if _, e := ParseZeckendorf(s); e == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
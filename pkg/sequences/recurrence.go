@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sequences
+
+import (
+	"errors"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// LinearRecurrence is a sequence defined by linear homogeneous recurrence with constant coefficients in ℚ:
+// a(n) = c1*a(n-1) + c2*a(n-2) + ... + ck*a(n-k), starting with a(0), ..., a(k-1)
+type LinearRecurrence struct {
+	coefficients []*numbers.Q
+	initial      []*numbers.Q
+}
+
+// NewLinearRecurrence creates recurrence with coefficients c1, ..., ck and initial terms a(0), ..., a(k-1): Fibonacci
+// numbers have coefficients 1, 1 and initial terms 0, 1
+func NewLinearRecurrence(coefficients []*numbers.Q, initial []*numbers.Q) (*LinearRecurrence, error) {
+	if len(coefficients) == 0 {
+		return nil, errors.New("recurrence needs at least one coefficient")
+	}
+	if len(coefficients) != len(initial) {
+		return nil, errors.New("recurrence of order k needs k initial terms")
+	}
+	return &LinearRecurrence{
+		coefficients: append([]*numbers.Q(nil), coefficients...),
+		initial:      append([]*numbers.Q(nil), initial...),
+	}, nil
+}
+
+// Term returns a(n) exactly, in O(k^3 log n) operations.
+//
+// The state [a(n), a(n-1), ..., a(n-k+1)] is multiplied by companion matrix M - the coefficients in the first row
+// and 1s below the diagonal - to get the next state, so a(n) is the first element of M^(n-k+1) * [a(k-1), ..., a(0)].
+// M^m is computed by repeated squaring. ErrOverflow is returned if any intermediate value doesn't fit ℚ.
+func (r *LinearRecurrence) Term(n *numbers.N) (*numbers.Q, error) {
+	k := uint64(len(r.coefficients))
+	if n.Uint64() < k {
+		return r.initial[n.Uint64()], nil
+	}
+	m := newMatrix(int(k))
+	copy(m[0], r.coefficients)
+	for i := 1; i < int(k); i++ {
+		m[i][i-1] = numbers.OneQ()
+	}
+	p, e := m.power(n.Uint64() - k + 1)
+	if e != nil {
+		return nil, e
+	}
+	res := numbers.ZeroQ()
+	for j := range p[0] {
+		t, e := p[0][j].MultiplyChecked(r.initial[int(k)-1-j])
+		if e != nil {
+			return nil, e
+		}
+		if res, e = res.AddChecked(t); e != nil {
+			return nil, e
+		}
+	}
+	return res, nil
+}
+
+// matrix is a square matrix of ℚ
+type matrix [][]*numbers.Q
+
+// newMatrix returns k x k ZERO matrix
+func newMatrix(k int) matrix {
+	m := make(matrix, k)
+	for i := range m {
+		m[i] = make([]*numbers.Q, k)
+		for j := range m[i] {
+			m[i][j] = numbers.ZeroQ()
+		}
+	}
+	return m
+}
+
+// multiply returns m * arg
+func (m matrix) multiply(arg matrix) (matrix, error) {
+	res := newMatrix(len(m))
+	for i := range m {
+		for j := range m {
+			for l := range m {
+				t, e := m[i][l].MultiplyChecked(arg[l][j])
+				if e != nil {
+					return nil, e
+				}
+				if res[i][j], e = res[i][j].AddChecked(t); e != nil {
+					return nil, e
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// power returns m^e by repeated squaring: m^(2j) = (m^j)^2 and m^(2j+1) = m * m^(2j)
+func (m matrix) power(exp uint64) (matrix, error) {
+	res := newMatrix(len(m))
+	for i := range res {
+		res[i][i] = numbers.OneQ()
+	}
+	base := m
+	for exp > 0 {
+		var e error
+		if exp%2 == 1 {
+			if res, e = res.multiply(base); e != nil {
+				return nil, e
+			}
+		}
+		if exp /= 2; exp > 0 {
+			if base, e = base.multiply(base); e != nil {
+				return nil, e
+			}
+		}
+	}
+	return res, nil
+}
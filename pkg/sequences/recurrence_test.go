@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sequences
+
+import (
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func qs(values ...string) []*numbers.Q {
+	res := make([]*numbers.Q, len(values))
+	for i, v := range values {
+		res[i] = numbers.NewQ(v)
+	}
+	return res
+}
+
+func TestLinearRecurrence(t *testing.T) {
+	fib, _ := NewLinearRecurrence(qs("1/1", "1/1"), qs("0/1", "1/1"))
+	i := uint64(0)
+	for f := range Fibonacci() {
+		if i == 93 {
+			// F(93) fits uint64, but not int64 of ℚ
+			break
+		}
+		v, e := fib.Term(numbers.NFromUint64(i))
+		if e != nil || v.String() != f.String()+"/1" {
+			t.Fatalf("F(%d): expected %s, got %v, %v", i, f, v, e)
+		}
+		i++
+	}
+
+	for _, c := range []struct {
+		coefficients, initial []*numbers.Q
+		n                     uint64
+		exp                   string
+	}{
+		// tribonacci
+		{qs("1/1", "1/1", "1/1"), qs("0/1", "0/1", "1/1"), 37, "1132436852/1"},
+		// Pell numbers
+		{qs("2/1", "1/1"), qs("0/1", "1/1"), 10, "2378/1"},
+		// a(n) = -a(n-2) is periodic
+		{qs("0/1", "-1/1"), qs("1/1", "0/1"), 1000000000000000002, "-1/1"},
+		// the mean of two previous terms approaches 2/3 of the way from a(0) to a(1)
+		{qs("1/2", "1/2"), qs("0/1", "1/1"), 10, "341/512"},
+		// geometric
+		{qs("3/2"), qs("2/1"), 5, "243/16"},
+		{qs("3/2"), qs("2/1"), 0, "2/1"},
+	} {
+		r, e := NewLinearRecurrence(c.coefficients, c.initial)
+		if e != nil {
+			t.Errorf("unexpected error %v", e)
+			continue
+		}
+		v, e := r.Term(numbers.NFromUint64(c.n))
+		if e != nil || v.String() != c.exp {
+			t.Errorf("a(%d): expected %s, got %v, %v", c.n, c.exp, v, e)
+		}
+	}
+
+	if _, e := fib.Term(numbers.NFromUint64(200)); e != numbers.ErrOverflow {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := NewLinearRecurrence(qs(), qs()); e == nil {
+		t.Errorf("expected error for no coefficients")
+	}
+	if _, e := NewLinearRecurrence(qs("1/1"), qs("1/1", "2/1")); e == nil {
+		t.Errorf("expected error for wrong number of initial terms")
+	}
+}
@@ -0,0 +1,297 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sets
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+	"github.com/grgrzybek/gomath/pkg/primes"
+)
+
+// MaxSubMultisets is the maximum number of sub-multisets SubMultisets returns
+const MaxSubMultisets = 1 << 16
+
+// MaxProductMultiplicity is the maximum multiplicity of an element other than 0, 1 and -1 accepted by Product - the
+// powers are calculated exactly, so they're limited to few hundred kilobits
+const MaxProductMultiplicity = 1 << 12
+
+// Multiset (bag) is an immutable finite collection of rational numbers, where an element may occur more than once.
+//
+// Prime factorization of n is the multiset of its primes - 12 is {2×2, 3} - so multiset operations are arithmetic:
+// Sum is the product of numbers, Union is their LCM, Intersection their GCD, sub-multisets are the divisors and
+// IsSubMultiset is divisibility.
+type Multiset struct {
+	// distinct elements sorted in increasing order
+	elements []*numbers.Q
+	// counts[i] > 0 is the multiplicity of elements[i]
+	counts []uint64
+
+	fmt.Stringer
+}
+
+// MultisetOf returns the multiset of given elements, each occurrence counted
+func MultisetOf(elements ...*numbers.Q) *Multiset {
+	sorted := slices.Clone(elements)
+	slices.SortFunc(sorted, numbers.CompareQ)
+	res := &Multiset{}
+	for _, q := range sorted {
+		if last := len(res.elements) - 1; last >= 0 && res.elements[last].Compare(q) == 0 {
+			res.counts[last]++
+		} else {
+			res.elements = append(res.elements, q)
+			res.counts = append(res.counts, 1)
+		}
+	}
+	return res
+}
+
+// MultisetOfZ returns the multiset of given integers, each occurrence counted
+func MultisetOfZ(elements ...*numbers.Z) *Multiset {
+	qs := make([]*numbers.Q, len(elements))
+	for i, z := range elements {
+		qs[i] = numbers.DefQ(z, numbers.ZFromInt64(1))
+	}
+	return MultisetOf(qs...)
+}
+
+// PrimeFactors returns prime factorization of n > 0 as multiset of primes - ∅ for 1
+func PrimeFactors(n *numbers.N) (*Multiset, error) {
+	factors, e := primes.Factorize(n)
+	if e != nil {
+		return nil, e
+	}
+	res := &Multiset{}
+	for _, f := range factors {
+		p, _ := f.Prime.Int64()
+		res.elements = append(res.elements, numbers.DefQ(numbers.ZFromInt64(p), numbers.ZFromInt64(1)))
+		res.counts = append(res.counts, uint64(f.Exponent))
+	}
+	return res, nil
+}
+
+// Multiplicity returns the number of occurrences of q in m - ZERO if it's not an element
+func (m *Multiset) Multiplicity(q *numbers.Q) *numbers.N {
+	if i, found := slices.BinarySearchFunc(m.elements, q, numbers.CompareQ); found {
+		return numbers.NFromUint64(m.counts[i])
+	}
+	return numbers.ZeroN()
+}
+
+// Contains checks if q occurs in m at least once
+func (m *Multiset) Contains(q *numbers.Q) bool {
+	_, found := slices.BinarySearchFunc(m.elements, q, numbers.CompareQ)
+	return found
+}
+
+// Cardinality returns the number of elements of m with their multiplicities
+func (m *Multiset) Cardinality() (*numbers.N, error) {
+	total := uint64(0)
+	for _, c := range m.counts {
+		var carry uint64
+		if total, carry = bits.Add64(total, c, 0); carry != 0 {
+			return nil, numbers.ErrOverflow
+		}
+	}
+	return numbers.NFromUint64(total), nil
+}
+
+// Support returns the set of distinct elements of m
+func (m *Multiset) Support() *Set {
+	return &Set{elements: slices.Clone(m.elements)}
+}
+
+// IsEmpty checks if m has no elements
+func (m *Multiset) IsEmpty() bool {
+	return len(m.elements) == 0
+}
+
+// Equal checks if m and t have the same elements with the same multiplicities
+func (m *Multiset) Equal(t *Multiset) bool {
+	return slices.Equal(m.counts, t.counts) &&
+		slices.EqualFunc(m.elements, t.elements, func(a, b *numbers.Q) bool { return a.Compare(b) == 0 })
+}
+
+// IsSubMultiset checks if every element of m occurs in t at least as many times as in m
+func (m *Multiset) IsSubMultiset(t *Multiset) bool {
+	for i, q := range m.elements {
+		if m.counts[i] > t.Multiplicity(q).Uint64() {
+			return false
+		}
+	}
+	return true
+}
+
+// Sum returns m ⊎ t - multiplicities are added. numbers.ErrOverflow is returned if a multiplicity doesn't fit ℕ.
+func (m *Multiset) Sum(t *Multiset) (*Multiset, error) {
+	overflow := false
+	res := m.merge(t, func(a, b uint64) uint64 {
+		sum, carry := bits.Add64(a, b, 0)
+		overflow = overflow || carry != 0
+		return sum
+	})
+	if overflow {
+		return nil, numbers.ErrOverflow
+	}
+	return res, nil
+}
+
+// Union returns m ∪ t - the larger of multiplicities
+func (m *Multiset) Union(t *Multiset) *Multiset {
+	return m.merge(t, func(a, b uint64) uint64 { return max(a, b) })
+}
+
+// Intersection returns m ∩ t - the smaller of multiplicities
+func (m *Multiset) Intersection(t *Multiset) *Multiset {
+	return m.merge(t, func(a, b uint64) uint64 { return min(a, b) })
+}
+
+// Difference returns m \ t - multiplicities of t are subtracted from multiplicities of m, but not below ZERO
+func (m *Multiset) Difference(t *Multiset) *Multiset {
+	return m.merge(t, func(a, b uint64) uint64 {
+		if a < b {
+			return 0
+		}
+		return a - b
+	})
+}
+
+// merge combines multiplicities of each element of m or t (ZERO if it's missing in one of them) and keeps the
+// elements with non-ZERO result
+func (m *Multiset) merge(t *Multiset, combine func(a, b uint64) uint64) *Multiset {
+	res := &Multiset{}
+	keep := func(q *numbers.Q, c uint64) {
+		if c > 0 {
+			res.elements = append(res.elements, q)
+			res.counts = append(res.counts, c)
+		}
+	}
+	i, j := 0, 0
+	for i < len(m.elements) || j < len(t.elements) {
+		c := 0
+		switch {
+		case i == len(m.elements):
+			c = 1
+		case j == len(t.elements):
+			c = -1
+		default:
+			c = m.elements[i].Compare(t.elements[j])
+		}
+		switch {
+		case c < 0:
+			keep(m.elements[i], combine(m.counts[i], 0))
+			i++
+		case c > 0:
+			keep(t.elements[j], combine(0, t.counts[j]))
+			j++
+		default:
+			keep(m.elements[i], combine(m.counts[i], t.counts[j]))
+			i++
+			j++
+		}
+	}
+	return res
+}
+
+// Product returns the product of all elements with their multiplicities - the number m is the factorization of.
+// It's calculated exactly, so the powers may cancel each other - {1/2×100, 2×100} is 1. numbers.ErrOverflow is
+// returned if the product doesn't fit ℚ or when an element other than 0, 1 and -1 occurs more than
+// MaxProductMultiplicity times.
+func (m *Multiset) Product() (*numbers.Q, error) {
+	// ZERO may be preceded by elements whose powers don't fit 64 bits
+	if m.Contains(numbers.ZeroQ()) {
+		return numbers.ZeroQ(), nil
+	}
+	res := big.NewRat(1, 1)
+	for i, q := range m.elements {
+		a, b := q.Ratio()
+		k := m.counts[i]
+		if b == 1 && (a == 1 || a == -1) {
+			// the only powers which are small for any multiplicity
+			if a == -1 && k%2 == 1 {
+				res.Neg(res)
+			}
+			continue
+		}
+		if k > MaxProductMultiplicity {
+			return nil, fmt.Errorf("%w: %s occurs more than %d times", numbers.ErrOverflow, q, MaxProductMultiplicity)
+		}
+		e := new(big.Int).SetUint64(k)
+		num := new(big.Int).Exp(big.NewInt(a), e, nil)
+		den := new(big.Int).Exp(big.NewInt(b), e, nil)
+		res.Mul(res, new(big.Rat).SetFrac(num, den))
+	}
+	return numbers.QFromRat(res)
+}
+
+// SubMultisets returns all sub-multisets of m - for prime factorization of n they are factorizations of its divisors.
+// Sub-multiset takes 0..k occurrences of each element with multiplicity k, so there are (k1 + 1) · ... · (kr + 1) of
+// them, from ∅ to m itself, ordered like digits of a mixed radix number with the first element changing fastest.
+func (m *Multiset) SubMultisets() ([]*Multiset, error) {
+	count := uint64(1)
+	for _, c := range m.counts {
+		if c >= MaxSubMultisets || count*(c+1) > MaxSubMultisets {
+			return nil, fmt.Errorf("%s has more than %d sub-multisets", m, MaxSubMultisets)
+		}
+		count *= c + 1
+	}
+	res := make([]*Multiset, 0, count)
+	taken := make([]uint64, len(m.counts))
+	for {
+		sub := &Multiset{}
+		for i, k := range taken {
+			if k > 0 {
+				sub.elements = append(sub.elements, m.elements[i])
+				sub.counts = append(sub.counts, k)
+			}
+		}
+		res = append(res, sub)
+
+		i := 0
+		for i < len(taken) && taken[i] == m.counts[i] {
+			taken[i] = 0
+			i++
+		}
+		if i == len(taken) {
+			return res, nil
+		}
+		taken[i]++
+	}
+}
+
+// String formats m as "{-1, 1/2, 2×3}" - element with multiplicity k > 1 is followed by "×k" - or "∅"
+func (m *Multiset) String() string {
+	if len(m.elements) == 0 {
+		return "∅"
+	}
+	parts := make([]string, len(m.elements))
+	for i, q := range m.elements {
+		parts[i] = format(q)
+		if m.counts[i] > 1 {
+			parts[i] += fmt.Sprintf("×%d", m.counts[i])
+		}
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+var _ = fmt.Stringer(&Multiset{})
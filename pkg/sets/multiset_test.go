@@ -0,0 +1,184 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sets
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func bag(vs ...int64) *Multiset {
+	res := make([]*numbers.Z, len(vs))
+	for i, v := range vs {
+		res[i] = numbers.ZFromInt64(v)
+	}
+	return MultisetOfZ(res...)
+}
+
+func integer(v int64) *numbers.Q {
+	res, _ := numbers.QFromInts(v, 1)
+	return res
+}
+
+func factors(t *testing.T, n uint64) *Multiset {
+	m, e := PrimeFactors(numbers.NFromUint64(n))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return m
+}
+
+func TestMultisetOf(t *testing.T) {
+	m := MultisetOf(q("2/1"), q("1/2"), q("4/2"), q("-1/1"), q("2/1"), q("2/4"))
+	if m.String() != "{-1, 1/2×2, 2×3}" {
+		t.Errorf("unexpected %s", m)
+	}
+	if m.Multiplicity(q("2/1")).Uint64() != 3 || m.Multiplicity(q("3/1")).Uint64() != 0 || !m.Contains(q("-1/1")) {
+		t.Error("unexpected multiplicities")
+	}
+	if size, _ := m.Cardinality(); size.Uint64() != 6 {
+		t.Errorf("expected 6 elements, got %s", size)
+	}
+	if m.Support().String() != "{-1, 1/2, 2}" {
+		t.Errorf("unexpected support %s", m.Support())
+	}
+	if MultisetOf().String() != "∅" || !MultisetOf().IsEmpty() {
+		t.Error("unexpected empty multiset")
+	}
+}
+
+func TestMultisetOperations(t *testing.T) {
+	a, b := bag(1, 1, 2, 3, 3, 3), bag(1, 3, 3, 4)
+	sum, e := a.Sum(b)
+	if e != nil {
+		t.Fatal(e)
+	}
+	for _, c := range []struct {
+		name     string
+		got      *Multiset
+		expected string
+	}{
+		{"sum", sum, "{1×3, 2, 3×5, 4}"},
+		{"union", a.Union(b), "{1×2, 2, 3×3, 4}"},
+		{"intersection", a.Intersection(b), "{1, 3×2}"},
+		{"difference", a.Difference(b), "{1, 2, 3}"},
+		{"reverse difference", b.Difference(a), "{4}"},
+	} {
+		if c.got.String() != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, c.got)
+		}
+	}
+	if !bag(3, 1, 3).IsSubMultiset(a) || bag(3, 3, 3, 3).IsSubMultiset(a) || !MultisetOf().IsSubMultiset(b) {
+		t.Error("unexpected sub-multisets")
+	}
+	if !a.Equal(bag(3, 1, 3, 2, 1, 3)) || a.Equal(bag(1, 2, 3)) {
+		t.Error("unexpected equality")
+	}
+
+	huge := &Multiset{elements: []*numbers.Q{q("1/1")}, counts: []uint64{math.MaxUint64}}
+	if _, e := huge.Sum(bag(1)); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := huge.Sum(bag(2)); e != nil {
+		t.Errorf("unexpected %v", e)
+	}
+	if _, e := huge.Union(bag(1, 2)).Cardinality(); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
+
+func TestFactorizations(t *testing.T) {
+	a, b := factors(t, 360), factors(t, 84)
+	if a.String() != "{2×3, 3×2, 5}" || !factors(t, 1).IsEmpty() {
+		t.Errorf("unexpected factorization %s", a)
+	}
+	for _, c := range []struct {
+		name     string
+		got      *Multiset
+		expected uint64
+	}{
+		{"GCD", a.Intersection(b), 12},
+		{"LCM", a.Union(b), 2520},
+		{"quotient", a.Difference(factors(t, 12)), 30},
+	} {
+		if p, e := c.got.Product(); e != nil || p.Compare(integer(int64(c.expected))) != 0 {
+			t.Errorf("%s: expected %d, got %v (%v)", c.name, c.expected, p, e)
+		}
+	}
+	product, _ := a.Sum(b)
+	if p, _ := product.Product(); p.Compare(integer(360*84)) != 0 {
+		t.Errorf("unexpected product %s", p)
+	}
+	if !factors(t, 12).IsSubMultiset(a) || factors(t, 7).IsSubMultiset(a) {
+		t.Error("sub-multiset should mean divisibility")
+	}
+}
+
+func TestDivisors(t *testing.T) {
+	subs, e := factors(t, 12).SubMultisets()
+	if e != nil {
+		t.Fatal(e)
+	}
+	expected := []int64{1, 2, 4, 3, 6, 12}
+	if len(subs) != len(expected) {
+		t.Fatalf("expected %d divisors, got %v", len(expected), subs)
+	}
+	for i, sub := range subs {
+		if p, _ := sub.Product(); p.Compare(integer(expected[i])) != 0 {
+			t.Errorf("expected %d, got %s", expected[i], p)
+		}
+	}
+	huge := &Multiset{elements: []*numbers.Q{q("2/1")}, counts: []uint64{MaxSubMultisets}}
+	if _, e := huge.SubMultisets(); e == nil {
+		t.Error("expected error for too many sub-multisets")
+	}
+}
+
+func TestProduct(t *testing.T) {
+	for _, c := range []struct {
+		m        *Multiset
+		expected string
+	}{
+		{MultisetOf(), "1/1"},
+		{MultisetOf(q("1/2"), q("1/2"), q("3/1")), "3/4"},
+		{bag(-1, -1, -1, 5), "-5/1"},
+		{bag(0, 7, 7), "0/1"},
+		{&Multiset{elements: []*numbers.Q{q("-1/1")}, counts: []uint64{math.MaxUint64}}, "-1/1"},
+		// ZERO is found also after elements whose powers don't fit 64 bits
+		{&Multiset{elements: []*numbers.Q{q("-2/1"), q("0/1")}, counts: []uint64{70, 1}}, "0/1"},
+		{&Multiset{elements: []*numbers.Q{q("1/2"), q("2/1")}, counts: []uint64{100, 100}}, "1/1"},
+		{&Multiset{elements: []*numbers.Q{q("2/3"), q("3/1")}, counts: []uint64{62, 62}}, "4611686018427387904/1"},
+	} {
+		if p, e := c.m.Product(); e != nil || p.Compare(q(c.expected)) != 0 {
+			t.Errorf("%s: expected %s, got %v (%v)", c.m, c.expected, p, e)
+		}
+	}
+	for _, m := range []*Multiset{
+		{elements: []*numbers.Q{q("3/2")}, counts: []uint64{math.MaxUint64}},
+		{elements: []*numbers.Q{q("3/2")}, counts: []uint64{64}},
+		{elements: []*numbers.Q{q("1/2"), q("2/1")}, counts: []uint64{MaxProductMultiplicity + 1, 1}},
+	} {
+		if _, e := m.Product(); !errors.Is(e, numbers.ErrOverflow) {
+			t.Errorf("%s: expected overflow, got %v", m, e)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package sets implements finite sets of numbers with the operations of set theory - union, intersection, difference,
+// Cartesian product and power set. Cardinalities are ℕ, so |A × B| = |A| · |B| and |P(A)| = 2^|A| tie set theory back
+// to the construction of numbers. Multiset counts repeated elements too, so prime factorizations and divisors are
+// multisets and sub-multisets of primes.
+package sets
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxCartesianProduct is the maximum number of pairs CartesianProduct returns
+const MaxCartesianProduct = 1 << 20
+
+// MaxPowerSetElements is the maximum number of elements of a set for PowerSet - it returns 2^n subsets
+const MaxPowerSetElements = 20
+
+// Set is an immutable finite set of rational numbers. ℤ is included in ℚ, so 2 and 2/1 are the same element.
+type Set struct {
+	// elements sorted in increasing order, without duplicates
+	elements []*numbers.Q
+
+	fmt.Stringer
+}
+
+// Pair is an element of Cartesian product - an ordered pair (First, Second)
+type Pair struct {
+	First  *numbers.Q
+	Second *numbers.Q
+}
+
+// String formats the pair as "(1, 1/2)"
+func (p Pair) String() string {
+	return fmt.Sprintf("(%s, %s)", format(p.First), format(p.Second))
+}
+
+// Empty returns the empty set ∅
+func Empty() *Set {
+	return &Set{}
+}
+
+// Of returns the set of given elements - duplicates are ignored
+func Of(elements ...*numbers.Q) *Set {
+	res := slices.Clone(elements)
+	slices.SortFunc(res, numbers.CompareQ)
+	return &Set{elements: slices.CompactFunc(res, func(a, b *numbers.Q) bool { return a.Compare(b) == 0 })}
+}
+
+// OfZ returns the set of given integers
+func OfZ(elements ...*numbers.Z) *Set {
+	qs := make([]*numbers.Q, len(elements))
+	for i, z := range elements {
+		qs[i] = numbers.DefQ(z, numbers.ZFromInt64(1))
+	}
+	return Of(qs...)
+}
+
+// Elements returns the elements of s in increasing order
+func (s *Set) Elements() []*numbers.Q {
+	return slices.Clone(s.elements)
+}
+
+// Cardinality returns the number of elements |s|
+func (s *Set) Cardinality() *numbers.N {
+	return numbers.NFromUint64(uint64(len(s.elements)))
+}
+
+// IsEmpty checks if s is ∅
+func (s *Set) IsEmpty() bool {
+	return len(s.elements) == 0
+}
+
+// Contains checks if q ∈ s
+func (s *Set) Contains(q *numbers.Q) bool {
+	_, found := slices.BinarySearchFunc(s.elements, q, numbers.CompareQ)
+	return found
+}
+
+// IsSubset checks if s ⊆ t
+func (s *Set) IsSubset(t *Set) bool {
+	return len(s.Difference(t).elements) == 0
+}
+
+// Equal checks if s and t have the same elements
+func (s *Set) Equal(t *Set) bool {
+	return slices.EqualFunc(s.elements, t.elements, func(a, b *numbers.Q) bool { return a.Compare(b) == 0 })
+}
+
+// Union returns s ∪ t - the elements of s or t
+func (s *Set) Union(t *Set) *Set {
+	return s.merge(t, true, true, true)
+}
+
+// Intersection returns s ∩ t - the elements of both s and t
+func (s *Set) Intersection(t *Set) *Set {
+	return s.merge(t, false, true, false)
+}
+
+// Difference returns s \ t - the elements of s which are not in t
+func (s *Set) Difference(t *Set) *Set {
+	return s.merge(t, true, false, false)
+}
+
+// merge walks the sorted elements of s and t together and keeps the elements only in s, in both or only in t
+func (s *Set) merge(t *Set, onlyS bool, both bool, onlyT bool) *Set {
+	var res []*numbers.Q
+	i, j := 0, 0
+	for i < len(s.elements) || j < len(t.elements) {
+		c := 0
+		switch {
+		case i == len(s.elements):
+			c = 1
+		case j == len(t.elements):
+			c = -1
+		default:
+			c = s.elements[i].Compare(t.elements[j])
+		}
+		switch {
+		case c < 0:
+			if onlyS {
+				res = append(res, s.elements[i])
+			}
+			i++
+		case c > 0:
+			if onlyT {
+				res = append(res, t.elements[j])
+			}
+			j++
+		default:
+			if both {
+				res = append(res, s.elements[i])
+			}
+			i++
+			j++
+		}
+	}
+	return &Set{elements: res}
+}
+
+// CartesianProduct returns s × t - all pairs (a, b) with a ∈ s and b ∈ t, sorted by a and then by b
+func (s *Set) CartesianProduct(t *Set) ([]Pair, error) {
+	size, e := s.ProductCardinality(t)
+	if e != nil {
+		return nil, e
+	}
+	if size.Uint64() > MaxCartesianProduct {
+		return nil, fmt.Errorf("%s × %s has more than %d pairs", s.Cardinality(), t.Cardinality(), MaxCartesianProduct)
+	}
+	res := make([]Pair, 0, size.Uint64())
+	for _, a := range s.elements {
+		for _, b := range t.elements {
+			res = append(res, Pair{First: a, Second: b})
+		}
+	}
+	return res, nil
+}
+
+// ProductCardinality returns |s × t| = |s| · |t| without building the product
+func (s *Set) ProductCardinality(t *Set) (*numbers.N, error) {
+	hi, lo := bits.Mul64(uint64(len(s.elements)), uint64(len(t.elements)))
+	if hi != 0 {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.NFromUint64(lo), nil
+}
+
+// PowerSet returns P(s) - all subsets of s. Subset i contains the elements of s at the positions of 1 bits of i, so
+// they go from ∅ to s itself.
+func (s *Set) PowerSet() ([]*Set, error) {
+	if len(s.elements) > MaxPowerSetElements {
+		return nil, fmt.Errorf("power set of %d elements has more than 2^%d subsets", len(s.elements),
+			MaxPowerSetElements)
+	}
+	res := make([]*Set, 1<<len(s.elements))
+	for i := range res {
+		var subset []*numbers.Q
+		for j, q := range s.elements {
+			if i&(1<<j) != 0 {
+				subset = append(subset, q)
+			}
+		}
+		res[i] = &Set{elements: subset}
+	}
+	return res, nil
+}
+
+// PowerSetCardinality returns |P(s)| = 2^|s| without building the power set - each element is either in a subset or
+// not. numbers.ErrOverflow is returned if it doesn't fit ℕ.
+func (s *Set) PowerSetCardinality() (*numbers.N, error) {
+	if len(s.elements) >= 64 {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.NFromUint64(1 << len(s.elements)), nil
+}
+
+// String formats s as "{-1, 1/2, 3}" or "∅"
+func (s *Set) String() string {
+	if len(s.elements) == 0 {
+		return "∅"
+	}
+	parts := make([]string, len(s.elements))
+	for i, q := range s.elements {
+		parts[i] = format(q)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// format formats q as "3" or "-1/2"
+func format(q *numbers.Q) string {
+	if a, b := q.Ratio(); b == 1 {
+		return fmt.Sprint(a)
+	}
+	return q.String()
+}
+
+var _ = fmt.Stringer(&Set{})
@@ -0,0 +1,162 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func q(v string) *numbers.Q {
+	return numbers.NewQ(v)
+}
+
+func z(vs ...int64) *Set {
+	res := make([]*numbers.Z, len(vs))
+	for i, v := range vs {
+		res[i] = numbers.ZFromInt64(v)
+	}
+	return OfZ(res...)
+}
+
+func TestOf(t *testing.T) {
+	s := Of(q("3/1"), q("1/2"), q("-1/1"), q("2/4"), q("6/2"))
+	if s.String() != "{-1, 1/2, 3}" || s.Cardinality().Uint64() != 3 {
+		t.Errorf("unexpected %s", s)
+	}
+	if !s.Contains(q("1/2")) || s.Contains(q("1/3")) {
+		t.Error("unexpected membership")
+	}
+	if !z(3, -1).Union(Of(q("1/2"))).Equal(s) {
+		t.Error("ℤ elements should equal ℚ ones")
+	}
+	if Empty().String() != "∅" || !Empty().IsEmpty() || Empty().Cardinality().Uint64() != 0 {
+		t.Error("unexpected empty set")
+	}
+	elements := s.Elements()
+	elements[0] = q("7/1")
+	if !s.Contains(q("-1/1")) {
+		t.Error("set changed through Elements")
+	}
+}
+
+func TestOperations(t *testing.T) {
+	a, b := z(1, 2, 3, 4), z(3, 4, 5)
+	for _, c := range []struct {
+		name     string
+		got      *Set
+		expected string
+	}{
+		{"union", a.Union(b), "{1, 2, 3, 4, 5}"},
+		{"intersection", a.Intersection(b), "{3, 4}"},
+		{"difference", a.Difference(b), "{1, 2}"},
+		{"reverse difference", b.Difference(a), "{5}"},
+		{"union with ∅", a.Union(Empty()), "{1, 2, 3, 4}"},
+		{"intersection with ∅", a.Intersection(Empty()), "∅"},
+		{"disjoint", z(1, 2).Intersection(z(3)), "∅"},
+	} {
+		if c.got.String() != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, c.got)
+		}
+	}
+	if !z(3, 4).IsSubset(a) || a.IsSubset(b) || !Empty().IsSubset(b) {
+		t.Error("unexpected subsets")
+	}
+}
+
+func TestInclusionExclusion(t *testing.T) {
+	sets := []*Set{Empty(), z(1), z(1, 2, 3), z(2, 3, 4, 5), Of(q("1/2"), q("1/3")), z(-5, 0, 5)}
+	for _, a := range sets {
+		for _, b := range sets {
+			// |A ∪ B| + |A ∩ B| = |A| + |B|
+			left := a.Union(b).Cardinality().Add(a.Intersection(b).Cardinality())
+			right := a.Cardinality().Add(b.Cardinality())
+			if left.Compare(right) != 0 {
+				t.Errorf("%s, %s: %s != %s", a, b, left, right)
+			}
+		}
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	a, b := z(1, 2), Of(q("1/2"), q("3/1"), q("-1/1"))
+	pairs, e := a.CartesianProduct(b)
+	if e != nil {
+		t.Fatal(e)
+	}
+	expected := []string{"(1, -1)", "(1, 1/2)", "(1, 3)", "(2, -1)", "(2, 1/2)", "(2, 3)"}
+	if len(pairs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, pairs)
+	}
+	for i := range pairs {
+		if pairs[i].String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], pairs[i])
+		}
+	}
+	size, _ := a.ProductCardinality(b)
+	if size.Compare(a.Cardinality().Multiply(b.Cardinality())) != 0 || size.Uint64() != 6 {
+		t.Errorf("unexpected |A × B| = %s", size)
+	}
+	if pairs, _ := a.CartesianProduct(Empty()); len(pairs) != 0 {
+		t.Errorf("expected no pairs, got %v", pairs)
+	}
+	big := make([]*numbers.Z, 1025)
+	for i := range big {
+		big[i] = numbers.ZFromInt64(int64(i))
+	}
+	if _, e := OfZ(big...).CartesianProduct(OfZ(big...)); e == nil {
+		t.Error("expected error for too big product")
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	subsets, e := z(1, 2, 3).PowerSet()
+	if e != nil {
+		t.Fatal(e)
+	}
+	expected := []string{"∅", "{1}", "{2}", "{1, 2}", "{3}", "{1, 3}", "{2, 3}", "{1, 2, 3}"}
+	for i := range expected {
+		if subsets[i].String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], subsets[i])
+		}
+	}
+	for _, s := range []*Set{Empty(), z(7), z(1, 2, 3, 4, 5)} {
+		subsets, _ := s.PowerSet()
+		size, e := s.PowerSetCardinality()
+		// |P(A)| = 2^|A|
+		if e != nil || size.Uint64() != uint64(len(subsets)) || size.Compare(numbers.TwoN().Power(s.Cardinality())) != 0 {
+			t.Errorf("%s: unexpected |P(A)| = %v (%v)", s, size, e)
+		}
+	}
+	many := make([]*numbers.Z, 64)
+	for i := range many {
+		many[i] = numbers.ZFromInt64(int64(i))
+	}
+	if _, e := OfZ(many[:MaxPowerSetElements+1]...).PowerSet(); e == nil {
+		t.Error("expected error for too big power set")
+	}
+	if size, e := OfZ(many[:63]...).PowerSetCardinality(); e != nil || size.Uint64() != 1<<63 {
+		t.Errorf("unexpected %v (%v)", size, e)
+	}
+	if _, e := OfZ(many...).PowerSetCardinality(); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package stats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Reduction is an exact operation folding a stream of numbers into one
+type Reduction int
+
+const (
+	// ReduceSum adds the numbers (0 for no numbers)
+	ReduceSum Reduction = iota
+	// ReduceProduct multiplies the numbers (1 for no numbers)
+	ReduceProduct
+	// ReduceMean calculates arithmetic mean of the numbers (ErrEmpty for no numbers)
+	ReduceMean
+)
+
+var reductionNames = []string{"sum", "product", "mean"}
+
+func (r Reduction) String() string {
+	if r < 0 || int(r) >= len(reductionNames) {
+		return "Reduction(?)"
+	}
+	return reductionNames[r]
+}
+
+// ParseReduction returns the reduction with given name, like "sum" - see Reduction.String. Case is ignored.
+func ParseReduction(name string) (Reduction, error) {
+	for r, n := range reductionNames {
+		if strings.EqualFold(n, name) {
+			return Reduction(r), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown reduction %q, expected one of %s", name, strings.Join(reductionNames, ", "))
+}
+
+// Reducer folds numbers one by one, so a stream doesn't have to be kept in memory
+type Reducer struct {
+	op    Reduction
+	acc   *numbers.Q
+	count int64
+}
+
+// NewReducer returns a reducer for given operation with no numbers added yet
+func NewReducer(op Reduction) (*Reducer, error) {
+	switch op {
+	case ReduceSum, ReduceMean:
+		return &Reducer{op: op, acc: numbers.ZeroQ()}, nil
+	case ReduceProduct:
+		return &Reducer{op: op, acc: numbers.OneQ()}, nil
+	}
+	return nil, fmt.Errorf("unknown reduction %s", op)
+}
+
+// Add folds the next number. numbers.ErrOverflow is returned when the exact result doesn't fit ℚ.
+func (r *Reducer) Add(q *numbers.Q) error {
+	var acc *numbers.Q
+	var e error
+	if r.op == ReduceProduct {
+		acc, e = r.acc.MultiplyChecked(q)
+	} else {
+		acc, e = r.acc.AddChecked(q)
+	}
+	if e != nil {
+		return e
+	}
+	r.acc = acc
+	r.count++
+	return nil
+}
+
+// Count returns how many numbers were added
+func (r *Reducer) Count() int64 {
+	return r.count
+}
+
+// Result returns the result for the numbers added so far
+func (r *Reducer) Result() (*numbers.Q, error) {
+	if r.op != ReduceMean {
+		return r.acc, nil
+	}
+	if r.count == 0 {
+		return nil, ErrEmpty
+	}
+	n, e := numbers.QFromInts(r.count, 1)
+	if e != nil {
+		return nil, e
+	}
+	return r.acc.Divide(n)
+}
+
+// maxField is the maximum length of a number read by ReduceReader
+const maxField = 1024
+
+// ReduceReader folds numbers read from in - fractions accepted by numbers.ParseQ ("1/3") or decimals accepted by
+// numbers.ParseScientific ("1.5", "2e-3") separated by whitespace or commas, so both CSV and plain lists of numbers
+// can be piped through it. The input is streamed number by number, so it may be one long line. Errors give the line
+// of the invalid number.
+func ReduceReader(in io.Reader, op Reduction) (*numbers.Q, error) {
+	r, e := NewReducer(op)
+	if e != nil {
+		return nil, e
+	}
+	reader := bufio.NewReader(in)
+	var field strings.Builder
+	line := 1
+	flush := func() error {
+		if field.Len() == 0 {
+			return nil
+		}
+		q, e := parseField(field.String())
+		if e == nil {
+			e = r.Add(q)
+		}
+		field.Reset()
+		if e != nil {
+			return fmt.Errorf("line %d: %w", line, e)
+		}
+		return nil
+	}
+	for {
+		c, _, e := reader.ReadRune()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			return nil, e
+		}
+		if c != ',' && !unicode.IsSpace(c) {
+			if field.Len() >= maxField {
+				return nil, fmt.Errorf("line %d: number longer than %d bytes", line, maxField)
+			}
+			field.WriteRune(c)
+			continue
+		}
+		if e := flush(); e != nil {
+			return nil, e
+		}
+		if c == '\n' {
+			line++
+		}
+	}
+	if e := flush(); e != nil {
+		return nil, e
+	}
+	return r.Result()
+}
+
+// parseField parses a fraction or a decimal number
+func parseField(f string) (*numbers.Q, error) {
+	if strings.Contains(f, "/") {
+		return numbers.ParseQ(f)
+	}
+	return numbers.ParseScientific(f)
+}
+
+// ReduceChannel folds numbers received from in until it's closed. The channel is drained even after an error, so the
+// sender never blocks, and the first error is returned.
+func ReduceChannel(in <-chan *numbers.Q, op Reduction) (*numbers.Q, error) {
+	r, e := NewReducer(op)
+	if e != nil {
+		return nil, e
+	}
+	var first error
+	for q := range in {
+		if first == nil {
+			first = r.Add(q)
+		}
+	}
+	if first != nil {
+		return nil, first
+	}
+	return r.Result()
+}
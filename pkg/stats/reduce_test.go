@@ -0,0 +1,128 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package stats
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestParseReduction(t *testing.T) {
+	for _, r := range []Reduction{ReduceSum, ReduceProduct, ReduceMean} {
+		if p, e := ParseReduction(strings.ToUpper(r.String())); e != nil || p != r {
+			t.Errorf("%s: got %s (%v)", r, p, e)
+		}
+	}
+	if _, e := ParseReduction("median"); e == nil {
+		t.Error("expected error")
+	}
+	if Reduction(7).String() != "Reduction(?)" {
+		t.Error("unexpected name")
+	}
+	if _, e := NewReducer(Reduction(7)); e == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestReduceReader(t *testing.T) {
+	input := "1/2, 1/3\n1/6\n\n  -1,2,,\t1\n"
+	for op, expected := range map[Reduction]string{ReduceSum: "3/1", ReduceProduct: "-1/18", ReduceMean: "1/2"} {
+		q, e := ReduceReader(strings.NewReader(input), op)
+		if e != nil || q.String() != expected {
+			t.Errorf("%s: expected %s, got %v (%v)", op, expected, q, e)
+		}
+	}
+}
+
+func TestReduceReaderDecimals(t *testing.T) {
+	q, e := ReduceReader(strings.NewReader("1.5, 2.5e1,-0.5\n3/4 0.25"), ReduceSum)
+	if e != nil || q.String() != "27/1" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+}
+
+func TestReduceReaderLongLine(t *testing.T) {
+	// much longer than bufio.Scanner's default token limit of 64 KiB
+	input := strings.Repeat("1.5,", 100000)
+	q, e := ReduceReader(strings.NewReader(input), ReduceSum)
+	if e != nil || q.String() != "150000/1" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+	if _, e := ReduceReader(strings.NewReader("1\n"+strings.Repeat("1", maxField+1)), ReduceSum); e == nil ||
+		!strings.HasPrefix(e.Error(), "line 2:") {
+		t.Errorf("expected error in line 2, got %v", e)
+	}
+}
+
+func TestReduceReaderEmpty(t *testing.T) {
+	if q, e := ReduceReader(strings.NewReader(""), ReduceSum); e != nil || q.String() != "0/1" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+	if q, e := ReduceReader(strings.NewReader(" \n"), ReduceProduct); e != nil || q.String() != "1/1" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+	if _, e := ReduceReader(strings.NewReader(""), ReduceMean); e != ErrEmpty {
+		t.Errorf("expected ErrEmpty, got %v", e)
+	}
+}
+
+func TestReduceReaderErrors(t *testing.T) {
+	if _, e := ReduceReader(strings.NewReader("1\n2 x 3"), ReduceSum); e == nil || !strings.HasPrefix(e.Error(), "line 2:") {
+		t.Errorf("expected error in line 2, got %v", e)
+	}
+	if _, e := ReduceReader(strings.NewReader("9223372036854775807 1"), ReduceSum); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if _, e := ReduceReader(iotest.ErrReader(errors.New("broken")), ReduceSum); e == nil || e.Error() != "broken" {
+		t.Errorf("expected read error, got %v", e)
+	}
+}
+
+func TestReduceChannel(t *testing.T) {
+	in := make(chan *numbers.Q)
+	go func() {
+		for i := int64(1); i <= 10; i++ {
+			q, _ := numbers.QFromInts(1, i*(i+1))
+			in <- q
+		}
+		close(in)
+	}()
+	// telescoping 1/(1·2) + 1/(2·3) + ... + 1/(10·11) = 1 - 1/11
+	if q, e := ReduceChannel(in, ReduceSum); e != nil || q.String() != "10/11" {
+		t.Errorf("unexpected %v (%v)", q, e)
+	}
+}
+
+func TestReduceChannelDrainsAfterError(t *testing.T) {
+	in := make(chan *numbers.Q)
+	big, _ := numbers.QFromInts(1<<40, 1)
+	go func() {
+		for range 5 {
+			in <- big
+		}
+		close(in)
+	}()
+	if _, e := ReduceChannel(in, ReduceProduct); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+}
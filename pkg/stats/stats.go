@@ -0,0 +1,175 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package stats calculates descriptive statistics of datasets in ℚ exactly - there's no rounding, so e.g. the mean of
+// 1/3, 1/3 and 1/3 is exactly 1/3 and variance of equal values is exactly 0.
+package stats
+
+import (
+	"errors"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+var (
+	// ErrEmpty is returned for statistics which are not defined for empty datasets
+	ErrEmpty = errors.New("dataset is empty")
+)
+
+// FromZ converts dataset of integers to dataset of rational numbers
+func FromZ(data []*numbers.Z) []*numbers.Q {
+	res := make([]*numbers.Q, len(data))
+	for i, z := range data {
+		res[i] = numbers.DefQ(z, numbers.OneZ())
+	}
+	return res
+}
+
+// Mean returns arithmetic mean (x1 + x2 + ... + xn) / n
+func Mean(data []*numbers.Q) (res *numbers.Q, e error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	defer recoverOverflow(&e)
+	sum, e := numbers.SumQ(data)
+	if e != nil {
+		return nil, e
+	}
+	return sum.Divide(count(data))
+}
+
+// WeightedMean returns (w1*x1 + w2*x2 + ... + wn*xn) / (w1 + w2 + ... + wn)
+func WeightedMean(data []*numbers.Q, weights []*numbers.Q) (res *numbers.Q, e error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	if len(data) != len(weights) {
+		return nil, errors.New("each value needs exactly one weight")
+	}
+	defer recoverOverflow(&e)
+	terms := make([]*numbers.Q, len(data))
+	for i := range data {
+		terms[i] = data[i].Multiply(weights[i])
+	}
+	sum, e := numbers.SumQ(terms)
+	if e != nil {
+		return nil, e
+	}
+	total, e := numbers.SumQ(weights)
+	if e != nil {
+		return nil, e
+	}
+	if total.Compare(numbers.ZeroQ()) == 0 {
+		return nil, errors.New("sum of weights is ZERO")
+	}
+	return sum.Divide(total)
+}
+
+// Median returns the middle value of sorted dataset or the mean of two middle values for even number of values
+func Median(data []*numbers.Q) (res *numbers.Q, e error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	defer recoverOverflow(&e)
+	sorted := make([]*numbers.Q, len(data))
+	copy(sorted, data)
+	numbers.SortQ(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle], nil
+	}
+	two, _ := numbers.QFromInts(2, 1)
+	return sorted[middle-1].Add(sorted[middle]).Divide(two)
+}
+
+// Mode returns all the values which occur most often, in increasing order
+func Mode(data []*numbers.Q) (res []*numbers.Q, e error) {
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	defer recoverOverflow(&e)
+	type key struct{ a, b int64 }
+	counts := make(map[key]int)
+	maxCount := 0
+	for _, q := range data {
+		a, b := q.Ratio()
+		k := key{a, b}
+		counts[k]++
+		if counts[k] > maxCount {
+			maxCount = counts[k]
+		}
+	}
+	for k, c := range counts {
+		if c == maxCount {
+			q, _ := numbers.QFromInts(k.a, k.b)
+			res = append(res, q)
+		}
+	}
+	numbers.SortQ(res)
+	return res, nil
+}
+
+// Variance returns population variance: mean of squared differences from the mean
+func Variance(data []*numbers.Q) (*numbers.Q, error) {
+	return variance(data, 0)
+}
+
+// SampleVariance returns sample variance: sum of squared differences from the mean divided by n-1 (Bessel's correction)
+func SampleVariance(data []*numbers.Q) (*numbers.Q, error) {
+	if len(data) == 1 {
+		return nil, errors.New("sample variance requires at least two values")
+	}
+	return variance(data, 1)
+}
+
+// variance divides sum of squared differences from the mean by n - correction
+func variance(data []*numbers.Q, correction int64) (res *numbers.Q, e error) {
+	mean, e := Mean(data)
+	if e != nil {
+		return nil, e
+	}
+	defer recoverOverflow(&e)
+	squares := make([]*numbers.Q, len(data))
+	for i, q := range data {
+		d := q.Subtract(mean)
+		squares[i] = d.Multiply(d)
+	}
+	sum, e := numbers.SumQ(squares)
+	if e != nil {
+		return nil, e
+	}
+	n, _ := numbers.QFromInts(int64(len(data))-correction, 1)
+	return sum.Divide(n)
+}
+
+// count returns the number of values as ℚ
+func count(data []*numbers.Q) *numbers.Q {
+	n, _ := numbers.QFromInts(int64(len(data)), 1)
+	return n
+}
+
+// recoverOverflow turns numbers.ErrOverflow panic of ℚ arithmetic into an error returned from the statistic
+func recoverOverflow(e *error) {
+	if r := recover(); r != nil {
+		if r != numbers.ErrOverflow {
+			panic(r)
+		}
+		*e = numbers.ErrOverflow
+	}
+}
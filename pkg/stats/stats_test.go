@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestMean(t *testing.T) {
+	check(t, "mean of 1/3, 1/3, 1/3", Mean, qs("1/3", "1/3", "1/3"), "1/3")
+	check(t, "mean of 1, 2, 3, 4", Mean, qs("1/1", "2/1", "3/1", "4/1"), "5/2")
+	check(t, "mean of 1/10 ten times", Mean, qs("1/10", "1/10", "1/10", "1/10", "1/10", "1/10", "1/10", "1/10",
+		"1/10", "1/10"), "1/10")
+	if _, e := Mean(nil); e != ErrEmpty {
+		t.Errorf("mean of empty dataset: expected ErrEmpty, got %v", e)
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	m, e := WeightedMean(qs("1/1", "2/1", "4/1"), qs("1/2", "1/4", "1/4"))
+	if e != nil || m.String() != "2/1" {
+		t.Errorf("weighted mean: expected 2/1, got %v (%v)", m, e)
+	}
+	if _, e = WeightedMean(qs("1/1"), qs("1/2", "1/2")); e == nil {
+		t.Errorf("weighted mean with wrong weights: expected error")
+	}
+	if _, e = WeightedMean(qs("1/1", "2/1"), qs("1/2", "-1/2")); e == nil {
+		t.Errorf("weighted mean with ZERO total weight: expected error")
+	}
+}
+
+func TestMedian(t *testing.T) {
+	check(t, "median of 5, 1, 3", Median, qs("5/1", "1/1", "3/1"), "3/1")
+	check(t, "median of 5, 1, 3, 2", Median, qs("5/1", "1/1", "3/1", "2/1"), "5/2")
+	check(t, "median of -1/2, 1/3", Median, qs("-1/2", "1/3"), "-1/12")
+}
+
+func TestMode(t *testing.T) {
+	m, e := Mode(qs("1/2", "2/4", "1/3", "3/1", "3/1", "-1/1"))
+	if e != nil || fmt.Sprintf("%s", m) != "[1/2 3/1]" {
+		t.Errorf("mode: expected [1/2 3/1], got %s (%v)", m, e)
+	}
+}
+
+func TestVariance(t *testing.T) {
+	check(t, "variance of 2, 4, 4, 4, 5, 5, 7, 9", Variance, qs("2/1", "4/1", "4/1", "4/1", "5/1", "5/1", "7/1", "9/1"),
+		"4/1")
+	check(t, "variance of 1/10 three times", Variance, qs("1/10", "1/10", "1/10"), "0/1")
+	check(t, "sample variance of 1, 2, 3, 4", SampleVariance, qs("1/1", "2/1", "3/1", "4/1"), "5/3")
+	if _, e := SampleVariance(qs("1/1")); e == nil {
+		t.Errorf("sample variance of one value: expected error")
+	}
+}
+
+func TestFromZ(t *testing.T) {
+	check(t, "mean of -3, 4", Mean, FromZ([]*numbers.Z{numbers.NewZ("-3"), numbers.NewZ("4")}), "1/2")
+}
+
+func TestOverflow(t *testing.T) {
+	big := numbers.ZFromInt64(math.MaxInt64)
+	if _, e := Variance(FromZ([]*numbers.Z{big, numbers.NewZ("-1")})); e != numbers.ErrOverflow {
+		t.Errorf("variance of MaxInt64, -1: expected overflow, got %v", e)
+	}
+}
+
+func qs(values ...string) []*numbers.Q {
+	res := make([]*numbers.Q, len(values))
+	for i, v := range values {
+		res[i] = numbers.NewQ(v)
+	}
+	return res
+}
+
+func check(t *testing.T, label string, f func([]*numbers.Q) (*numbers.Q, error), data []*numbers.Q, expected string) {
+	res, e := f(data)
+	if e != nil || res.String() != expected {
+		t.Errorf("%s: expected %s, got %v (%v)", label, expected, res, e)
+	}
+}
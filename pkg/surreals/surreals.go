@@ -0,0 +1,347 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package surreals constructs surreal numbers day by day and implements their comparison and addition.
+//
+// On day 0 there are no numbers yet, so the only form is { | } with empty left and right sets - it's 0. Each next day
+// creates the numbers {L | R} from sets L and R of the numbers created before, where no member of L is >= any member
+// of R. Day 1 creates -1 = { | 0} and 1 = {0 | }, day 2 creates -2, -1/2, 1/2 and 2, and so on - the numbers created in
+// finitely many days are exactly the integers and the dyadic rationals (with denominator 2^k), so this construction
+// subsumes ℤ and a part of ℚ.
+package surreals
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxDay is the latest day of creation of surreal numbers of this package
+const MaxDay = 64
+
+// MaxListedDay is the latest day for Day, which returns 2^(day+1) - 1 numbers
+const MaxListedDay = 16
+
+// Surreal is an immutable surreal number created on a finite day.
+//
+// Every form {L | R} equals exactly one number created earliest - the simplest one between L and R - and this number
+// is kept by the path to it in the tree of creation: each day a number x gets children {l | x} and {x | r} (for its
+// canonical form {l | r}), so the path is a sequence of "-" and "+" signs (the sign expansion). 0 is the empty path,
+// "++" is 2, "+-" is 1/2 and "+-+" is 3/4. The length of the path is the day of creation.
+type Surreal struct {
+	signs string
+
+	fmt.Stringer
+}
+
+// Zero returns 0 = { | } - the only number created on day 0
+func Zero() *Surreal {
+	return &Surreal{}
+}
+
+// New returns the number {L | R} - the simplest (earliest created) number greater than all members of left and less
+// than all members of right. It's an error if some member of left is >= some member of right, or if the number would
+// be created after MaxDay.
+func New(left []*Surreal, right []*Surreal) (*Surreal, error) {
+	for _, l := range left {
+		for _, r := range right {
+			if l.Compare(r) >= 0 {
+				return nil, fmt.Errorf("{%s | %s} is not a number, %s >= %s", list(left), list(right), l, r)
+			}
+		}
+	}
+	// the simplest number between L and R is found going down from 0 - if x isn't between L and R, they're all on one
+	// side of x, where all the numbers are descendants of x
+	var signs strings.Builder
+	x := Zero()
+	for {
+		var next byte
+		for _, l := range left {
+			if l.Compare(x) >= 0 {
+				next = '+'
+			}
+		}
+		for _, r := range right {
+			if r.Compare(x) <= 0 {
+				next = '-'
+			}
+		}
+		if next == 0 {
+			return x, nil
+		}
+		if signs.Len() == MaxDay {
+			return nil, fmt.Errorf("{%s | %s} is created after day %d", list(left), list(right), MaxDay)
+		}
+		signs.WriteByte(next)
+		x = &Surreal{signs: signs.String()}
+	}
+}
+
+// FromZ returns integer z as surreal number - n > 0 is {n - 1 | } created on day n, -n is { | -n + 1}
+func FromZ(z *numbers.Z) (*Surreal, error) {
+	return FromQ(numbers.DefQ(z, numbers.ZFromInt64(1)))
+}
+
+// FromQ returns dyadic rational q (with denominator 2^k) as surreal number. n + 1/2 is {n | n + 1}, n + 1/4 is
+// {n | n + 1/2} and so on.
+func FromQ(q *numbers.Q) (*Surreal, error) {
+	a, b := q.Ratio()
+	if b&(b-1) != 0 {
+		return nil, fmt.Errorf("%s is not a dyadic rational, it's not created on any finite day", q)
+	}
+	x := big.NewRat(a, b)
+	var signs strings.Builder
+	// integers first - 1, 2, 3, ... (or -1, -2, -3, ...) until x is reached or passed
+	v, one := new(big.Rat), big.NewRat(int64(x.Sign()), 1)
+	for x.Sign() != 0 && v.Cmp(x) == -x.Sign() && signs.Len() <= MaxDay {
+		v.Add(v, one)
+		signs.WriteByte(sign(x.Sign()))
+	}
+	// then halves, quarters, ... towards x
+	step := big.NewRat(1, 1)
+	for v.Cmp(x) != 0 && signs.Len() <= MaxDay {
+		step.Quo(step, big.NewRat(2, 1))
+		if x.Cmp(v) < 0 {
+			v.Sub(v, step)
+			signs.WriteByte('-')
+		} else {
+			v.Add(v, step)
+			signs.WriteByte('+')
+		}
+	}
+	if signs.Len() > MaxDay {
+		return nil, fmt.Errorf("%s is created after day %d", q, MaxDay)
+	}
+	return &Surreal{signs: signs.String()}, nil
+}
+
+// Day returns all numbers created until given day, in increasing order. Day n + 1 creates a number {x | y} in each gap
+// between consecutive numbers x < y of day n (and below the first and above the last one).
+func Day(day int) ([]*Surreal, error) {
+	if day < 0 || day > MaxListedDay {
+		return nil, fmt.Errorf("day has to be between 0 and %d", MaxListedDay)
+	}
+	res := []*Surreal{Zero()}
+	for range day {
+		next := make([]*Surreal, 0, 2*len(res)+1)
+		for i := 0; i <= len(res); i++ {
+			var left, right []*Surreal
+			if i > 0 {
+				left = res[i-1 : i]
+			}
+			if i < len(res) {
+				right = res[i : i+1]
+			}
+			x, e := New(left, right)
+			if e != nil {
+				return nil, e
+			}
+			next = append(next, x)
+			if i < len(res) {
+				next = append(next, res[i])
+			}
+		}
+		res = next
+	}
+	return res, nil
+}
+
+// Day returns the day on which x was created - the length of its sign expansion
+func (x *Surreal) Day() int {
+	return len(x.signs)
+}
+
+// Signs returns the sign expansion of x, like "+-+" for 3/4 - the path from 0 in the tree of creation
+func (x *Surreal) Signs() string {
+	return x.signs
+}
+
+// Left returns the left option of the canonical form {l | r} of x - the latest created number before x on its path,
+// which is less than x. ok is false if the left set is empty.
+func (x *Surreal) Left() (l *Surreal, ok bool) {
+	// a number on the path is less than x when the path goes on to the right ("+") from it
+	i := strings.LastIndexByte(x.signs, '+')
+	if i < 0 {
+		return nil, false
+	}
+	return &Surreal{signs: x.signs[:i]}, true
+}
+
+// Right returns the right option of the canonical form {l | r} of x. ok is false if the right set is empty.
+func (x *Surreal) Right() (r *Surreal, ok bool) {
+	i := strings.LastIndexByte(x.signs, '-')
+	if i < 0 {
+		return nil, false
+	}
+	return &Surreal{signs: x.signs[:i]}, true
+}
+
+// Compare returns -1 if x < y, 0 if x = y and +1 if x > y.
+//
+// By definition x <= y when no left option of x is >= y and no right option of y is <= x. For canonical forms this
+// means comparing sign expansions letter by letter, where "-" < (end of expansion) < "+" - the first difference shows
+// which side of their common ancestor x and y are.
+func (x *Surreal) Compare(y *Surreal) int {
+	for i := 0; ; i++ {
+		a, b := signAt(x.signs, i), signAt(y.signs, i)
+		if a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+		if a == 0 {
+			return 0
+		}
+	}
+}
+
+// Negate returns -x = {-R | -L}, which mirrors the tree of creation
+func (x *Surreal) Negate() *Surreal {
+	signs := []byte(x.signs)
+	for i, s := range signs {
+		if s == '+' {
+			signs[i] = '-'
+		} else {
+			signs[i] = '+'
+		}
+	}
+	return &Surreal{signs: string(signs)}
+}
+
+// Add returns x + y, defined recursively as x + y = {xL + y, x + yL | xR + y, x + yR} for all options of x and y. The
+// options are sums of numbers created earlier, so the recursion ends with 0 + 0 = { | }. An error is returned if the
+// sum is created after MaxDay.
+func (x *Surreal) Add(y *Surreal) (*Surreal, error) {
+	return add(x, y, map[[2]string]*Surreal{})
+}
+
+// add is Add which remembers sums of options - the same sums are needed again and again by the recursion
+func add(x *Surreal, y *Surreal, sums map[[2]string]*Surreal) (*Surreal, error) {
+	key := [2]string{x.signs, y.signs}
+	if s, ok := sums[key]; ok {
+		return s, nil
+	}
+	var left, right []*Surreal
+	for _, o := range []struct {
+		option  func(*Surreal) (*Surreal, bool)
+		options *[]*Surreal
+	}{{(*Surreal).Left, &left}, {(*Surreal).Right, &right}} {
+		if xo, ok := o.option(x); ok {
+			s, e := add(xo, y, sums)
+			if e != nil {
+				return nil, e
+			}
+			*o.options = append(*o.options, s)
+		}
+		if yo, ok := o.option(y); ok {
+			s, e := add(x, yo, sums)
+			if e != nil {
+				return nil, e
+			}
+			*o.options = append(*o.options, s)
+		}
+	}
+	s, e := New(left, right)
+	if e != nil {
+		return nil, e
+	}
+	sums[key] = s
+	return s, nil
+}
+
+// Q returns the value of x as ℚ. The first run of equal signs counts integers 1, 2, ... (or -1, -2, ...) and each
+// next sign adds or subtracts the next of 1/2, 1/4, ... numbers.ErrOverflow is returned if it doesn't fit ℚ.
+func (x *Surreal) Q() (*numbers.Q, error) {
+	v := x.rat()
+	if !v.Num().IsInt64() || !v.Denom().IsInt64() {
+		return nil, numbers.ErrOverflow
+	}
+	return numbers.QFromInts(v.Num().Int64(), v.Denom().Int64())
+}
+
+// rat returns the value of x
+func (x *Surreal) rat() *big.Rat {
+	run := 0
+	for run < len(x.signs) && x.signs[run] == x.signs[0] {
+		run++
+	}
+	v := new(big.Rat).SetInt64(int64(run))
+	if run > 0 && x.signs[0] == '-' {
+		v.Neg(v)
+	}
+	step := big.NewRat(1, 1)
+	for _, s := range []byte(x.signs[run:]) {
+		step.Quo(step, big.NewRat(2, 1))
+		if s == '+' {
+			v.Add(v, step)
+		} else {
+			v.Sub(v, step)
+		}
+	}
+	return v
+}
+
+// Form formats the canonical form of x, like "{1/2 | 1}" for 3/4 or "{ | }" for 0
+func (x *Surreal) Form() string {
+	l, r := "{", "}"
+	if left, ok := x.Left(); ok {
+		l += left.String()
+	}
+	if right, ok := x.Right(); ok {
+		r = right.String() + r
+	}
+	return l + " | " + r
+}
+
+// String formats the value of x, like "-2" or "3/4"
+func (x *Surreal) String() string {
+	return x.rat().RatString()
+}
+
+// signAt returns the order of i-th sign of the expansion: -1 for "-", 1 for "+" and 0 after the end
+func signAt(signs string, i int) int {
+	switch {
+	case i >= len(signs):
+		return 0
+	case signs[i] == '-':
+		return -1
+	}
+	return 1
+}
+
+// sign returns "+" for positive s and "-" otherwise
+func sign(s int) byte {
+	if s > 0 {
+		return '+'
+	}
+	return '-'
+}
+
+// list formats the options of a form, like "0, 1/2"
+func list(options []*Surreal) string {
+	s := make([]string, len(options))
+	for i, o := range options {
+		s[i] = o.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+var _ = fmt.Stringer(&Surreal{})
@@ -0,0 +1,192 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package surreals
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func s(t *testing.T, v string) *Surreal {
+	t.Helper()
+	x, e := FromQ(numbers.NewQ(v))
+	if e != nil {
+		t.Fatal(e)
+	}
+	return x
+}
+
+// leq is x <= y by definition: no left option of x is >= y and no right option of y is <= x
+func leq(x *Surreal, y *Surreal) bool {
+	if l, ok := x.Left(); ok && leq(y, l) {
+		return false
+	}
+	if r, ok := y.Right(); ok && leq(r, x) {
+		return false
+	}
+	return true
+}
+
+func TestDay(t *testing.T) {
+	for day, expected := range []string{
+		"0",
+		"-1 0 1",
+		"-2 -1 -1/2 0 1/2 1 2",
+		"-3 -2 -3/2 -1 -3/4 -1/2 -1/4 0 1/4 1/2 3/4 1 3/2 2 3",
+	} {
+		xs, e := Day(day)
+		if e != nil {
+			t.Fatal(e)
+		}
+		got := list(xs)
+		if got != strings.ReplaceAll(expected, " ", ", ") {
+			t.Errorf("day %d: expected %s, got %s", day, expected, got)
+		}
+	}
+	xs, _ := Day(MaxListedDay)
+	if len(xs) != 1<<(MaxListedDay+1)-1 || xs[len(xs)-1].Day() != MaxListedDay {
+		t.Errorf("unexpected day %d with %d numbers", MaxListedDay, len(xs))
+	}
+	if _, e := Day(MaxListedDay + 1); e == nil {
+		t.Error("expected error for too late day")
+	}
+}
+
+func TestForms(t *testing.T) {
+	for _, c := range []struct {
+		v, signs, form string
+	}{
+		{"0/1", "", "{ | }"},
+		{"1/1", "+", "{0 | }"},
+		{"-1/1", "-", "{ | 0}"},
+		{"2/1", "++", "{1 | }"},
+		{"1/2", "+-", "{0 | 1}"},
+		{"3/4", "+-+", "{1/2 | 1}"},
+		{"-5/8", "-+-+", "{-3/4 | -1/2}"},
+		{"7/2", "++++-", "{3 | 4}"},
+	} {
+		x := s(t, c.v)
+		if x.Signs() != c.signs || x.Form() != c.form || x.Day() != len(c.signs) {
+			t.Errorf("%s: expected %q %s, got %q %s", c.v, c.signs, c.form, x.Signs(), x.Form())
+		}
+		if q, e := x.Q(); e != nil || q.Compare(numbers.NewQ(c.v)) != 0 {
+			t.Errorf("%s: unexpected value %v (%v)", c.v, q, e)
+		}
+	}
+	for _, v := range []string{"1/3", "2/5"} {
+		if _, e := FromQ(numbers.NewQ(v)); e == nil {
+			t.Errorf("expected error for %s", v)
+		}
+	}
+	if _, e := FromZ(numbers.ZFromInt64(MaxDay + 1)); e == nil {
+		t.Error("expected error for too late day")
+	}
+	if x, e := FromZ(numbers.ZFromInt64(-MaxDay)); e != nil || x.Day() != MaxDay {
+		t.Errorf("unexpected %v (%v)", x, e)
+	}
+	tiny := &Surreal{signs: "+" + strings.Repeat("-", MaxDay-1)}
+	if _, e := tiny.Q(); !errors.Is(e, numbers.ErrOverflow) {
+		t.Errorf("expected overflow, got %v", e)
+	}
+	if tiny.String() != "1/9223372036854775808" {
+		t.Errorf("unexpected %s", tiny)
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, c := range []struct {
+		left, right []string
+		expected    string
+	}{
+		{nil, nil, "0"},
+		{[]string{"-1/1"}, []string{"1/1"}, "0"},
+		{[]string{"0/1", "1/2"}, []string{"3/1"}, "1"},
+		{[]string{"1/2"}, []string{"1/1"}, "3/4"},
+		{[]string{"1/1"}, nil, "2"},
+		{[]string{"5/8"}, []string{"3/4"}, "11/16"},
+		{nil, []string{"-3/1", "-2/1"}, "-4"},
+	} {
+		var left, right []*Surreal
+		for _, v := range c.left {
+			left = append(left, s(t, v))
+		}
+		for _, v := range c.right {
+			right = append(right, s(t, v))
+		}
+		x, e := New(left, right)
+		if e != nil || x.String() != c.expected {
+			t.Errorf("{%v | %v}: expected %s, got %v (%v)", c.left, c.right, c.expected, x, e)
+		}
+	}
+	if _, e := New([]*Surreal{s(t, "1/1")}, []*Surreal{s(t, "1/1")}); e == nil {
+		t.Error("{1 | 1} is not a number")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	xs, _ := Day(4)
+	for i, x := range xs {
+		for j, y := range xs {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			if c := x.Compare(y); c != expected || leq(x, y) != (expected <= 0) {
+				t.Errorf("%s vs %s: expected %d, got %d", x, y, expected, c)
+			}
+		}
+		if x.Negate().Compare(xs[len(xs)-1-i]) != 0 {
+			t.Errorf("unexpected -%s = %s", x, x.Negate())
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	xs, _ := Day(4)
+	for _, x := range xs {
+		for _, y := range xs {
+			sum, e := x.Add(y)
+			if e != nil {
+				t.Fatal(e)
+			}
+			qx, _ := x.Q()
+			qy, _ := y.Q()
+			if q, _ := sum.Q(); q.Compare(qx.Add(qy)) != 0 {
+				t.Errorf("%s + %s: expected %s, got %s", x, y, qx.Add(qy), sum)
+			}
+			if other, _ := y.Add(x); other.Compare(sum) != 0 {
+				t.Errorf("%s + %s != %s + %s", x, y, y, x)
+			}
+		}
+		if zero, _ := x.Add(x.Negate()); zero.Compare(Zero()) != 0 {
+			t.Errorf("%s + -%s = %s", x, x, zero)
+		}
+	}
+	if sum, e := s(t, "40/1").Add(s(t, "-81/4")); e != nil || sum.String() != "79/4" {
+		t.Errorf("expected 79/4, got %v (%v)", sum, e)
+	}
+	if _, e := s(t, "40/1").Add(s(t, "30/1")); e == nil {
+		t.Error("expected error for too late day")
+	}
+}
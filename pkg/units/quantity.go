@@ -0,0 +1,140 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package units
+
+import (
+	"fmt"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Quantity is exact magnitude of given unit, like 3/2 km. Values of Quantity are immutable.
+type Quantity struct {
+	magnitude *numbers.Q
+	unit      *Unit
+
+	fmt.Stringer
+}
+
+// New creates magnitude of unit
+func New(magnitude *numbers.Q, unit *Unit) *Quantity {
+	return &Quantity{magnitude: magnitude, unit: unit}
+}
+
+// Magnitude returns the number of units
+func (q *Quantity) Magnitude() *numbers.Q {
+	return q.magnitude
+}
+
+// Unit returns the unit of q
+func (q *Quantity) Unit() *Unit {
+	return q.unit
+}
+
+// In returns q converted to given unit, *DimensionError if it measures something else or ErrOverflow. The
+// conversion is exact: 1 mi is 63360 in.
+func (q *Quantity) In(unit *Unit) (*Quantity, error) {
+	f, e := q.unit.ConversionFactor(unit)
+	if e != nil {
+		return nil, e
+	}
+	m, e := q.magnitude.MultiplyChecked(f)
+	if e != nil {
+		return nil, e
+	}
+	return &Quantity{magnitude: m, unit: unit}, nil
+}
+
+// Add returns q + arg in the unit of q, *DimensionError if they measure different things or ErrOverflow
+func (q *Quantity) Add(arg *Quantity) (*Quantity, error) {
+	a, e := arg.In(q.unit)
+	if e != nil {
+		return nil, e
+	}
+	m, e := q.magnitude.AddChecked(a.magnitude)
+	if e != nil {
+		return nil, e
+	}
+	return &Quantity{magnitude: m, unit: q.unit}, nil
+}
+
+// Subtract returns q - arg in the unit of q, *DimensionError if they measure different things or ErrOverflow
+func (q *Quantity) Subtract(arg *Quantity) (*Quantity, error) {
+	a, e := arg.In(q.unit)
+	if e != nil {
+		return nil, e
+	}
+	m, e := q.magnitude.SubtractChecked(a.magnitude)
+	if e != nil {
+		return nil, e
+	}
+	return &Quantity{magnitude: m, unit: q.unit}, nil
+}
+
+// Multiply returns q * arg in the product unit (3 m * 2 s is 6 m·s) or ErrOverflow
+func (q *Quantity) Multiply(arg *Quantity) (*Quantity, error) {
+	u, e := q.unit.Multiply(arg.unit)
+	if e != nil {
+		return nil, e
+	}
+	m, e := q.magnitude.MultiplyChecked(arg.magnitude)
+	if e != nil {
+		return nil, e
+	}
+	return &Quantity{magnitude: m, unit: u}, nil
+}
+
+// Divide returns q / arg in the quotient unit (100 km / 2 h is 50 km/h), an error for ZERO magnitude of arg or
+// ErrOverflow
+func (q *Quantity) Divide(arg *Quantity) (*Quantity, error) {
+	u, e := q.unit.Divide(arg.unit)
+	if e != nil {
+		return nil, e
+	}
+	m, e := q.magnitude.Divide(arg.magnitude)
+	if e != nil {
+		return nil, e
+	}
+	return &Quantity{magnitude: m, unit: u}, nil
+}
+
+// Compare returns -1 if q < arg, 0 if q = arg and +1 if q > arg, whatever units they're in (1 in = 2.54 cm), or
+// *DimensionError if they measure different things
+func (q *Quantity) Compare(arg *Quantity) (int, error) {
+	if q.unit.dimension != arg.unit.dimension {
+		return 0, &DimensionError{From: q.unit.dimension, To: arg.unit.dimension}
+	}
+	// compared in SI units
+	a, e := q.magnitude.MultiplyChecked(q.unit.factor)
+	if e != nil {
+		return 0, e
+	}
+	b, e := arg.magnitude.MultiplyChecked(arg.unit.factor)
+	if e != nil {
+		return 0, e
+	}
+	return a.Compare(b), nil
+}
+
+// String formats q as "magnitude unit": "3/2 km"
+func (q *Quantity) String() string {
+	return fmt.Sprintf("%s %s", q.magnitude, q.unit)
+}
+
+var _ = fmt.Stringer(&Quantity{})
@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package units
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestIn(t *testing.T) {
+	q, e := New(numbers.OneQ(), Mile).In(Inch)
+	if e != nil || q.String() != "63360/1 in" {
+		t.Errorf("1 mi in inches: %v (%v)", q, e)
+	}
+	// there and back again, exactly
+	q, _ = New(numbers.NewQ("1/3"), Foot).In(Centimetre)
+	if back, _ := q.In(Foot); back.Magnitude().Compare(numbers.NewQ("1/3")) != 0 {
+		t.Errorf("1/3 ft -> %s -> %s", q, back)
+	}
+	var de *DimensionError
+	if _, e := New(numbers.OneQ(), Kilogram).In(Second); !errors.As(e, &de) {
+		t.Errorf("expected DimensionError, got %v", e)
+	}
+}
+
+func TestQuantityArithmetic(t *testing.T) {
+	a, b := New(numbers.OneQ(), Metre), New(numbers.NewQ("50/1"), Centimetre)
+	if s, e := a.Add(b); e != nil || s.String() != "3/2 m" {
+		t.Errorf("%s + %s: %v (%v)", a, b, s, e)
+	}
+	if s, e := b.Subtract(a); e != nil || s.String() != "-50/1 cm" {
+		t.Errorf("%s - %s: %v (%v)", b, a, s, e)
+	}
+	distance, time := New(numbers.NewQ("100/1"), Kilometre), New(numbers.NewQ("2/1"), Hour)
+	speed, e := distance.Divide(time)
+	if e != nil || speed.String() != "50/1 km/h" {
+		t.Fatalf("%s / %s: %v (%v)", distance, time, speed, e)
+	}
+	ms, _ := Metre.Divide(Second)
+	if s, e := speed.In(ms); e != nil || s.String() != "125/9 m/s" {
+		t.Errorf("%s in m/s: %v (%v)", speed, s, e)
+	}
+	if back, e := speed.Multiply(time); e != nil || back.Unit().Dimension() != Kilometre.Dimension() {
+		t.Errorf("%s * %s: %v (%v)", speed, time, back, e)
+	} else if c, _ := back.Compare(distance); c != 0 {
+		t.Errorf("%s * %s = %s, expected %s", speed, time, back, distance)
+	}
+	if _, e := a.Add(time); e == nil {
+		t.Error("expected error adding metres and hours")
+	}
+	if _, e := a.Divide(New(numbers.ZeroQ(), Second)); e == nil {
+		t.Error("expected error dividing by ZERO")
+	}
+}
+
+func TestQuantityCompare(t *testing.T) {
+	inch, cm := New(numbers.OneQ(), Inch), New(numbers.NewQ("254/100"), Centimetre)
+	if c, e := inch.Compare(cm); e != nil || c != 0 {
+		t.Errorf("1 in vs 2.54 cm: %d (%v)", c, e)
+	}
+	if c, _ := New(numbers.OneQ(), Pound).Compare(New(numbers.NewQ("1/2"), Kilogram)); c != -1 {
+		t.Errorf("1 lb should be less than 1/2 kg, got %d", c)
+	}
+	if _, e := inch.Compare(New(numbers.OneQ(), Second)); e == nil {
+		t.Error("expected error comparing inches and seconds")
+	}
+}
@@ -0,0 +1,186 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package units attaches units to exact quantities of ℚ. Every unit is a multiple of the SI base units (metre,
+// kilogram, second) - the factor is an exact fraction (an inch is exactly 127/5000 m), so conversions never lose
+// precision.
+package units
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// Dimension is the product of powers of base dimensions: speed is Length^1 * Time^-1
+type Dimension struct {
+	Length int
+	Mass   int
+	Time   int
+}
+
+// Multiply returns dimension of product of quantities
+func (d Dimension) Multiply(arg Dimension) Dimension {
+	return Dimension{Length: d.Length + arg.Length, Mass: d.Mass + arg.Mass, Time: d.Time + arg.Time}
+}
+
+// Divide returns dimension of quotient of quantities
+func (d Dimension) Divide(arg Dimension) Dimension {
+	return Dimension{Length: d.Length - arg.Length, Mass: d.Mass - arg.Mass, Time: d.Time - arg.Time}
+}
+
+// String formats d like "L·T^-1" ("1" for dimensionless)
+func (d Dimension) String() string {
+	parts := make([]string, 0, 3)
+	for _, p := range []struct {
+		symbol string
+		power  int
+	}{{"L", d.Length}, {"M", d.Mass}, {"T", d.Time}} {
+		switch p.power {
+		case 0:
+		case 1:
+			parts = append(parts, p.symbol)
+		default:
+			parts = append(parts, fmt.Sprintf("%s^%d", p.symbol, p.power))
+		}
+	}
+	if len(parts) == 0 {
+		return "1"
+	}
+	return strings.Join(parts, "·")
+}
+
+// DimensionError is returned when quantities of different dimensions are converted, added or compared
+type DimensionError struct {
+	From Dimension
+	To   Dimension
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("incompatible dimensions %s and %s", e.From, e.To)
+}
+
+// Unit is a named multiple of SI base units with given dimension. Values of Unit are immutable.
+type Unit struct {
+	symbol    string
+	factor    *numbers.Q
+	dimension Dimension
+
+	fmt.Stringer
+}
+
+var (
+	// Metre is the SI unit of length
+	Metre = &Unit{symbol: "m", factor: numbers.OneQ(), dimension: Dimension{Length: 1}}
+	// Kilometre is 1000 m
+	Kilometre = &Unit{symbol: "km", factor: numbers.NewQ("1000/1"), dimension: Dimension{Length: 1}}
+	// Centimetre is 1/100 m
+	Centimetre = &Unit{symbol: "cm", factor: numbers.NewQ("1/100"), dimension: Dimension{Length: 1}}
+	// Millimetre is 1/1000 m
+	Millimetre = &Unit{symbol: "mm", factor: numbers.NewQ("1/1000"), dimension: Dimension{Length: 1}}
+	// Inch is exactly 0.0254 m
+	Inch = &Unit{symbol: "in", factor: numbers.NewQ("127/5000"), dimension: Dimension{Length: 1}}
+	// Foot is 12 in
+	Foot = &Unit{symbol: "ft", factor: numbers.NewQ("381/1250"), dimension: Dimension{Length: 1}}
+	// Yard is 3 ft
+	Yard = &Unit{symbol: "yd", factor: numbers.NewQ("1143/1250"), dimension: Dimension{Length: 1}}
+	// Mile is 1760 yd
+	Mile = &Unit{symbol: "mi", factor: numbers.NewQ("201168/125"), dimension: Dimension{Length: 1}}
+
+	// Kilogram is the SI unit of mass
+	Kilogram = &Unit{symbol: "kg", factor: numbers.OneQ(), dimension: Dimension{Mass: 1}}
+	// Gram is 1/1000 kg
+	Gram = &Unit{symbol: "g", factor: numbers.NewQ("1/1000"), dimension: Dimension{Mass: 1}}
+	// Pound is exactly 0.45359237 kg
+	Pound = &Unit{symbol: "lb", factor: numbers.NewQ("45359237/100000000"), dimension: Dimension{Mass: 1}}
+	// Ounce is 1/16 lb
+	Ounce = &Unit{symbol: "oz", factor: numbers.NewQ("45359237/1600000000"), dimension: Dimension{Mass: 1}}
+
+	// Second is the SI unit of time
+	Second = &Unit{symbol: "s", factor: numbers.OneQ(), dimension: Dimension{Time: 1}}
+	// Minute is 60 s
+	Minute = &Unit{symbol: "min", factor: numbers.NewQ("60/1"), dimension: Dimension{Time: 1}}
+	// Hour is 60 min
+	Hour = &Unit{symbol: "h", factor: numbers.NewQ("3600/1"), dimension: Dimension{Time: 1}}
+	// Day is 24 h
+	Day = &Unit{symbol: "d", factor: numbers.NewQ("86400/1"), dimension: Dimension{Time: 1}}
+)
+
+// NewUnit creates a unit with given symbol, equal to factor times the SI unit of given dimension: a nautical mile is
+// NewUnit("nmi", 1852/1, Dimension{Length: 1}). factor has to be positive.
+func NewUnit(symbol string, factor *numbers.Q, dimension Dimension) (*Unit, error) {
+	if factor.Compare(numbers.ZeroQ()) <= 0 {
+		return nil, fmt.Errorf("factor of %s has to be positive, got %s", symbol, factor)
+	}
+	return &Unit{symbol: symbol, factor: factor, dimension: dimension}, nil
+}
+
+// Symbol returns the symbol of u, like "km"
+func (u *Unit) Symbol() string {
+	return u.symbol
+}
+
+// Factor returns the size of u in SI units of its dimension: 127/5000 for Inch
+func (u *Unit) Factor() *numbers.Q {
+	return u.factor
+}
+
+// Dimension returns the dimension of u
+func (u *Unit) Dimension() Dimension {
+	return u.dimension
+}
+
+// Multiply returns the product unit, like "N·m", or ErrOverflow
+func (u *Unit) Multiply(arg *Unit) (*Unit, error) {
+	f, e := u.factor.MultiplyChecked(arg.factor)
+	if e != nil {
+		return nil, e
+	}
+	return &Unit{symbol: u.symbol + "·" + arg.symbol, factor: f, dimension: u.dimension.Multiply(arg.dimension)}, nil
+}
+
+// Divide returns the quotient unit, like "km/h", or ErrOverflow
+func (u *Unit) Divide(arg *Unit) (*Unit, error) {
+	f, e := u.factor.Divide(arg.factor)
+	if e != nil {
+		return nil, e
+	}
+	symbol := arg.symbol
+	if strings.ContainsAny(symbol, "·/") {
+		symbol = "(" + symbol + ")"
+	}
+	return &Unit{symbol: u.symbol + "/" + symbol, factor: f, dimension: u.dimension.Divide(arg.dimension)}, nil
+}
+
+// ConversionFactor returns the exact number of units "to" in one unit u: 127/50 cm in an inch. Returns
+// *DimensionError if the units measure different things.
+func (u *Unit) ConversionFactor(to *Unit) (*numbers.Q, error) {
+	if u.dimension != to.dimension {
+		return nil, &DimensionError{From: u.dimension, To: to.dimension}
+	}
+	return u.factor.Divide(to.factor)
+}
+
+// String returns the symbol of u
+func (u *Unit) String() string {
+	return u.symbol
+}
+
+var _ = fmt.Stringer(&Unit{})
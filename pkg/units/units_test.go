@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package units
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestDimension(t *testing.T) {
+	speed := Metre.Dimension().Divide(Second.Dimension())
+	if speed != (Dimension{Length: 1, Time: -1}) || speed.String() != "L·T^-1" {
+		t.Errorf("unexpected dimension of speed %s", speed)
+	}
+	if d := speed.Multiply(Second.Dimension()).Multiply(Kilogram.Dimension()); d.String() != "L·M" {
+		t.Errorf("unexpected dimension %s", d)
+	}
+	if d := (Dimension{}); d.String() != "1" {
+		t.Errorf("unexpected dimensionless %s", d)
+	}
+}
+
+func TestConversionFactor(t *testing.T) {
+	for _, c := range []struct {
+		from, to *Unit
+		exp      string
+	}{
+		{Inch, Centimetre, "127/50"},
+		{Foot, Inch, "12/1"},
+		{Yard, Foot, "3/1"},
+		{Mile, Yard, "1760/1"},
+		{Mile, Kilometre, "201168/125000"},
+		{Pound, Ounce, "16/1"},
+		{Pound, Gram, "45359237/100000"},
+		{Day, Minute, "1440/1"},
+		{Millimetre, Metre, "1/1000"},
+	} {
+		f, e := c.from.ConversionFactor(c.to)
+		if e != nil || f.Compare(numbers.NewQ(c.exp)) != 0 {
+			t.Errorf("%s in %s: expected %s, got %v (%v)", c.from, c.to, c.exp, f, e)
+		}
+	}
+	var de *DimensionError
+	if _, e := Metre.ConversionFactor(Second); !errors.As(e, &de) || de.Error() != "incompatible dimensions L and T" {
+		t.Errorf("expected DimensionError, got %v", e)
+	}
+}
+
+func TestDerivedUnits(t *testing.T) {
+	kmh, _ := Kilometre.Divide(Hour)
+	ms, _ := Metre.Divide(Second)
+	if f, e := kmh.ConversionFactor(ms); e != nil || f.Compare(numbers.NewQ("5/18")) != 0 || kmh.Symbol() != "km/h" {
+		t.Errorf("%s in %s: %v (%v)", kmh, ms, f, e)
+	}
+	s2, _ := Second.Multiply(Second)
+	if a, _ := Metre.Divide(s2); a.String() != "m/(s·s)" || a.Dimension() != (Dimension{Length: 1, Time: -2}) {
+		t.Errorf("unexpected unit of acceleration %s", a)
+	}
+	nmi, e := NewUnit("nmi", numbers.NewQ("1852/1"), Dimension{Length: 1})
+	if e != nil || nmi.Factor().Compare(numbers.NewQ("1852/1")) != 0 {
+		t.Fatalf("unexpected unit %v (%v)", nmi, e)
+	}
+	if _, e := NewUnit("x", numbers.ZeroQ(), Dimension{}); e == nil {
+		t.Error("expected error for ZERO factor")
+	}
+}
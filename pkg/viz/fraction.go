@@ -0,0 +1,128 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxFractionParts is the maximum denominator of fractions drawn as pies or bars
+const MaxFractionParts = 64
+
+// MaxWholes is the maximum number of pies or bars for fractions bigger than 1
+const MaxWholes = 10
+
+// Size of the fraction pictures
+const (
+	pieRadius  = 50
+	barWidth   = 320
+	barHeight  = 30
+	fracMargin = 10
+)
+
+// Pie returns SVG picture of q = a/b >= 0 as pies divided into b equal sectors with a sectors filled. A pie is drawn for
+// each whole started, so 5/4 is a full pie and a pie with 1 of 4 sectors filled.
+func Pie(q *numbers.Q) (string, error) {
+	a, b, wholes, e := fractionParts(q)
+	if e != nil {
+		return "", e
+	}
+	size := 2 * (pieRadius + fracMargin)
+	s := newSVG(float64(wholes*size), float64(size+20))
+	for w := range wholes {
+		cx, cy := float64(w*size+size/2), float64(size/2)
+		for i := range b {
+			fill := colorEmpty
+			if int64(w)*b+i < a {
+				fill = colorQ
+			}
+			if b == 1 {
+				s.circle(cx, cy, pieRadius, fill)
+				continue
+			}
+			from := 2*math.Pi*float64(i)/float64(b) - math.Pi/2
+			to := 2*math.Pi*float64(i+1)/float64(b) - math.Pi/2
+			large := 0
+			if to-from > math.Pi {
+				large = 1
+			}
+			s.path(fmt.Sprintf("M %s %s L %s %s A %d %d 0 %d 1 %s %s Z", num(cx), num(cy),
+				num(cx+pieRadius*math.Cos(from)), num(cy+pieRadius*math.Sin(from)), pieRadius, pieRadius, large,
+				num(cx+pieRadius*math.Cos(to)), num(cy+pieRadius*math.Sin(to))), fill)
+		}
+	}
+	s.text(float64(wholes*size)/2, float64(size+12), fractionLabel(a, b), colorAxis)
+	return s.String(), nil
+}
+
+// Bar returns SVG picture of q = a/b >= 0 as bars divided into b equal cells with a cells filled, one bar below another
+// for each whole started
+func Bar(q *numbers.Q) (string, error) {
+	a, b, wholes, e := fractionParts(q)
+	if e != nil {
+		return "", e
+	}
+	height := wholes*(barHeight+fracMargin) + fracMargin
+	s := newSVG(barWidth+2*fracMargin, float64(height+20))
+	cell := float64(barWidth) / float64(b)
+	for w := range wholes {
+		y := float64(fracMargin + w*(barHeight+fracMargin))
+		for i := range b {
+			fill := colorEmpty
+			if int64(w)*b+i < a {
+				fill = colorQ
+			}
+			s.rect(fracMargin+float64(i)*cell, y, cell, barHeight, fill)
+		}
+	}
+	s.text(barWidth/2+fracMargin, float64(height+12), fractionLabel(a, b), colorAxis)
+	return s.String(), nil
+}
+
+// fractionParts returns a/b of q with the number of wholes to draw (at least one)
+func fractionParts(q *numbers.Q) (a int64, b int64, wholes int, e error) {
+	a, b = q.Ratio()
+	if a < 0 {
+		return 0, 0, 0, errors.New("negative fractions can't be drawn as parts of a whole")
+	}
+	if b > MaxFractionParts {
+		return 0, 0, 0, fmt.Errorf("denominator %d is bigger than %d", b, MaxFractionParts)
+	}
+	// a/b rounded up without a+b-1, which overflows for a close to math.MaxInt64
+	w := a / b
+	if a%b != 0 || w == 0 {
+		w++
+	}
+	if w > MaxWholes {
+		return 0, 0, 0, fmt.Errorf("%s needs more than %d wholes", q, MaxWholes)
+	}
+	return a, b, int(w), nil
+}
+
+// fractionLabel formats a/b
+func fractionLabel(a int64, b int64) string {
+	if b == 1 {
+		return fmt.Sprint(a)
+	}
+	return fmt.Sprintf("%d/%d", a, b)
+}
@@ -0,0 +1,75 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPie(t *testing.T) {
+	doc, e := Pie(numbers.NewQ("5/4"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	if strings.Count(doc, "<path") != 8 || strings.Count(doc, `fill="`+colorQ+`"`) != 5 {
+		t.Errorf("expected 2 pies of 4 sectors with 5 filled:\n%s", doc)
+	}
+	if !strings.Contains(doc, ">5/4</text>") {
+		t.Errorf("missing label:\n%s", doc)
+	}
+	doc, _ = Pie(numbers.OneQ())
+	if strings.Count(doc, "<circle") != 1 || !strings.Contains(doc, ">1</text>") {
+		t.Errorf("expected full circle:\n%s", doc)
+	}
+	doc, _ = Pie(numbers.NewQ("1/2"))
+	if !strings.Contains(doc, "A 50 50 0 0 1 60 110 Z") {
+		t.Errorf("unexpected half:\n%s", doc)
+	}
+}
+
+func TestBar(t *testing.T) {
+	doc, e := Bar(numbers.NewQ("3/8"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	if strings.Count(doc, "<rect") != 8 || strings.Count(doc, `fill="`+colorQ+`"`) != 3 {
+		t.Errorf("expected 8 cells with 3 filled:\n%s", doc)
+	}
+	doc, _ = Bar(numbers.ZeroQ())
+	if strings.Count(doc, "<rect") != 1 || strings.Contains(doc, `fill="`+colorQ+`"`) {
+		t.Errorf("expected single empty cell:\n%s", doc)
+	}
+}
+
+func TestInvalidFractions(t *testing.T) {
+	for _, q := range []string{"-1/2", "1/65", "21/2", "4611686018427387903/1", "9223372036854775807/1",
+		"9223372036854775807/2"} {
+		if _, e := Pie(numbers.NewQ(q)); e == nil {
+			t.Errorf("expected error for %s", q)
+		}
+		if _, e := Bar(numbers.NewQ(q)); e == nil {
+			t.Errorf("expected error for %s", q)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxTicks is the maximum number of integer ticks on a number line - longer lines have a tick every k-th integer
+const MaxTicks = 20
+
+// Size of the number line picture
+const (
+	lineWidth  = 640
+	lineHeight = 80
+	lineMargin = 30
+	lineY      = 45
+)
+
+// Point is a number marked on the number line, drawn in the color of the smallest of ℕ, ℤ and ℚ it was given as
+type Point struct {
+	value *big.Rat
+	color string
+}
+
+// PointN returns a point for n ∈ ℕ
+func PointN(n *numbers.N) *Point {
+	return &Point{value: new(big.Rat).SetUint64(n.Uint64()), color: colorN}
+}
+
+// PointZ returns a point for z ∈ ℤ
+func PointZ(z *numbers.Z) *Point {
+	return &Point{value: new(big.Rat).SetInt64(z.Int64()), color: colorZ}
+}
+
+// PointQ returns a point for q ∈ ℚ
+func PointQ(q *numbers.Q) *Point {
+	return &Point{value: q.Rat(), color: colorQ}
+}
+
+// NumberLine returns SVG picture of the segment [from, to] of the number line with ticks at integers and the points
+// marked and labeled above it
+func NumberLine(from *numbers.Q, to *numbers.Q, points ...*Point) (string, error) {
+	lo, hi := from.Rat(), to.Rat()
+	if lo.Cmp(hi) >= 0 {
+		return "", fmt.Errorf("empty segment [%s, %s]", label(lo), label(hi))
+	}
+	for _, p := range points {
+		if p.value.Cmp(lo) < 0 || p.value.Cmp(hi) > 0 {
+			return "", fmt.Errorf("%s is outside [%s, %s]", label(p.value), label(lo), label(hi))
+		}
+	}
+	span, _ := new(big.Rat).Sub(hi, lo).Float64()
+	x := func(v *big.Rat) float64 {
+		d, _ := new(big.Rat).Sub(v, lo).Float64()
+		return lineMargin + d/span*(lineWidth-2*lineMargin)
+	}
+
+	s := newSVG(lineWidth, lineHeight)
+	s.line(lineMargin/2, lineY, lineWidth-lineMargin/2, lineY, colorAxis)
+	s.path(fmt.Sprintf("M %d %d L %d %d L %d %d Z", lineWidth-lineMargin/2, lineY, lineWidth-lineMargin/2-8, lineY-4,
+		lineWidth-lineMargin/2-8, lineY+4), colorAxis)
+
+	for _, k := range integerTicks(lo, hi) {
+		tx := x(new(big.Rat).SetInt(k))
+		s.line(tx, lineY-5, tx, lineY+5, colorAxis)
+		s.text(tx, lineY+20, k.String(), colorAxis)
+	}
+	for _, p := range points {
+		px := x(p.value)
+		s.circle(px, lineY, 4, p.color)
+		s.text(px, lineY-10, label(p.value), p.color)
+	}
+	return s.String(), nil
+}
+
+// integerTicks returns at most MaxTicks integers of [lo, hi] - all of them or the multiples of some step, so 0 is
+// always among them
+func integerTicks(lo *big.Rat, hi *big.Rat) []*big.Int {
+	first, last := ceil(lo), floor(hi)
+	if first.Cmp(last) > 0 {
+		return nil
+	}
+	count := new(big.Int).Sub(last, first)
+	count.Add(count, big.NewInt(MaxTicks))
+	step := count.Div(count, big.NewInt(MaxTicks)) // ceil(integers / MaxTicks)
+	var res []*big.Int
+	k := ceil(new(big.Rat).SetFrac(first, step))
+	for k.Mul(k, step); k.Cmp(last) <= 0; k.Add(k, step) {
+		res = append(res, new(big.Int).Set(k))
+	}
+	return res
+}
+
+// floor returns the biggest integer <= v
+func floor(v *big.Rat) *big.Int {
+	res := new(big.Int)
+	res.Div(v.Num(), v.Denom()) // Euclidean division rounds down for positive denominator
+	return res
+}
+
+// ceil returns the smallest integer >= v
+func ceil(v *big.Rat) *big.Int {
+	res := floor(v)
+	if !v.IsInt() {
+		res.Add(res, big.NewInt(1))
+	}
+	return res
+}
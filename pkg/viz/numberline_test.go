@@ -0,0 +1,76 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestNumberLine(t *testing.T) {
+	doc, e := NumberLine(numbers.NewQ("-3/1"), numbers.NewQ("7/2"),
+		PointN(numbers.NFromUint64(2)), PointZ(numbers.ZFromInt64(-2)), PointQ(numbers.NewQ("1/3")))
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	for _, part := range []string{
+		">-3</text>", ">3</text>", // ticks
+		`fill="` + colorN + `">2</text>`, `fill="` + colorZ + `">-2</text>`, `fill="` + colorQ + `">1/3</text>`,
+	} {
+		if !strings.Contains(doc, part) {
+			t.Errorf("missing %q in:\n%s", part, doc)
+		}
+	}
+	if strings.Contains(doc, ">4</text>") {
+		t.Error("tick outside of the segment")
+	}
+	if n := strings.Count(doc, "<line"); n != 8 {
+		t.Errorf("expected axis and 7 ticks, got %d lines", n)
+	}
+}
+
+func TestNumberLineTicks(t *testing.T) {
+	doc, e := NumberLine(numbers.NewQ("-1000000000000/1"), numbers.NewQ("1000000000000/1"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if n := strings.Count(doc, "<line") - 1; n > MaxTicks+1 || !strings.Contains(doc, ">0</text>") {
+		t.Errorf("%d ticks:\n%s", n, doc)
+	}
+	doc, e = NumberLine(numbers.NewQ("1/3"), numbers.NewQ("2/3"), PointQ(numbers.NewQ("1/2")))
+	if e != nil || strings.Count(doc, "<line") != 1 {
+		t.Errorf("expected no ticks: %v\n%s", e, doc)
+	}
+	if ceil(big.NewRat(-3, 2)).Int64() != -1 || floor(big.NewRat(-3, 2)).Int64() != -2 {
+		t.Error("unexpected rounding")
+	}
+}
+
+func TestInvalidNumberLine(t *testing.T) {
+	if _, e := NumberLine(numbers.OneQ(), numbers.ZeroQ()); e == nil {
+		t.Error("expected error for empty segment")
+	}
+	if _, e := NumberLine(numbers.ZeroQ(), numbers.OneQ(), PointZ(numbers.ZFromInt64(2))); e == nil {
+		t.Error("expected error for point outside")
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+// MaxPlotPoints is the maximum number of sequence terms or function values in a plot
+const MaxPlotPoints = 1000
+
+// Size of the plots
+const (
+	plotWidth  = 640
+	plotHeight = 400
+	plotMargin = 50
+)
+
+// PlotSequence returns SVG plot of exact sequence terms - the point (first + i, terms[i]) for each term, joined with
+// a line. The y axis is labeled with the exact smallest and biggest term, like for harmonic numbers 1, 3/2, 11/6, ...
+// or convergents 3, 22/7, 333/106, 355/113 of π.
+func PlotSequence(first *numbers.Z, terms []*numbers.Q) (string, error) {
+	if len(terms) == 0 {
+		return "", errors.New("no terms to plot")
+	}
+	if len(terms) > MaxPlotPoints {
+		return "", fmt.Errorf("more than %d terms", MaxPlotPoints)
+	}
+	x0 := new(big.Rat).SetInt64(first.Int64())
+	x1 := new(big.Rat).SetInt64(first.Int64() + int64(len(terms)) - 1)
+	ys := make([]*big.Rat, len(terms))
+	for i, t := range terms {
+		ys[i] = t.Rat()
+	}
+	p := newPlot(x0, x1, ys)
+	var points []string
+	for i, y := range ys {
+		x := p.x(new(big.Rat).SetInt64(first.Int64() + int64(i)))
+		points = append(points, num(x)+","+num(p.y(y)))
+	}
+	p.element(fmt.Sprintf(`<polyline points="%s" fill="none" stroke="%s"/>`, strings.Join(points, " "), colorQ))
+	for i, y := range ys {
+		p.circle(p.x(new(big.Rat).SetInt64(first.Int64()+int64(i))), p.y(y), 3, colorQ)
+	}
+	return p.String(), nil
+}
+
+// StepPlot returns SVG plot of f: ℤ → ℚ over from <= k <= to as a step function - f(k) is drawn as a horizontal line
+// over [k, k + 1), like the exact values of ⌊x⌋/2 or the running average of a sequence
+func StepPlot(f func(k *numbers.Z) (*numbers.Q, error), from *numbers.Z, to *numbers.Z) (string, error) {
+	if from.Compare(to) > 0 {
+		return "", fmt.Errorf("empty range [%s, %s]", from, to)
+	}
+	lo, hi := from.Int64(), to.Int64()
+	if hi-lo >= MaxPlotPoints || hi-lo < 0 {
+		return "", fmt.Errorf("more than %d values", MaxPlotPoints)
+	}
+	ys := make([]*big.Rat, 0, hi-lo+1)
+	for k := lo; k <= hi; k++ {
+		v, e := f(numbers.ZFromInt64(k))
+		if e != nil {
+			return "", fmt.Errorf("f(%d): %w", k, e)
+		}
+		ys = append(ys, v.Rat())
+	}
+	p := newPlot(new(big.Rat).SetInt64(lo), new(big.Rat).SetInt64(hi+1), ys)
+	var d strings.Builder
+	for i, y := range ys {
+		x := p.x(new(big.Rat).SetInt64(lo + int64(i)))
+		if i == 0 {
+			fmt.Fprintf(&d, "M %s %s", num(x), num(p.y(y)))
+		} else {
+			fmt.Fprintf(&d, " V %s", num(p.y(y)))
+		}
+		fmt.Fprintf(&d, " H %s", num(p.x(new(big.Rat).SetInt64(lo+int64(i)+1))))
+	}
+	p.element(fmt.Sprintf(`<path d="%s" fill="none" stroke="%s"/>`, d.String(), colorQ))
+	return p.String(), nil
+}
+
+// plot is SVG document with axes mapping [x0, x1] × [y0, y1] to the drawing area
+type plot struct {
+	*svg
+	x0, x1, y0, y1 *big.Rat
+}
+
+// newPlot starts a plot over [x0, x1] and the range of ys, with integer ticks on the x axis and the exact minimum
+// and maximum on the y axis
+func newPlot(x0 *big.Rat, x1 *big.Rat, ys []*big.Rat) *plot {
+	p := &plot{svg: newSVG(plotWidth, plotHeight), x0: x0, x1: x1, y0: ys[0], y1: ys[0]}
+	for _, y := range ys {
+		if y.Cmp(p.y0) < 0 {
+			p.y0 = y
+		}
+		if y.Cmp(p.y1) > 0 {
+			p.y1 = y
+		}
+	}
+	bottom, left := float64(plotHeight-plotMargin), float64(plotMargin)
+	p.line(left, bottom, plotWidth-plotMargin/2, bottom, colorAxis)
+	p.line(left, bottom, left, plotMargin/2, colorAxis)
+	for _, k := range integerTicks(x0, x1) {
+		x := p.x(new(big.Rat).SetInt(k))
+		p.line(x, bottom, x, bottom+5, colorAxis)
+		p.text(x, bottom+20, k.String(), colorAxis)
+	}
+	for _, y := range []*big.Rat{p.y0, p.y1} {
+		p.line(left-5, p.y(y), left, p.y(y), colorAxis)
+		p.text(left/2, p.y(y)+4, label(y), colorAxis)
+	}
+	return p
+}
+
+// x returns the horizontal coordinate of v
+func (p *plot) x(v *big.Rat) float64 {
+	return scale(v, p.x0, p.x1, plotMargin, plotWidth-plotMargin)
+}
+
+// y returns the vertical coordinate of v (growing downwards)
+func (p *plot) y(v *big.Rat) float64 {
+	return scale(v, p.y0, p.y1, plotHeight-plotMargin, plotMargin)
+}
+
+// scale maps v from [lo, hi] to [from, to], or to the middle if lo = hi
+func scale(v *big.Rat, lo *big.Rat, hi *big.Rat, from float64, to float64) float64 {
+	span, _ := new(big.Rat).Sub(hi, lo).Float64()
+	if span == 0 {
+		return (from + to) / 2
+	}
+	d, _ := new(big.Rat).Sub(v, lo).Float64()
+	return from + d/span*(to-from)
+}
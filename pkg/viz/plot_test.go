@@ -0,0 +1,115 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grgrzybek/gomath/pkg/numbers"
+)
+
+func TestPlotHarmonicNumbers(t *testing.T) {
+	terms := []*numbers.Q{numbers.OneQ()}
+	for n := int64(2); n <= 10; n++ {
+		q, _ := numbers.QFromInts(1, n)
+		h, e := terms[len(terms)-1].AddChecked(q)
+		if e != nil {
+			t.Fatal(e)
+		}
+		terms = append(terms, h)
+	}
+	doc, e := PlotSequence(numbers.ZFromInt64(1), terms)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	if strings.Count(doc, "<circle") != 10 || !strings.Contains(doc, ">7381/2520</text>") ||
+		!strings.Contains(doc, ">1</text>") || !strings.Contains(doc, ">10</text>") {
+		t.Errorf("unexpected plot:\n%s", doc)
+	}
+	// H1 in the bottom left corner and H10 in the top right corner
+	if !strings.Contains(doc, `<polyline points="50,350 `) || !strings.Contains(doc, ` 590,50"`) {
+		t.Errorf("unexpected polyline:\n%s", doc)
+	}
+}
+
+func TestPlotConvergentsOfPi(t *testing.T) {
+	cf, e := numbers.NewContinuedFraction(numbers.ZFromInt64(3), numbers.NFromUint64(7), numbers.NFromUint64(15),
+		numbers.NFromUint64(1), numbers.NFromUint64(292))
+	if e != nil {
+		t.Fatal(e)
+	}
+	convergents, e := cf.Convergents()
+	if e != nil {
+		t.Fatal(e)
+	}
+	doc, e := PlotSequence(numbers.ZFromInt64(0), convergents)
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	if !strings.Contains(doc, ">3</text>") || !strings.Contains(doc, ">22/7</text>") {
+		t.Errorf("expected 3 and 22/7 as the range:\n%s", doc)
+	}
+}
+
+func TestPlotConstantSequence(t *testing.T) {
+	doc, e := PlotSequence(numbers.ZFromInt64(-2), []*numbers.Q{numbers.OneQ(), numbers.OneQ()})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !strings.Contains(doc, `points="50,200 590,200"`) {
+		t.Errorf("expected horizontal line in the middle:\n%s", doc)
+	}
+	if _, e := PlotSequence(numbers.ZFromInt64(0), nil); e == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestStepPlot(t *testing.T) {
+	half := func(k *numbers.Z) (*numbers.Q, error) {
+		return numbers.QFromInts(k.Int64()/2, 1)
+	}
+	doc, e := StepPlot(half, numbers.ZFromInt64(0), numbers.ZFromInt64(3))
+	if e != nil {
+		t.Fatal(e)
+	}
+	checkSVG(t, doc)
+	if !strings.Contains(doc, `<path d="M 50 350 H 185 V 350 H 320 V 50 H 455 V 50 H 590"`) {
+		t.Errorf("unexpected steps:\n%s", doc)
+	}
+	failing := func(k *numbers.Z) (*numbers.Q, error) {
+		if k.Int64() == 2 {
+			return nil, errors.New("boom")
+		}
+		return numbers.ZeroQ(), nil
+	}
+	if _, e := StepPlot(failing, numbers.ZFromInt64(0), numbers.ZFromInt64(3)); e == nil ||
+		!strings.Contains(e.Error(), "f(2)") {
+		t.Errorf("expected error at 2, got %v", e)
+	}
+	if _, e := StepPlot(half, numbers.ZFromInt64(1), numbers.ZFromInt64(0)); e == nil {
+		t.Error("expected error for empty range")
+	}
+	if _, e := StepPlot(half, numbers.ZFromInt64(0), numbers.ZFromInt64(MaxPlotPoints)); e == nil {
+		t.Error("expected error for too many values")
+	}
+}
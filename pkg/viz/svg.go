@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package viz renders numbers as SVG pictures for web-based lessons: points of ℕ, ℤ and ℚ on a number line, fractions
+// as pies and bars, plots of exact sequences and step plots of ℚ-valued functions. The SVG is returned as a standalone
+// document in a string, which can also be embedded in HTML as it is.
+package viz
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Colors of the pictures
+const (
+	colorAxis  = "#333333"
+	colorN     = "#1f77b4"
+	colorZ     = "#2ca02c"
+	colorQ     = "#ff7f0e"
+	colorEmpty = "#ffffff"
+)
+
+// svg builds an SVG document element by element
+type svg struct {
+	sb strings.Builder
+}
+
+// newSVG starts SVG document of given size
+func newSVG(width float64, height float64) *svg {
+	s := &svg{}
+	fmt.Fprintf(&s.sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`,
+		num(width), num(height), num(width), num(height))
+	s.sb.WriteByte('\n')
+	return s
+}
+
+func (s *svg) line(x1, y1, x2, y2 float64, color string) {
+	s.element(fmt.Sprintf(`<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`,
+		num(x1), num(y1), num(x2), num(y2), color))
+}
+
+func (s *svg) circle(cx, cy, r float64, fill string) {
+	s.element(fmt.Sprintf(`<circle cx="%s" cy="%s" r="%s" fill="%s" stroke="%s"/>`,
+		num(cx), num(cy), num(r), fill, colorAxis))
+}
+
+func (s *svg) rect(x, y, width, height float64, fill string) {
+	s.element(fmt.Sprintf(`<rect x="%s" y="%s" width="%s" height="%s" fill="%s" stroke="%s"/>`,
+		num(x), num(y), num(width), num(height), fill, colorAxis))
+}
+
+func (s *svg) path(d string, fill string) {
+	s.element(fmt.Sprintf(`<path d="%s" fill="%s" stroke="%s"/>`, d, fill, colorAxis))
+}
+
+// text writes text centered at x with baseline at y
+func (s *svg) text(x, y float64, text string, color string) {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(text))
+	s.element(fmt.Sprintf(`<text x="%s" y="%s" text-anchor="middle" font-family="sans-serif" font-size="12" `+
+		`fill="%s">%s</text>`, num(x), num(y), color, escaped.String()))
+}
+
+func (s *svg) element(e string) {
+	s.sb.WriteString("  ")
+	s.sb.WriteString(e)
+	s.sb.WriteByte('\n')
+}
+
+// String ends the document
+func (s *svg) String() string {
+	return s.sb.String() + "</svg>\n"
+}
+
+// num formats a coordinate with at most 2 decimal places
+func num(v float64) string {
+	res := strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+	if res == "-0" {
+		return "0"
+	}
+	return res
+}
+
+// label formats q as "3" or "-1/2"
+func label(q *big.Rat) string {
+	if q.IsInt() {
+		return q.Num().String()
+	}
+	return q.String()
+}
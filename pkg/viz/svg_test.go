@@ -0,0 +1,67 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package viz
+
+import (
+	"encoding/xml"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// checkSVG checks if doc is well-formed XML with svg root element
+func checkSVG(t *testing.T, doc string) {
+	t.Helper()
+	if !strings.HasPrefix(doc, `<svg xmlns="http://www.w3.org/2000/svg"`) {
+		t.Errorf("not SVG: %s", doc)
+	}
+	d := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		if _, e := d.Token(); e == io.EOF {
+			return
+		} else if e != nil {
+			t.Fatalf("%v in:\n%s", e, doc)
+		}
+	}
+}
+
+func TestSVGElements(t *testing.T) {
+	s := newSVG(100, 50)
+	s.line(0, 0, 10.126, 20, colorAxis)
+	s.text(5, 5, "a<b & c", colorAxis)
+	doc := s.String()
+	checkSVG(t, doc)
+	for _, part := range []string{`viewBox="0 0 100 50"`, `x2="10.13"`, "a&lt;b &amp; c", "</svg>\n"} {
+		if !strings.Contains(doc, part) {
+			t.Errorf("missing %q in:\n%s", part, doc)
+		}
+	}
+}
+
+func TestNum(t *testing.T) {
+	for v, expected := range map[float64]string{0: "0", -0.001: "0", 1.5: "1.5", 2.999: "3", -12.25: "-12.25"} {
+		if num(v) != expected {
+			t.Errorf("%v: expected %s, got %s", v, expected, num(v))
+		}
+	}
+	if label(big.NewRat(6, 3)) != "2" || label(big.NewRat(-1, 2)) != "-1/2" {
+		t.Error("unexpected label")
+	}
+}